@@ -8,10 +8,13 @@ import "code.cloudfoundry.org/cli/util/configv3"
 type Config interface {
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(repoName string, repoURL string)
+	AddPluginTrustedKey(name string, publicKey string)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
+	PluginTrustedKeys() []configv3.PluginTrustedKey
 	Plugins() []configv3.Plugin
 	RemovePlugin(string)
+	RemovePluginTrustedKey(name string)
 	WritePluginConfig() error
 }
@@ -36,7 +36,7 @@ func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 		for _, plugin := range repository.Plugins {
 			existingVersion, exist := repoPlugins[plugin.Name]
 			if exist {
-				if lessThan(existingVersion, plugin.Version) {
+				if LessThan(existingVersion, plugin.Version) {
 					repoPlugins[plugin.Name] = plugin.Version
 				}
 			} else {
@@ -47,7 +47,7 @@ func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 
 	for _, installedPlugin := range actor.config.Plugins() {
 		repoVersion, exist := repoPlugins[installedPlugin.Name]
-		if exist && lessThan(installedPlugin.Version.String(), repoVersion) {
+		if exist && LessThan(installedPlugin.Version.String(), repoVersion) {
 			outdatedPlugins = append(outdatedPlugins, OutdatedPlugin{
 				Name:           installedPlugin.Name,
 				CurrentVersion: installedPlugin.Version.String(),
@@ -59,7 +59,9 @@ func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 	return outdatedPlugins, nil
 }
 
-func lessThan(version1 string, version2 string) bool {
+// LessThan returns true if version1 is an older semantic version than
+// version2. It returns false if either version is not a semantic version.
+func LessThan(version1 string, version2 string) bool {
 	v1, err := semver.Make(version1)
 	if err != nil {
 		return false
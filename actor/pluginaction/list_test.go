@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/util/configv3"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -108,4 +109,14 @@ var _ = Describe("Plugin actions", func() {
 			})
 		})
 	})
+
+	DescribeTable("LessThan",
+		func(version1 string, version2 string, expected bool) {
+			Expect(LessThan(version1, version2)).To(Equal(expected))
+		},
+		Entry("an older version", "1.2.3", "1.10.0", true),
+		Entry("the same version", "1.2.3", "1.2.3", false),
+		Entry("a newer version", "2.0.0", "1.10.0", false),
+		Entry("a version that is not semantic", "N/A", "1.0.0", false),
+	)
 })
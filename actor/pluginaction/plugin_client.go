@@ -7,4 +7,5 @@ import "code.cloudfoundry.org/cli/api/plugin"
 type PluginClient interface {
 	GetPluginRepository(repositoryURL string) (plugin.PluginRepository, error)
 	DownloadPlugin(pluginURL string, path string, proxyReader plugin.ProxyReader) error
+	DownloadPluginSignature(signatureURL string) ([]byte, error)
 }
@@ -9,10 +9,11 @@ import (
 )
 
 type PluginInfo struct {
-	Name     string
-	Version  string
-	URL      string
-	Checksum string
+	Name         string
+	Version      string
+	URL          string
+	Checksum     string
+	SignatureURL string
 }
 
 // FetchingPluginInfoFromRepositoryError is returned an error is encountered
@@ -73,7 +74,7 @@ func (actor Actor) GetPluginInfoFromRepositoriesForPlatform(pluginName string, p
 			pluginFoundWithIncompatibleBinary = true
 			continue
 		case nil:
-			if len(reposWithPlugin) == 0 || lessThan(newestPluginInfo.Version, pluginInfo.Version) {
+			if len(reposWithPlugin) == 0 || LessThan(newestPluginInfo.Version, pluginInfo.Version) {
 				newestPluginInfo = pluginInfo
 				reposWithPlugin = []string{repo.Name}
 			} else if pluginInfo.Version == newestPluginInfo.Version {
@@ -116,7 +117,13 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 		if plugin.Name == pluginName {
 			for _, pluginBinary := range plugin.Binaries {
 				if pluginBinary.Platform == platform {
-					return PluginInfo{Name: plugin.Name, Version: plugin.Version, URL: pluginBinary.URL, Checksum: pluginBinary.Checksum}, nil
+					return PluginInfo{
+						Name:         plugin.Name,
+						Version:      plugin.Version,
+						URL:          pluginBinary.URL,
+						Checksum:     pluginBinary.Checksum,
+						SignatureURL: pluginBinary.SignatureURL,
+					}, nil
 				}
 			}
 			pluginFoundWithIncompatibleBinary = true
@@ -46,7 +46,7 @@ var _ = Describe("plugin info actions", func() {
 								Name:    "some-plugin",
 								Version: "1.2.3",
 								Binaries: []plugin.PluginBinary{
-									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum"},
+									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum", SignatureURL: "http://some-darwin-url.sig"},
 									{Platform: "win64", URL: "http://some-windows-url", Checksum: "anotherchecksum"},
 									{Platform: "linux64", URL: "http://some-linux-url", Checksum: "lastchecksum"},
 								},
@@ -96,6 +96,8 @@ var _ = Describe("plugin info actions", func() {
 						Expect(pluginInfo.Name).To(Equal("some-plugin"))
 						Expect(pluginInfo.Version).To(Equal("1.2.3"))
 						Expect(pluginInfo.URL).To(Equal("http://some-darwin-url"))
+						Expect(pluginInfo.Checksum).To(Equal("somechecksum"))
+						Expect(pluginInfo.SignatureURL).To(Equal("http://some-darwin-url.sig"))
 						Expect(repos).To(ConsistOf("some-repo"))
 					})
 				})
@@ -89,6 +89,32 @@ func (actor Actor) GetPluginRepository(repositoryName string) (configv3.PluginRe
 	return configv3.PluginRepository{}, RepositoryNotRegisteredError{Name: repositoryName}
 }
 
+// RepositoryPlugin is a plugin listed in the index of a plugin repository.
+type RepositoryPlugin struct {
+	Name        string
+	Version     string
+	Description string
+}
+
+// GetRepositoryPlugins returns the plugins listed in the index of the
+// repository.
+func (actor Actor) GetRepositoryPlugins(repository configv3.PluginRepository) ([]RepositoryPlugin, error) {
+	pluginRepository, err := actor.client.GetPluginRepository(repository.URL)
+	if err != nil {
+		return nil, GettingPluginRepositoryError{Name: repository.Name, Message: err.Error()}
+	}
+
+	var plugins []RepositoryPlugin
+	for _, plugin := range pluginRepository.Plugins {
+		plugins = append(plugins, RepositoryPlugin{
+			Name:        plugin.Name,
+			Version:     plugin.Version,
+			Description: plugin.Description,
+		})
+	}
+	return plugins, nil
+}
+
 func (actor Actor) IsPluginRepositoryRegistered(repositoryName string) bool {
 	for _, repository := range actor.config.PluginRepositories() {
 		if repositoryName == repository.Name {
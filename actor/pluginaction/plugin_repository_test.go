@@ -205,6 +205,48 @@ var _ = Describe("Plugin Repository Actions", func() {
 		})
 	})
 
+	Describe("GetRepositoryPlugins", func() {
+		var repository configv3.PluginRepository
+
+		BeforeEach(func() {
+			repository = configv3.PluginRepository{Name: "some-repo", URL: "some-url"}
+		})
+
+		Context("when the repository index can be fetched", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{Name: "plugin-1", Version: "1.2.3", Description: "some-description"},
+						{Name: "plugin-2", Version: "0.1.0"},
+					},
+				}, nil)
+			})
+
+			It("returns the plugins in the index", func() {
+				plugins, err := actor.GetRepositoryPlugins(repository)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plugins).To(Equal([]RepositoryPlugin{
+					{Name: "plugin-1", Version: "1.2.3", Description: "some-description"},
+					{Name: "plugin-2", Version: "0.1.0"},
+				}))
+
+				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(1))
+				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("some-url"))
+			})
+		})
+
+		Context("when fetching the repository index fails", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, errors.New("some-error"))
+			})
+
+			It("returns a GettingPluginRepositoryError", func() {
+				_, err := actor.GetRepositoryPlugins(repository)
+				Expect(err).To(MatchError(GettingPluginRepositoryError{Name: "some-repo", Message: "some-error"}))
+			})
+		})
+	})
+
 	Describe("IsPluginRepositoryRegistered", func() {
 		Context("when the repository is registered", func() {
 			BeforeEach(func() {
@@ -0,0 +1,278 @@
+package pluginaction
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	"github.com/blang/semver"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// PluginSetEntry is a plugin listed in a plugin set file. Repository and
+// Version are optional; Version is a semantic version range such as
+// ">=1.2.0 <2.0.0".
+type PluginSetEntry struct {
+	Name       string `yaml:"name"`
+	Repository string `yaml:"repository,omitempty"`
+	Version    string `yaml:"version,omitempty"`
+}
+
+// LockedPlugin is the exact plugin version that a plugin set entry resolved
+// to, with the binary it resolved to on each platform it was installed on.
+// Binaries are keyed by platform, so that a lockfile shared between machines
+// keeps the checksums locked on every platform.
+type LockedPlugin struct {
+	Name       string                        `yaml:"name"`
+	Version    string                        `yaml:"version"`
+	Repository string                        `yaml:"repository"`
+	Binaries   map[string]LockedPluginBinary `yaml:"binaries"`
+}
+
+// LockedPluginBinary is the plugin binary a locked plugin resolved to for a
+// platform.
+type LockedPluginBinary struct {
+	URL          string `yaml:"url"`
+	Checksum     string `yaml:"checksum"`
+	SignatureURL string `yaml:"signature_url,omitempty"`
+}
+
+type pluginSet struct {
+	Plugins []PluginSetEntry `yaml:"plugins"`
+}
+
+type pluginLockfile struct {
+	Plugins []LockedPlugin `yaml:"plugins"`
+}
+
+// PluginSetInvalidError is returned when a plugin set file cannot be parsed
+// or lists a plugin without a name or more than once.
+type PluginSetInvalidError struct {
+	Path    string
+	Message string
+}
+
+func (e PluginSetInvalidError) Error() string {
+	return fmt.Sprintf("Plugin set %s is invalid: %s", e.Path, e.Message)
+}
+
+// PluginVersionConstraintInvalidError is returned when the version of a
+// plugin set entry is not a semantic version range.
+type PluginVersionConstraintInvalidError struct {
+	PluginName string
+	Constraint string
+}
+
+func (e PluginVersionConstraintInvalidError) Error() string {
+	return fmt.Sprintf("Version constraint %s of plugin %s is invalid", e.Constraint, e.PluginName)
+}
+
+// PluginVersionConstraintNotSatisfiedError is returned when the newest version
+// of a plugin in the repositories does not satisfy its version constraint.
+type PluginVersionConstraintNotSatisfiedError struct {
+	PluginName string
+	Constraint string
+	Version    string
+}
+
+func (e PluginVersionConstraintNotSatisfiedError) Error() string {
+	return fmt.Sprintf("Plugin %s %s does not satisfy version constraint %s", e.PluginName, e.Version, e.Constraint)
+}
+
+// LockedPluginVersionUnavailableError is returned when the repositories no
+// longer provide the version of a plugin recorded in the lockfile.
+type LockedPluginVersionUnavailableError struct {
+	PluginName    string
+	LockedVersion string
+	Version       string
+}
+
+func (e LockedPluginVersionUnavailableError) Error() string {
+	return fmt.Sprintf("Plugin %s is locked to version %s but the repositories provide version %s", e.PluginName, e.LockedVersion, e.Version)
+}
+
+// PluginLockfilePath returns the path of the lockfile that belongs to the
+// plugin set file at pluginSetPath: the same path with a .lock extension.
+func PluginLockfilePath(pluginSetPath string) string {
+	return strings.TrimSuffix(pluginSetPath, filepath.Ext(pluginSetPath)) + ".lock"
+}
+
+// ReadPluginSet reads the plugins listed in a plugin set file.
+func (actor Actor) ReadPluginSet(path string) ([]PluginSetEntry, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var set pluginSet
+	err = yaml.Unmarshal(raw, &set)
+	if err != nil {
+		return nil, PluginSetInvalidError{Path: path, Message: err.Error()}
+	}
+
+	names := map[string]bool{}
+	for _, entry := range set.Plugins {
+		if entry.Name == "" {
+			return nil, PluginSetInvalidError{Path: path, Message: "every plugin must have a name"}
+		}
+		if names[entry.Name] {
+			return nil, PluginSetInvalidError{Path: path, Message: fmt.Sprintf("plugin %s is listed more than once", entry.Name)}
+		}
+		names[entry.Name] = true
+	}
+
+	return set.Plugins, nil
+}
+
+// ReadPluginLockfile reads the plugins recorded in a lockfile. It returns no
+// plugins if the lockfile does not exist.
+func (actor Actor) ReadPluginLockfile(path string) ([]LockedPlugin, error) {
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var lockfile pluginLockfile
+	err = yaml.Unmarshal(raw, &lockfile)
+	if err != nil {
+		return nil, PluginSetInvalidError{Path: path, Message: err.Error()}
+	}
+
+	return lockfile.Plugins, nil
+}
+
+// WritePluginLockfile records the resolved plugins in a lockfile.
+func (actor Actor) WritePluginLockfile(path string, plugins []LockedPlugin) error {
+	raw, err := yaml.Marshal(pluginLockfile{Plugins: plugins})
+	if err != nil {
+		return err
+	}
+
+	// The lockfile is written to a temporary file that replaces it, so that
+	// an interrupted write cannot leave a truncated lockfile behind.
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(raw)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tempFile.Name(), 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
+
+// ResolvePluginSet resolves every entry of a plugin set to an exact plugin
+// binary for the platform. Entries that are locked to a version that still
+// satisfies their constraint keep that version; locked entries without a
+// binary for the platform are looked up again, must resolve to the same
+// version and keep the binaries locked for other platforms. When update is
+// true the lockfile is ignored and the newest versions are used.
+func (actor Actor) ResolvePluginSet(entries []PluginSetEntry, locked []LockedPlugin, platform string, update bool) ([]LockedPlugin, error) {
+	lockedByName := map[string]LockedPlugin{}
+	for _, lockedPlugin := range locked {
+		lockedByName[lockedPlugin.Name] = lockedPlugin
+	}
+
+	resolved := make([]LockedPlugin, 0, len(entries))
+	for _, entry := range entries {
+		var constraint semver.Range
+		if entry.Version != "" {
+			var err error
+			constraint, err = semver.ParseRange(entry.Version)
+			if err != nil {
+				return nil, PluginVersionConstraintInvalidError{PluginName: entry.Name, Constraint: entry.Version}
+			}
+		}
+
+		lockedPlugin, isLocked := lockedByName[entry.Name]
+		isLocked = isLocked && !update &&
+			satisfies(constraint, lockedPlugin.Version) &&
+			(entry.Repository == "" || strings.EqualFold(entry.Repository, lockedPlugin.Repository))
+		if _, hasBinary := lockedPlugin.Binaries[platform]; isLocked && hasBinary {
+			resolved = append(resolved, lockedPlugin)
+			continue
+		}
+
+		repos := actor.config.PluginRepositories()
+		if entry.Repository != "" {
+			repo, err := actor.GetPluginRepository(entry.Repository)
+			if err != nil {
+				return nil, err
+			}
+			repos = []configv3.PluginRepository{repo}
+		}
+
+		pluginInfo, repoNames, err := actor.GetPluginInfoFromRepositoriesForPlatform(entry.Name, repos, platform)
+		if err != nil {
+			return nil, err
+		}
+
+		if isLocked && pluginInfo.Version != lockedPlugin.Version {
+			return nil, LockedPluginVersionUnavailableError{
+				PluginName:    entry.Name,
+				LockedVersion: lockedPlugin.Version,
+				Version:       pluginInfo.Version,
+			}
+		}
+
+		if !satisfies(constraint, pluginInfo.Version) {
+			return nil, PluginVersionConstraintNotSatisfiedError{
+				PluginName: entry.Name,
+				Constraint: entry.Version,
+				Version:    pluginInfo.Version,
+			}
+		}
+
+		binaries := map[string]LockedPluginBinary{}
+		if lockedPlugin.Version == pluginInfo.Version && strings.EqualFold(lockedPlugin.Repository, repoNames[0]) {
+			for lockedPlatform, binary := range lockedPlugin.Binaries {
+				binaries[lockedPlatform] = binary
+			}
+		}
+		binaries[platform] = LockedPluginBinary{
+			URL:          pluginInfo.URL,
+			Checksum:     pluginInfo.Checksum,
+			SignatureURL: pluginInfo.SignatureURL,
+		}
+
+		resolved = append(resolved, LockedPlugin{
+			Name:       pluginInfo.Name,
+			Version:    pluginInfo.Version,
+			Repository: repoNames[0],
+			Binaries:   binaries,
+		})
+	}
+
+	return resolved, nil
+}
+
+// satisfies returns true if version is within constraint. A nil constraint is
+// satisfied by every version.
+func satisfies(constraint semver.Range, version string) bool {
+	if constraint == nil {
+		return true
+	}
+
+	v, err := semver.Make(version)
+	if err != nil {
+		return false
+	}
+
+	return constraint(v)
+}
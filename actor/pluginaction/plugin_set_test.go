@@ -0,0 +1,390 @@
+package pluginaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("plugin set actions", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+		fakeClient *pluginactionfakes.FakePluginClient
+		tempDir    string
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakeClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakeClient)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "plugin-set")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	DescribeTable("PluginLockfilePath",
+		func(pluginSetPath string, expectedPath string) {
+			Expect(PluginLockfilePath(pluginSetPath)).To(Equal(expectedPath))
+		},
+
+		Entry("replaces the extension", "some-dir/plugins.yml", "some-dir/plugins.lock"),
+		Entry("adds an extension", "some-dir/plugins", "some-dir/plugins.lock"),
+	)
+
+	Describe("ReadPluginSet", func() {
+		var (
+			path    string
+			entries []PluginSetEntry
+			err     error
+		)
+
+		BeforeEach(func() {
+			path = filepath.Join(tempDir, "plugins.yml")
+		})
+
+		JustBeforeEach(func() {
+			entries, err = actor.ReadPluginSet(path)
+		})
+
+		Context("when the file is valid", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte(`---
+plugins:
+- name: plugin-1
+- name: plugin-2
+  repository: some-repo
+  version: ">=1.0.0 <2.0.0"
+`), 0600)).To(Succeed())
+			})
+
+			It("returns the plugins in the file", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(Equal([]PluginSetEntry{
+					{Name: "plugin-1"},
+					{Name: "plugin-2", Repository: "some-repo", Version: ">=1.0.0 <2.0.0"},
+				}))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns the error", func() {
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the file is not valid YAML", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte("plugins: [\n"), 0600)).To(Succeed())
+			})
+
+			It("returns a PluginSetInvalidError", func() {
+				Expect(err).To(BeAssignableToTypeOf(PluginSetInvalidError{}))
+			})
+		})
+
+		Context("when a plugin has no name", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte("plugins:\n- version: 1.0.0\n"), 0600)).To(Succeed())
+			})
+
+			It("returns a PluginSetInvalidError", func() {
+				Expect(err).To(MatchError(PluginSetInvalidError{Path: path, Message: "every plugin must have a name"}))
+			})
+		})
+
+		Context("when a plugin is listed twice", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte("plugins:\n- name: plugin-1\n- name: plugin-1\n"), 0600)).To(Succeed())
+			})
+
+			It("returns a PluginSetInvalidError", func() {
+				Expect(err).To(MatchError(PluginSetInvalidError{Path: path, Message: "plugin plugin-1 is listed more than once"}))
+			})
+		})
+	})
+
+	Describe("ReadPluginLockfile and WritePluginLockfile", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(tempDir, "plugins.lock")
+		})
+
+		Context("when the lockfile does not exist", func() {
+			It("returns no plugins", func() {
+				locked, err := actor.ReadPluginLockfile(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(locked).To(BeEmpty())
+			})
+		})
+
+		It("reads back the plugins that were written", func() {
+			locked := []LockedPlugin{
+				{
+					Name: "plugin-1", Version: "1.2.3", Repository: "repo-1",
+					Binaries: map[string]LockedPluginBinary{
+						"linux64": {URL: "https://example.com/plugin-1", Checksum: "some-checksum"},
+						"osx":     {URL: "https://example.com/plugin-1-osx", Checksum: "osx-checksum"},
+					},
+				},
+				{
+					Name: "plugin-2", Version: "2.0.0", Repository: "repo-2",
+					Binaries: map[string]LockedPluginBinary{
+						"linux64": {URL: "https://example.com/plugin-2", Checksum: "other-checksum", SignatureURL: "https://example.com/plugin-2.sig"},
+					},
+				},
+			}
+			Expect(actor.WritePluginLockfile(path, locked)).To(Succeed())
+
+			readLocked, err := actor.ReadPluginLockfile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readLocked).To(Equal(locked))
+		})
+
+		It("replaces the lockfile without leaving temporary files behind", func() {
+			Expect(ioutil.WriteFile(path, []byte("some-old-lockfile"), 0644)).To(Succeed())
+
+			Expect(actor.WritePluginLockfile(path, []LockedPlugin{{Name: "plugin-1", Version: "1.0.0"}})).To(Succeed())
+
+			readLocked, err := actor.ReadPluginLockfile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readLocked).To(HaveLen(1))
+			Expect(readLocked[0].Version).To(Equal("1.0.0"))
+
+			files, err := ioutil.ReadDir(tempDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+		})
+	})
+
+	Describe("ResolvePluginSet", func() {
+		var (
+			entries  []PluginSetEntry
+			locked   []LockedPlugin
+			update   bool
+			resolved []LockedPlugin
+			err      error
+		)
+
+		BeforeEach(func() {
+			entries = []PluginSetEntry{{Name: "plugin-1"}}
+			locked = nil
+			update = false
+
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "repo-1", URL: "https://repo-1.example.com"},
+				{Name: "repo-2", URL: "https://repo-2.example.com"},
+			})
+			fakeClient.GetPluginRepositoryStub = func(url string) (plugin.PluginRepository, error) {
+				if url == "https://repo-2.example.com" {
+					return plugin.PluginRepository{}, nil
+				}
+				return plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{
+							Name:    "plugin-1",
+							Version: "1.5.0",
+							Binaries: []plugin.PluginBinary{
+								{Platform: "linux64", URL: "https://example.com/plugin-1", Checksum: "some-checksum"},
+							},
+						},
+					},
+				}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			resolved, err = actor.ResolvePluginSet(entries, locked, "linux64", update)
+		})
+
+		Context("when nothing is locked", func() {
+			It("resolves the newest version from the repositories", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resolved).To(Equal([]LockedPlugin{
+					{
+						Name: "plugin-1", Version: "1.5.0", Repository: "repo-1",
+						Binaries: map[string]LockedPluginBinary{
+							"linux64": {URL: "https://example.com/plugin-1", Checksum: "some-checksum"},
+						},
+					},
+				}))
+				Expect(fakeClient.GetPluginRepositoryCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the entry names a repository", func() {
+			BeforeEach(func() {
+				entries[0].Repository = "REPO-1"
+			})
+
+			It("only searches that repository", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resolved).To(HaveLen(1))
+				Expect(fakeClient.GetPluginRepositoryCallCount()).To(Equal(1))
+				Expect(fakeClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://repo-1.example.com"))
+			})
+
+			Context("when the repository is not registered", func() {
+				BeforeEach(func() {
+					entries[0].Repository = "repo-3"
+				})
+
+				It("returns a RepositoryNotRegisteredError", func() {
+					Expect(err).To(MatchError(RepositoryNotRegisteredError{Name: "repo-3"}))
+				})
+			})
+		})
+
+		Context("when the entry has a version constraint", func() {
+			Context("when the newest version satisfies it", func() {
+				BeforeEach(func() {
+					entries[0].Version = ">=1.0.0 <2.0.0"
+				})
+
+				It("resolves the newest version", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resolved[0].Version).To(Equal("1.5.0"))
+				})
+			})
+
+			Context("when the newest version does not satisfy it", func() {
+				BeforeEach(func() {
+					entries[0].Version = "<1.5.0"
+				})
+
+				It("returns a PluginVersionConstraintNotSatisfiedError", func() {
+					Expect(err).To(MatchError(PluginVersionConstraintNotSatisfiedError{
+						PluginName: "plugin-1",
+						Constraint: "<1.5.0",
+						Version:    "1.5.0",
+					}))
+				})
+			})
+
+			Context("when the constraint is invalid", func() {
+				BeforeEach(func() {
+					entries[0].Version = "not-a-range"
+				})
+
+				It("returns a PluginVersionConstraintInvalidError", func() {
+					Expect(err).To(MatchError(PluginVersionConstraintInvalidError{
+						PluginName: "plugin-1",
+						Constraint: "not-a-range",
+					}))
+				})
+			})
+		})
+
+		Context("when the plugin is locked for this platform", func() {
+			BeforeEach(func() {
+				locked = []LockedPlugin{
+					{
+						Name: "plugin-1", Version: "1.0.0", Repository: "repo-1",
+						Binaries: map[string]LockedPluginBinary{
+							"linux64": {URL: "https://example.com/plugin-1-old", Checksum: "old-checksum"},
+						},
+					},
+				}
+			})
+
+			It("keeps the locked version without searching the repositories", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resolved).To(Equal(locked))
+				Expect(fakeClient.GetPluginRepositoryCallCount()).To(Equal(0))
+			})
+
+			Context("when update is true", func() {
+				BeforeEach(func() {
+					update = true
+				})
+
+				It("resolves the newest version", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resolved[0].Version).To(Equal("1.5.0"))
+				})
+			})
+
+			Context("when the locked version no longer satisfies the constraint", func() {
+				BeforeEach(func() {
+					entries[0].Version = ">=1.1.0"
+				})
+
+				It("resolves the newest version", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resolved[0].Version).To(Equal("1.5.0"))
+				})
+			})
+		})
+
+		Context("when the plugin is locked for another platform", func() {
+			BeforeEach(func() {
+				locked = []LockedPlugin{
+					{
+						Name: "plugin-1", Version: "1.5.0", Repository: "repo-1",
+						Binaries: map[string]LockedPluginBinary{
+							"osx": {URL: "https://example.com/plugin-1-osx", Checksum: "osx-checksum"},
+						},
+					},
+				}
+			})
+
+			It("adds the binary for this platform and keeps the binary for the other platform", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resolved[0].Binaries).To(Equal(map[string]LockedPluginBinary{
+					"linux64": {URL: "https://example.com/plugin-1", Checksum: "some-checksum"},
+					"osx":     {URL: "https://example.com/plugin-1-osx", Checksum: "osx-checksum"},
+				}))
+			})
+
+			Context("when the plugin is locked for both platforms", func() {
+				BeforeEach(func() {
+					locked[0].Binaries["linux64"] = LockedPluginBinary{URL: "https://example.com/plugin-1-locked", Checksum: "locked-checksum"}
+				})
+
+				It("keeps both binaries without searching the repositories", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resolved).To(Equal(locked))
+					Expect(fakeClient.GetPluginRepositoryCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the repositories provide a different version", func() {
+				BeforeEach(func() {
+					locked[0].Version = "1.0.0"
+				})
+
+				It("returns a LockedPluginVersionUnavailableError", func() {
+					Expect(err).To(MatchError(LockedPluginVersionUnavailableError{
+						PluginName:    "plugin-1",
+						LockedVersion: "1.0.0",
+						Version:       "1.5.0",
+					}))
+				})
+			})
+		})
+
+		Context("when the plugin is not in any repository", func() {
+			BeforeEach(func() {
+				entries[0].Name = "plugin-3"
+			})
+
+			It("returns a PluginNotFoundInAnyRepositoryError", func() {
+				Expect(err).To(MatchError(PluginNotFoundInAnyRepositoryError{PluginName: "plugin-3"}))
+			})
+		})
+	})
+})
@@ -20,6 +20,12 @@ type FakeConfig struct {
 		repoName string
 		repoURL  string
 	}
+	AddPluginTrustedKeyStub        func(name string, publicKey string)
+	addPluginTrustedKeyMutex       sync.RWMutex
+	addPluginTrustedKeyArgsForCall []struct {
+		name      string
+		publicKey string
+	}
 	GetPluginStub        func(pluginName string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -51,6 +57,15 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginTrustedKeysStub        func() []configv3.PluginTrustedKey
+	pluginTrustedKeysMutex       sync.RWMutex
+	pluginTrustedKeysArgsForCall []struct{}
+	pluginTrustedKeysReturns     struct {
+		result1 []configv3.PluginTrustedKey
+	}
+	pluginTrustedKeysReturnsOnCall map[int]struct {
+		result1 []configv3.PluginTrustedKey
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct{}
@@ -65,6 +80,11 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemovePluginTrustedKeyStub        func(name string)
+	removePluginTrustedKeyMutex       sync.RWMutex
+	removePluginTrustedKeyArgsForCall []struct {
+		name string
+	}
 	WritePluginConfigStub        func() error
 	writePluginConfigMutex       sync.RWMutex
 	writePluginConfigArgsForCall []struct{}
@@ -127,6 +147,31 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return fake.addPluginRepositoryArgsForCall[i].repoName, fake.addPluginRepositoryArgsForCall[i].repoURL
 }
 
+func (fake *FakeConfig) AddPluginTrustedKey(name string, publicKey string) {
+	fake.addPluginTrustedKeyMutex.Lock()
+	fake.addPluginTrustedKeyArgsForCall = append(fake.addPluginTrustedKeyArgsForCall, struct {
+		name      string
+		publicKey string
+	}{name, publicKey})
+	fake.recordInvocation("AddPluginTrustedKey", []interface{}{name, publicKey})
+	fake.addPluginTrustedKeyMutex.Unlock()
+	if fake.AddPluginTrustedKeyStub != nil {
+		fake.AddPluginTrustedKeyStub(name, publicKey)
+	}
+}
+
+func (fake *FakeConfig) AddPluginTrustedKeyCallCount() int {
+	fake.addPluginTrustedKeyMutex.RLock()
+	defer fake.addPluginTrustedKeyMutex.RUnlock()
+	return len(fake.addPluginTrustedKeyArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginTrustedKeyArgsForCall(i int) (string, string) {
+	fake.addPluginTrustedKeyMutex.RLock()
+	defer fake.addPluginTrustedKeyMutex.RUnlock()
+	return fake.addPluginTrustedKeyArgsForCall[i].name, fake.addPluginTrustedKeyArgsForCall[i].publicKey
+}
+
 func (fake *FakeConfig) GetPlugin(pluginName string) (configv3.Plugin, bool) {
 	fake.getPluginMutex.Lock()
 	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
@@ -258,6 +303,46 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginTrustedKeys() []configv3.PluginTrustedKey {
+	fake.pluginTrustedKeysMutex.Lock()
+	ret, specificReturn := fake.pluginTrustedKeysReturnsOnCall[len(fake.pluginTrustedKeysArgsForCall)]
+	fake.pluginTrustedKeysArgsForCall = append(fake.pluginTrustedKeysArgsForCall, struct{}{})
+	fake.recordInvocation("PluginTrustedKeys", []interface{}{})
+	fake.pluginTrustedKeysMutex.Unlock()
+	if fake.PluginTrustedKeysStub != nil {
+		return fake.PluginTrustedKeysStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pluginTrustedKeysReturns.result1
+}
+
+func (fake *FakeConfig) PluginTrustedKeysCallCount() int {
+	fake.pluginTrustedKeysMutex.RLock()
+	defer fake.pluginTrustedKeysMutex.RUnlock()
+	return len(fake.pluginTrustedKeysArgsForCall)
+}
+
+func (fake *FakeConfig) PluginTrustedKeysReturns(result1 []configv3.PluginTrustedKey) {
+	fake.PluginTrustedKeysStub = nil
+	fake.pluginTrustedKeysReturns = struct {
+		result1 []configv3.PluginTrustedKey
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginTrustedKeysReturnsOnCall(i int, result1 []configv3.PluginTrustedKey) {
+	fake.PluginTrustedKeysStub = nil
+	if fake.pluginTrustedKeysReturnsOnCall == nil {
+		fake.pluginTrustedKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.PluginTrustedKey
+		})
+	}
+	fake.pluginTrustedKeysReturnsOnCall[i] = struct {
+		result1 []configv3.PluginTrustedKey
+	}{result1}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -322,6 +407,30 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RemovePluginTrustedKey(name string) {
+	fake.removePluginTrustedKeyMutex.Lock()
+	fake.removePluginTrustedKeyArgsForCall = append(fake.removePluginTrustedKeyArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemovePluginTrustedKey", []interface{}{name})
+	fake.removePluginTrustedKeyMutex.Unlock()
+	if fake.RemovePluginTrustedKeyStub != nil {
+		fake.RemovePluginTrustedKeyStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemovePluginTrustedKeyCallCount() int {
+	fake.removePluginTrustedKeyMutex.RLock()
+	defer fake.removePluginTrustedKeyMutex.RUnlock()
+	return len(fake.removePluginTrustedKeyArgsForCall)
+}
+
+func (fake *FakeConfig) RemovePluginTrustedKeyArgsForCall(i int) string {
+	fake.removePluginTrustedKeyMutex.RLock()
+	defer fake.removePluginTrustedKeyMutex.RUnlock()
+	return fake.removePluginTrustedKeyArgsForCall[i].name
+}
+
 func (fake *FakeConfig) WritePluginConfig() error {
 	fake.writePluginConfigMutex.Lock()
 	ret, specificReturn := fake.writePluginConfigReturnsOnCall[len(fake.writePluginConfigArgsForCall)]
@@ -369,16 +478,22 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addPluginTrustedKeyMutex.RLock()
+	defer fake.addPluginTrustedKeyMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
+	fake.pluginTrustedKeysMutex.RLock()
+	defer fake.pluginTrustedKeysMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removePluginTrustedKeyMutex.RLock()
+	defer fake.removePluginTrustedKeyMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -35,6 +35,19 @@ type FakePluginClient struct {
 	downloadPluginReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DownloadPluginSignatureStub        func(signatureURL string) ([]byte, error)
+	downloadPluginSignatureMutex       sync.RWMutex
+	downloadPluginSignatureArgsForCall []struct {
+		signatureURL string
+	}
+	downloadPluginSignatureReturns struct {
+		result1 []byte
+		result2 error
+	}
+	downloadPluginSignatureReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -140,6 +153,57 @@ func (fake *FakePluginClient) DownloadPluginReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakePluginClient) DownloadPluginSignature(signatureURL string) ([]byte, error) {
+	fake.downloadPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.downloadPluginSignatureReturnsOnCall[len(fake.downloadPluginSignatureArgsForCall)]
+	fake.downloadPluginSignatureArgsForCall = append(fake.downloadPluginSignatureArgsForCall, struct {
+		signatureURL string
+	}{signatureURL})
+	fake.recordInvocation("DownloadPluginSignature", []interface{}{signatureURL})
+	fake.downloadPluginSignatureMutex.Unlock()
+	if fake.DownloadPluginSignatureStub != nil {
+		return fake.DownloadPluginSignatureStub(signatureURL)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.downloadPluginSignatureReturns.result1, fake.downloadPluginSignatureReturns.result2
+}
+
+func (fake *FakePluginClient) DownloadPluginSignatureCallCount() int {
+	fake.downloadPluginSignatureMutex.RLock()
+	defer fake.downloadPluginSignatureMutex.RUnlock()
+	return len(fake.downloadPluginSignatureArgsForCall)
+}
+
+func (fake *FakePluginClient) DownloadPluginSignatureArgsForCall(i int) string {
+	fake.downloadPluginSignatureMutex.RLock()
+	defer fake.downloadPluginSignatureMutex.RUnlock()
+	return fake.downloadPluginSignatureArgsForCall[i].signatureURL
+}
+
+func (fake *FakePluginClient) DownloadPluginSignatureReturns(result1 []byte, result2 error) {
+	fake.DownloadPluginSignatureStub = nil
+	fake.downloadPluginSignatureReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginClient) DownloadPluginSignatureReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.DownloadPluginSignatureStub = nil
+	if fake.downloadPluginSignatureReturnsOnCall == nil {
+		fake.downloadPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.downloadPluginSignatureReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePluginClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -147,6 +211,8 @@ func (fake *FakePluginClient) Invocations() map[string][][]interface{} {
 	defer fake.getPluginRepositoryMutex.RUnlock()
 	fake.downloadPluginMutex.RLock()
 	defer fake.downloadPluginMutex.RUnlock()
+	fake.downloadPluginSignatureMutex.RLock()
+	defer fake.downloadPluginSignatureMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,280 @@
+package pluginaction
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/generic"
+)
+
+// PluginRepositoryIndexFilename is the name of the index file in a plugin
+// repository directory. The plugin client requests it by appending /list to
+// the repository URL.
+const PluginRepositoryIndexFilename = "list"
+
+// PluginBinaryInvalidError is returned when a binary for this platform does
+// not respond to the plugin metadata handshake.
+type PluginBinaryInvalidError struct {
+	Path string
+}
+
+func (e PluginBinaryInvalidError) Error() string {
+	return fmt.Sprintf("%s is not a valid cf CLI plugin binary", e.Path)
+}
+
+// PluginBinaryNotInspectableError is returned when a binary is built for
+// another platform and there is no binary for this platform with the same
+// name to read its metadata from.
+type PluginBinaryNotInspectableError struct {
+	Path     string
+	Platform string
+}
+
+func (e PluginBinaryNotInspectableError) Error() string {
+	return fmt.Sprintf("%s is built for %s and has no binary for this platform to read its metadata from", e.Path, e.Platform)
+}
+
+// DuplicatePluginError is returned when binaries with different names contain
+// the same plugin.
+type DuplicatePluginError struct {
+	PluginName string
+}
+
+func (e DuplicatePluginError) Error() string {
+	return fmt.Sprintf("Plugin %s is provided by more than one set of binaries", e.PluginName)
+}
+
+// DuplicatePluginBinaryError is returned when a plugin has more than one
+// binary for a platform.
+type DuplicatePluginBinaryError struct {
+	PluginName string
+	Platform   string
+}
+
+func (e DuplicatePluginBinaryError) Error() string {
+	return fmt.Sprintf("Plugin %s has more than one binary for %s", e.PluginName, e.Platform)
+}
+
+// binaryNameParts are the parts of a binary's file name that name its
+// platform, such as the "linux_amd64" in "my-plugin_linux_amd64".
+var binaryNameParts = map[string]bool{
+	"386":     true,
+	"amd64":   true,
+	"darwin":  true,
+	"i386":    true,
+	"linux":   true,
+	"linux32": true,
+	"linux64": true,
+	"macos":   true,
+	"osx":     true,
+	"win32":   true,
+	"win64":   true,
+	"windows": true,
+	"x86":     true,
+}
+
+type pluginBinaryFile struct {
+	name     string
+	path     string
+	platform string
+}
+
+// CreatePluginRepositoryIndex builds a plugin repository index from the plugin
+// binaries in dir. Binaries whose names only differ in platform, such as
+// my-plugin_linux_amd64 and my-plugin_windows_amd64.exe, are binaries of the
+// same plugin; its name, version and commands are read from the binary for
+// the specified platform with the metadata handshake. Binary URLs are baseURL
+// followed by the file name.
+func (actor Actor) CreatePluginRepositoryIndex(metadata PluginMetadata, dir string, baseURL string, platform string, tempPluginDir string) (plugin.PluginRepository, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+
+	binariesByStem := map[string][]pluginBinaryFile{}
+	var stems []string
+	for _, file := range files {
+		if !file.Mode().IsRegular() || file.Name() == PluginRepositoryIndexFilename || strings.HasSuffix(file.Name(), ".sig") {
+			continue
+		}
+
+		path := filepath.Join(dir, file.Name())
+		binaryPlatform := getBinaryPlatform(path)
+		if binaryPlatform == "" {
+			continue
+		}
+
+		stem := binaryStem(file.Name())
+		if _, ok := binariesByStem[stem]; !ok {
+			stems = append(stems, stem)
+		}
+		binariesByStem[stem] = append(binariesByStem[stem], pluginBinaryFile{
+			name:     file.Name(),
+			path:     path,
+			platform: binaryPlatform,
+		})
+	}
+	sort.Strings(stems)
+
+	repository := plugin.PluginRepository{Plugins: []plugin.Plugin{}}
+	pluginNames := map[string]bool{}
+	for _, stem := range stems {
+		binaries := binariesByStem[stem]
+
+		pluginMetadata, err := actor.getBinaryMetadata(metadata, binaries, platform, tempPluginDir)
+		if err != nil {
+			return plugin.PluginRepository{}, err
+		}
+
+		if pluginNames[pluginMetadata.Name] {
+			return plugin.PluginRepository{}, DuplicatePluginError{PluginName: pluginMetadata.Name}
+		}
+		pluginNames[pluginMetadata.Name] = true
+
+		// PluginVersion.String displays unversioned plugins as N/A, which
+		// cannot be compared with other versions.
+		version := pluginMetadata.Version
+		repositoryPlugin := plugin.Plugin{
+			Name:    pluginMetadata.Name,
+			Version: fmt.Sprintf("%d.%d.%d", version.Major, version.Minor, version.Build),
+		}
+
+		sort.Slice(binaries, func(i, j int) bool { return binaries[i].platform < binaries[j].platform })
+		for i, binary := range binaries {
+			if i > 0 && binaries[i-1].platform == binary.platform {
+				return plugin.PluginRepository{}, DuplicatePluginBinaryError{
+					PluginName: pluginMetadata.Name,
+					Platform:   binary.platform,
+				}
+			}
+
+			repositoryPlugin.Binaries = append(repositoryPlugin.Binaries, plugin.PluginBinary{
+				Platform: binary.platform,
+				URL:      strings.TrimSuffix(baseURL, "/") + "/" + url.PathEscape(binary.name),
+				Checksum: configv3.Plugin{Location: binary.path}.CalculateSHA1(),
+			})
+		}
+
+		repository.Plugins = append(repository.Plugins, repositoryPlugin)
+	}
+
+	return repository, nil
+}
+
+// WritePluginRepositoryIndex writes the index to the index file in dir and
+// returns its path.
+func (actor Actor) WritePluginRepositoryIndex(dir string, repository plugin.PluginRepository) (string, error) {
+	raw, err := json.MarshalIndent(repository, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, PluginRepositoryIndexFilename)
+	return path, ioutil.WriteFile(path, raw, 0644)
+}
+
+// ServePluginRepository serves the files in dir, including the index, on the
+// listener until the listener is closed.
+func (actor Actor) ServePluginRepository(dir string, listener net.Listener) error {
+	return http.Serve(listener, http.FileServer(http.Dir(dir)))
+}
+
+func (actor Actor) getBinaryMetadata(metadata PluginMetadata, binaries []pluginBinaryFile, platform string, tempPluginDir string) (configv3.Plugin, error) {
+	for _, binary := range binaries {
+		if binary.platform != platform {
+			continue
+		}
+
+		executablePath, err := actor.CreateExecutableCopy(binary.path, tempPluginDir)
+		if err != nil {
+			return configv3.Plugin{}, err
+		}
+
+		pluginMetadata, err := metadata.GetMetadata(executablePath)
+		if err != nil || pluginMetadata.Name == "" || len(pluginMetadata.Commands) == 0 {
+			return configv3.Plugin{}, PluginBinaryInvalidError{Path: binary.path}
+		}
+		return pluginMetadata, nil
+	}
+
+	return configv3.Plugin{}, PluginBinaryNotInspectableError{
+		Path:     binaries[0].path,
+		Platform: binaries[0].platform,
+	}
+}
+
+// getBinaryPlatform returns the plugin platform an executable is built for,
+// or an empty string if the file is not an executable of a supported
+// platform.
+func getBinaryPlatform(path string) string {
+	if file, err := elf.Open(path); err == nil {
+		defer file.Close()
+		switch file.Machine {
+		case elf.EM_X86_64:
+			return generic.GeneratePlatform("linux", "amd64")
+		case elf.EM_386:
+			return generic.GeneratePlatform("linux", "386")
+		}
+		return ""
+	}
+
+	if file, err := macho.Open(path); err == nil {
+		file.Close()
+		return generic.GeneratePlatform("darwin", "amd64")
+	}
+
+	if file, err := macho.OpenFat(path); err == nil {
+		file.Close()
+		return generic.GeneratePlatform("darwin", "amd64")
+	}
+
+	if file, err := pe.Open(path); err == nil {
+		defer file.Close()
+		switch file.Machine {
+		case pe.IMAGE_FILE_MACHINE_AMD64:
+			return generic.GeneratePlatform("windows", "amd64")
+		case pe.IMAGE_FILE_MACHINE_I386:
+			return generic.GeneratePlatform("windows", "386")
+		}
+	}
+
+	return ""
+}
+
+// binaryStem returns the file name of a binary without its .exe extension
+// and the parts that name its platform.
+func binaryStem(filename string) string {
+	if strings.EqualFold(filepath.Ext(filename), ".exe") {
+		filename = filename[:len(filename)-len(".exe")]
+	}
+
+	parts := strings.FieldsFunc(filename, func(r rune) bool {
+		return r == '_' || r == '-' || r == '.'
+	})
+	for len(parts) > 1 {
+		last := strings.ToLower(parts[len(parts)-1])
+		switch {
+		case binaryNameParts[last]:
+			parts = parts[:len(parts)-1]
+		case last == "64" && len(parts) > 2 && strings.EqualFold(parts[len(parts)-2], "x86"):
+			// x86_64 is split into two parts
+			parts = parts[:len(parts)-2]
+		default:
+			return strings.Join(parts, "-")
+		}
+	}
+
+	return strings.Join(parts, "-")
+}
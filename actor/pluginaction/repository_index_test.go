@@ -0,0 +1,282 @@
+package pluginaction_test
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// The headers below are the smallest files that the debug packages recognize
+// as executables; they are enough to detect the platform of a binary.
+
+func linux64Binary() []byte {
+	header := make([]byte, 64)
+	copy(header, []byte{0x7f, 'E', 'L', 'F', 2, 1, 1})
+	binary.LittleEndian.PutUint16(header[16:], uint16(elf.ET_EXEC))
+	binary.LittleEndian.PutUint16(header[18:], uint16(elf.EM_X86_64))
+	binary.LittleEndian.PutUint32(header[20:], 1)
+	binary.LittleEndian.PutUint16(header[52:], 64)
+	return header
+}
+
+func osxBinary() []byte {
+	header := make([]byte, 32)
+	binary.LittleEndian.PutUint32(header[0:], macho.Magic64)
+	binary.LittleEndian.PutUint32(header[4:], uint32(macho.CpuAmd64))
+	binary.LittleEndian.PutUint32(header[12:], uint32(macho.TypeExec))
+	return header
+}
+
+func win64Binary() []byte {
+	header := make([]byte, 512)
+	header[0], header[1] = 'M', 'Z'
+	binary.LittleEndian.PutUint32(header[0x3c:], 0x40)
+	copy(header[0x40:], []byte{'P', 'E', 0, 0})
+	binary.LittleEndian.PutUint16(header[0x44:], pe.IMAGE_FILE_MACHINE_AMD64)
+	return header
+}
+
+var _ = Describe("plugin repository index actions", func() {
+	var (
+		actor            *Actor
+		fakePluginMeta   *pluginactionfakes.FakePluginMetadata
+		repoDir          string
+		tempPluginDir    string
+		writeRepoFile    func(name string, contents []byte)
+		expectedChecksum func(name string) string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil)
+		fakePluginMeta = new(pluginactionfakes.FakePluginMetadata)
+
+		var err error
+		repoDir, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).ToNot(HaveOccurred())
+		tempPluginDir, err = ioutil.TempDir("", "plugin-repo-temp")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeRepoFile = func(name string, contents []byte) {
+			Expect(ioutil.WriteFile(filepath.Join(repoDir, name), contents, 0600)).To(Succeed())
+		}
+		expectedChecksum = func(name string) string {
+			return configv3.Plugin{Location: filepath.Join(repoDir, name)}.CalculateSHA1()
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(repoDir)
+		os.RemoveAll(tempPluginDir)
+	})
+
+	Describe("CreatePluginRepositoryIndex", func() {
+		var (
+			repository plugin.PluginRepository
+			err        error
+		)
+
+		JustBeforeEach(func() {
+			repository, err = actor.CreatePluginRepositoryIndex(fakePluginMeta, repoDir, "https://plugins.example.com/", "linux64", tempPluginDir)
+		})
+
+		Context("when the directory contains binaries of plugins for several platforms", func() {
+			BeforeEach(func() {
+				writeRepoFile("echo_linux_amd64", linux64Binary())
+				writeRepoFile("echo_darwin_amd64", osxBinary())
+				writeRepoFile("echo_windows_amd64.exe", win64Binary())
+				writeRepoFile("echo_linux_amd64.sig", []byte("some-signature"))
+				writeRepoFile("word-count-linux64", linux64Binary())
+				writeRepoFile("README.md", []byte("not a plugin"))
+				writeRepoFile("list", []byte("{}"))
+
+				fakePluginMeta.GetMetadataStub = func(path string) (configv3.Plugin, error) {
+					contents, err := ioutil.ReadFile(path)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(contents).To(Equal(linux64Binary()))
+
+					if fakePluginMeta.GetMetadataCallCount() == 1 {
+						return configv3.Plugin{
+							Name:     "echo",
+							Version:  configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+							Commands: []configv3.PluginCommand{{Name: "echo"}, {Name: "echo-all"}},
+						}, nil
+					}
+					return configv3.Plugin{
+						Name:     "word-count",
+						Version:  configv3.PluginVersion{Major: 0, Minor: 1},
+						Commands: []configv3.PluginCommand{{Name: "wc"}},
+					}, nil
+				}
+			})
+
+			It("reads the metadata from executable copies of the binaries for this platform", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakePluginMeta.GetMetadataCallCount()).To(Equal(2))
+				Expect(filepath.Dir(fakePluginMeta.GetMetadataArgsForCall(0))).To(Equal(tempPluginDir))
+			})
+
+			It("groups the binaries by name and lists them with their checksums", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(repository).To(Equal(plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{
+							Name:    "echo",
+							Version: "1.2.3",
+							Binaries: []plugin.PluginBinary{
+								{Platform: "linux64", URL: "https://plugins.example.com/echo_linux_amd64", Checksum: expectedChecksum("echo_linux_amd64")},
+								{Platform: "osx", URL: "https://plugins.example.com/echo_darwin_amd64", Checksum: expectedChecksum("echo_darwin_amd64")},
+								{Platform: "win64", URL: "https://plugins.example.com/echo_windows_amd64.exe", Checksum: expectedChecksum("echo_windows_amd64.exe")},
+							},
+						},
+						{
+							Name:    "word-count",
+							Version: "0.1.0",
+							Binaries: []plugin.PluginBinary{
+								{Platform: "linux64", URL: "https://plugins.example.com/word-count-linux64", Checksum: expectedChecksum("word-count-linux64")},
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when a plugin does not report a version", func() {
+			BeforeEach(func() {
+				writeRepoFile("echo_linux_amd64", linux64Binary())
+				fakePluginMeta.GetMetadataReturns(configv3.Plugin{
+					Name:     "echo",
+					Commands: []configv3.PluginCommand{{Name: "echo"}},
+				}, nil)
+			})
+
+			It("lists the plugin with version 0.0.0", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(repository.Plugins).To(HaveLen(1))
+				Expect(repository.Plugins[0].Version).To(Equal("0.0.0"))
+			})
+		})
+
+		Context("when the directory contains no binaries", func() {
+			It("returns an empty index", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(repository.Plugins).To(BeEmpty())
+			})
+		})
+
+		Context("when a binary for this platform is not a plugin", func() {
+			BeforeEach(func() {
+				writeRepoFile("echo_linux_amd64", linux64Binary())
+				fakePluginMeta.GetMetadataReturns(configv3.Plugin{}, errors.New("exit status 1"))
+			})
+
+			It("returns a PluginBinaryInvalidError", func() {
+				Expect(err).To(MatchError(PluginBinaryInvalidError{Path: filepath.Join(repoDir, "echo_linux_amd64")}))
+			})
+		})
+
+		Context("when a plugin has no binary for this platform", func() {
+			BeforeEach(func() {
+				writeRepoFile("echo_darwin_amd64", osxBinary())
+			})
+
+			It("returns a PluginBinaryNotInspectableError", func() {
+				Expect(err).To(MatchError(PluginBinaryNotInspectableError{
+					Path:     filepath.Join(repoDir, "echo_darwin_amd64"),
+					Platform: "osx",
+				}))
+				Expect(fakePluginMeta.GetMetadataCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a plugin has two binaries for the same platform", func() {
+			BeforeEach(func() {
+				writeRepoFile("echo_linux_amd64", linux64Binary())
+				writeRepoFile("echo_linux_x86_64", linux64Binary())
+				fakePluginMeta.GetMetadataReturns(configv3.Plugin{
+					Name:     "echo",
+					Commands: []configv3.PluginCommand{{Name: "echo"}},
+				}, nil)
+			})
+
+			It("returns a DuplicatePluginBinaryError", func() {
+				Expect(err).To(MatchError(DuplicatePluginBinaryError{PluginName: "echo", Platform: "linux64"}))
+			})
+		})
+
+		Context("when binaries with different names contain the same plugin", func() {
+			BeforeEach(func() {
+				writeRepoFile("echo_linux_amd64", linux64Binary())
+				writeRepoFile("echo-copy_linux_amd64", linux64Binary())
+				fakePluginMeta.GetMetadataReturns(configv3.Plugin{
+					Name:     "echo",
+					Commands: []configv3.PluginCommand{{Name: "echo"}},
+				}, nil)
+			})
+
+			It("returns a DuplicatePluginError", func() {
+				Expect(err).To(MatchError(DuplicatePluginError{PluginName: "echo"}))
+			})
+		})
+	})
+
+	Describe("WritePluginRepositoryIndex", func() {
+		It("writes the index to the list file in the directory", func() {
+			repository := plugin.PluginRepository{
+				Plugins: []plugin.Plugin{{Name: "echo", Version: "1.0.0"}},
+			}
+
+			path, err := actor.WritePluginRepositoryIndex(repoDir, repository)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path).To(Equal(filepath.Join(repoDir, "list")))
+
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			var readRepository plugin.PluginRepository
+			Expect(json.Unmarshal(raw, &readRepository)).To(Succeed())
+			Expect(readRepository).To(Equal(repository))
+		})
+	})
+
+	Describe("ServePluginRepository", func() {
+		It("serves the files in the directory until the listener is closed", func() {
+			writeRepoFile("list", []byte(`{"plugins":[]}`))
+
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+
+			serveErr := make(chan error, 1)
+			go func() {
+				defer GinkgoRecover()
+				serveErr <- actor.ServePluginRepository(repoDir, listener)
+			}()
+
+			response, err := http.Get("http://" + listener.Addr().String() + "/list")
+			Expect(err).ToNot(HaveOccurred())
+			body, err := ioutil.ReadAll(response.Body)
+			response.Body.Close()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal(`{"plugins":[]}`))
+
+			listener.Close()
+			Eventually(serveErr).Should(Receive(HaveOccurred()))
+		})
+	})
+})
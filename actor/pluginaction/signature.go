@@ -0,0 +1,129 @@
+package pluginaction
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/util"
+	"golang.org/x/crypto/ed25519"
+)
+
+// PluginSignatureNotFoundError is returned when a plugin binary has no
+// detached signature.
+type PluginSignatureNotFoundError struct {
+	Location string
+}
+
+func (e PluginSignatureNotFoundError) Error() string {
+	return fmt.Sprintf("Plugin signature %s not found", e.Location)
+}
+
+// PluginSignatureInvalidError is returned when the signature of a plugin
+// binary cannot be verified with any of the trusted keys.
+type PluginSignatureInvalidError struct {
+	Location string
+}
+
+func (e PluginSignatureInvalidError) Error() string {
+	return fmt.Sprintf("Plugin signature %s does not match any trusted key", e.Location)
+}
+
+// PluginSignatureUnavailableError is returned when the detached signature of
+// a plugin binary cannot be read or downloaded.
+type PluginSignatureUnavailableError struct {
+	Location string
+	Err      error
+}
+
+func (e PluginSignatureUnavailableError) Error() string {
+	return fmt.Sprintf("Plugin signature %s could not be read: %s", e.Location, e.Err)
+}
+
+// SignatureLocation returns where the detached signature of the plugin binary
+// at binaryLocation, a path or an HTTP(S) URL, is published: next to the
+// binary, with a .sig extension.
+func SignatureLocation(binaryLocation string) string {
+	if util.IsHTTPScheme(binaryLocation) {
+		if parsedURL, err := url.Parse(binaryLocation); err == nil {
+			parsedURL.Path += ".sig"
+			return parsedURL.String()
+		}
+	}
+	return binaryLocation + ".sig"
+}
+
+// ValidateFileSignature verifies the plugin binary at path with the detached
+// signature at signatureLocation, a path or an HTTP(S) URL. The signature is
+// the base64 encoded ed25519 signature of the binary. It returns the name of
+// the trusted key that the signature was verified with.
+func (actor Actor) ValidateFileSignature(path string, signatureLocation string) (string, error) {
+	encodedSignature, err := actor.readSignature(signatureLocation)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encodedSignature)))
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return "", PluginSignatureInvalidError{Location: signatureLocation}
+	}
+
+	binary, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, key := range actor.config.PluginTrustedKeys() {
+		publicKey, err := decodePublicKey(key.PublicKey)
+		if err != nil {
+			continue
+		}
+
+		if ed25519.Verify(publicKey, binary, signature) {
+			return key.Name, nil
+		}
+	}
+
+	return "", PluginSignatureInvalidError{Location: signatureLocation}
+}
+
+func (actor Actor) readSignature(signatureLocation string) ([]byte, error) {
+	if util.IsHTTPScheme(signatureLocation) {
+		signature, err := actor.client.DownloadPluginSignature(signatureLocation)
+		switch err.(type) {
+		case nil:
+			return signature, nil
+		case pluginerror.NotFoundError:
+			return nil, PluginSignatureNotFoundError{Location: signatureLocation}
+		default:
+			return nil, PluginSignatureUnavailableError{Location: signatureLocation, Err: err}
+		}
+	}
+
+	signature, err := ioutil.ReadFile(signatureLocation)
+	switch {
+	case err == nil:
+		return signature, nil
+	case os.IsNotExist(err):
+		return nil, PluginSignatureNotFoundError{Location: signatureLocation}
+	default:
+		return nil, PluginSignatureUnavailableError{Location: signatureLocation, Err: err}
+	}
+}
+
+func decodePublicKey(encodedKey string) (ed25519.PublicKey, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("public key is %d bytes long instead of %d", len(key), ed25519.PublicKeySize)
+	}
+
+	return ed25519.PublicKey(key), nil
+}
@@ -0,0 +1,184 @@
+package pluginaction_test
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ed25519"
+)
+
+var _ = Describe("Signatures", func() {
+	var (
+		actor            *Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+	})
+
+	Describe("SignatureLocation", func() {
+		It("appends .sig to paths", func() {
+			Expect(SignatureLocation("/some/dir/plugin")).To(Equal("/some/dir/plugin.sig"))
+		})
+
+		It("appends .sig to the path of URLs", func() {
+			Expect(SignatureLocation("https://example.com/plugin?platform=linux64")).To(Equal("https://example.com/plugin.sig?platform=linux64"))
+		})
+	})
+
+	Describe("ValidateFileSignature", func() {
+		var (
+			tempDir       string
+			binaryPath    string
+			signaturePath string
+			publicKey     ed25519.PublicKey
+			privateKey    ed25519.PrivateKey
+
+			keyName string
+			err     error
+		)
+
+		BeforeEach(func() {
+			tempDir, err = ioutil.TempDir("", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			binaryPath = filepath.Join(tempDir, "plugin")
+			err = ioutil.WriteFile(binaryPath, []byte("some-plugin-binary"), 0700)
+			Expect(err).NotTo(HaveOccurred())
+			signaturePath = binaryPath + ".sig"
+
+			publicKey, privateKey, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeConfig.PluginTrustedKeysReturns([]configv3.PluginTrustedKey{
+				{Name: "other-key", PublicKey: base64.StdEncoding.EncodeToString(otherPublicKey)},
+				{Name: "some-key", PublicKey: base64.StdEncoding.EncodeToString(publicKey)},
+			})
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		sign := func(data []byte) []byte {
+			return []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data)) + "\n")
+		}
+
+		Context("when the signature is a local file", func() {
+			JustBeforeEach(func() {
+				keyName, err = actor.ValidateFileSignature(binaryPath, signaturePath)
+			})
+
+			Context("when the binary is signed with a trusted key", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(signaturePath, sign([]byte("some-plugin-binary")), 0600)).To(Succeed())
+				})
+
+				It("returns the name of the key", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(keyName).To(Equal("some-key"))
+				})
+			})
+
+			Context("when the signature does not exist", func() {
+				It("returns a PluginSignatureNotFoundError", func() {
+					Expect(err).To(MatchError(PluginSignatureNotFoundError{Location: signaturePath}))
+				})
+			})
+
+			Context("when the binary has been modified", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(signaturePath, sign([]byte("another-plugin-binary")), 0600)).To(Succeed())
+				})
+
+				It("returns a PluginSignatureInvalidError", func() {
+					Expect(err).To(MatchError(PluginSignatureInvalidError{Location: signaturePath}))
+				})
+			})
+
+			Context("when the signature is not base64 encoded", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(signaturePath, []byte("not a signature"), 0600)).To(Succeed())
+				})
+
+				It("returns a PluginSignatureInvalidError", func() {
+					Expect(err).To(MatchError(PluginSignatureInvalidError{Location: signaturePath}))
+				})
+			})
+
+			Context("when no key is trusted", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(signaturePath, sign([]byte("some-plugin-binary")), 0600)).To(Succeed())
+					fakeConfig.PluginTrustedKeysReturns(nil)
+				})
+
+				It("returns a PluginSignatureInvalidError", func() {
+					Expect(err).To(MatchError(PluginSignatureInvalidError{Location: signaturePath}))
+				})
+			})
+		})
+
+		Context("when the signature is a URL", func() {
+			var signatureURL string
+
+			BeforeEach(func() {
+				signatureURL = "https://example.com/plugin.sig"
+			})
+
+			JustBeforeEach(func() {
+				keyName, err = actor.ValidateFileSignature(binaryPath, signatureURL)
+			})
+
+			Context("when the binary is signed with a trusted key", func() {
+				BeforeEach(func() {
+					fakePluginClient.DownloadPluginSignatureReturns(sign([]byte("some-plugin-binary")), nil)
+				})
+
+				It("downloads the signature and returns the name of the key", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(keyName).To(Equal("some-key"))
+
+					Expect(fakePluginClient.DownloadPluginSignatureCallCount()).To(Equal(1))
+					Expect(fakePluginClient.DownloadPluginSignatureArgsForCall(0)).To(Equal(signatureURL))
+				})
+			})
+
+			Context("when the signature does not exist", func() {
+				BeforeEach(func() {
+					fakePluginClient.DownloadPluginSignatureReturns(nil, pluginerror.NotFoundError{URL: signatureURL})
+				})
+
+				It("returns a PluginSignatureNotFoundError", func() {
+					Expect(err).To(MatchError(PluginSignatureNotFoundError{Location: signatureURL}))
+				})
+			})
+
+			Context("when downloading the signature fails", func() {
+				BeforeEach(func() {
+					fakePluginClient.DownloadPluginSignatureReturns(nil, errors.New("connection refused"))
+				})
+
+				It("returns a PluginSignatureUnavailableError", func() {
+					Expect(err).To(MatchError(PluginSignatureUnavailableError{Location: signatureURL, Err: errors.New("connection refused")}))
+				})
+			})
+		})
+	})
+})
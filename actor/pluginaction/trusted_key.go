@@ -0,0 +1,75 @@
+package pluginaction
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// PluginKeyInvalidError is returned when a public key is not a base64 encoded
+// ed25519 public key.
+type PluginKeyInvalidError struct {
+	Name string
+}
+
+func (e PluginKeyInvalidError) Error() string {
+	return fmt.Sprintf("Key %s is not a base64 encoded ed25519 public key", e.Name)
+}
+
+// PluginKeyNameTakenError is returned when a different key is already trusted
+// under the same name.
+type PluginKeyNameTakenError struct {
+	Name string
+}
+
+func (e PluginKeyNameTakenError) Error() string {
+	return fmt.Sprintf("Plugin key named '%s' already exists, please use another name.", e.Name)
+}
+
+// PluginKeyNotFoundError is returned when no trusted key has the given name.
+type PluginKeyNotFoundError struct {
+	Name string
+}
+
+func (e PluginKeyNotFoundError) Error() string {
+	return fmt.Sprintf("Plugin key %s not found", e.Name)
+}
+
+// AddPluginTrustedKey trusts publicKey for verifying plugin signatures. Adding
+// the same key under the same name again does nothing.
+func (actor Actor) AddPluginTrustedKey(keyName string, publicKey string) error {
+	if _, err := decodePublicKey(publicKey); err != nil {
+		return PluginKeyInvalidError{Name: keyName}
+	}
+
+	for _, key := range actor.config.PluginTrustedKeys() {
+		if strings.EqualFold(key.Name, keyName) {
+			if key.PublicKey == publicKey {
+				return nil
+			}
+			return PluginKeyNameTakenError{Name: key.Name}
+		}
+	}
+
+	actor.config.AddPluginTrustedKey(keyName, publicKey)
+	return nil
+}
+
+// GetPluginTrustedKeys returns the keys trusted for verifying plugin
+// signatures.
+func (actor Actor) GetPluginTrustedKeys() []configv3.PluginTrustedKey {
+	return actor.config.PluginTrustedKeys()
+}
+
+// RemovePluginTrustedKey stops trusting the key with the given name.
+func (actor Actor) RemovePluginTrustedKey(keyName string) error {
+	for _, key := range actor.config.PluginTrustedKeys() {
+		if strings.EqualFold(key.Name, keyName) {
+			actor.config.RemovePluginTrustedKey(key.Name)
+			return nil
+		}
+	}
+
+	return PluginKeyNotFoundError{Name: keyName}
+}
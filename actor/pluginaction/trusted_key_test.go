@@ -0,0 +1,81 @@
+package pluginaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Trusted Key Actions", func() {
+	const publicKey = "TNsqVpXWtS1iN6ZxtgCSrMMgpUF8iPn6wlcdGKTXkjU="
+
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig, nil)
+
+		fakeConfig.PluginTrustedKeysReturns([]configv3.PluginTrustedKey{
+			{Name: "Existing-Key", PublicKey: publicKey},
+		})
+	})
+
+	Describe("AddPluginTrustedKey", func() {
+		It("adds the key to the config", func() {
+			err := actor.AddPluginTrustedKey("some-key", publicKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeConfig.AddPluginTrustedKeyCallCount()).To(Equal(1))
+			name, key := fakeConfig.AddPluginTrustedKeyArgsForCall(0)
+			Expect(name).To(Equal("some-key"))
+			Expect(key).To(Equal(publicKey))
+		})
+
+		Context("when the key is not an ed25519 public key", func() {
+			It("returns a PluginKeyInvalidError", func() {
+				err := actor.AddPluginTrustedKey("some-key", "c2hvcnQ=")
+				Expect(err).To(MatchError(PluginKeyInvalidError{Name: "some-key"}))
+				Expect(fakeConfig.AddPluginTrustedKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the same key is already trusted under the name", func() {
+			It("does nothing", func() {
+				err := actor.AddPluginTrustedKey("existing-key", publicKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeConfig.AddPluginTrustedKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when another key is already trusted under the name", func() {
+			It("returns a PluginKeyNameTakenError", func() {
+				err := actor.AddPluginTrustedKey("existing-key", "3vVYxTsrCW0W/4CDEGbyn+pkVPhGJtc2tjSMwGCWQsA=")
+				Expect(err).To(MatchError(PluginKeyNameTakenError{Name: "Existing-Key"}))
+				Expect(fakeConfig.AddPluginTrustedKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RemovePluginTrustedKey", func() {
+		It("removes the key, ignoring the case of its name", func() {
+			err := actor.RemovePluginTrustedKey("existing-key")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeConfig.RemovePluginTrustedKeyCallCount()).To(Equal(1))
+			Expect(fakeConfig.RemovePluginTrustedKeyArgsForCall(0)).To(Equal("Existing-Key"))
+		})
+
+		Context("when the key does not exist", func() {
+			It("returns a PluginKeyNotFoundError", func() {
+				err := actor.RemovePluginTrustedKey("some-key")
+				Expect(err).To(MatchError(PluginKeyNotFoundError{Name: "some-key"}))
+				Expect(fakeConfig.RemovePluginTrustedKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,128 @@
+package sharedaction
+
+import (
+	"reflect"
+	"sort"
+)
+
+// CompletionResource is a kind of resource whose names can be suggested when
+// completing a command's arguments.
+type CompletionResource string
+
+const (
+	NoCompletionResource      CompletionResource = ""
+	AppCompletionResource     CompletionResource = "apps"
+	OrgCompletionResource     CompletionResource = "orgs"
+	ServiceCompletionResource CompletionResource = "services"
+	SpaceCompletionResource   CompletionResource = "spaces"
+)
+
+var argumentCompletionResources = map[string]CompletionResource{
+	"APP_NAME":         AppCompletionResource,
+	"ORG":              OrgCompletionResource,
+	"ORG_NAME":         OrgCompletionResource,
+	"SERVICE_INSTANCE": ServiceCompletionResource,
+	"SPACE":            SpaceCompletionResource,
+	"SPACE_NAME":       SpaceCompletionResource,
+}
+
+var flagCompletionResources = map[string]CompletionResource{
+	"Org":          OrgCompletionResource,
+	"Organization": OrgCompletionResource,
+	"Space":        SpaceCompletionResource,
+}
+
+// CommandCompletion contains the details of a command needed to complete it
+// in a shell.
+type CommandCompletion struct {
+	// Name is the command name
+	Name string
+
+	// Description is the command description
+	Description string
+
+	// Alias is the command alias
+	Alias string
+
+	// Flags contains the list of visible flags for this command
+	Flags []CompletionFlag
+
+	// Arguments contains the resource of each positional argument, in order
+	Arguments []CompletionResource
+}
+
+// CompletionFlag contains the details of a command's flag needed to complete
+// it in a shell.
+type CompletionFlag struct {
+	// Short is the short form of the flag
+	Short string
+
+	// Long is the long form of the flag
+	Long string
+
+	// Description is the description of the flag
+	Description string
+
+	// TakesValue is true when the flag is followed by a value
+	TakesValue bool
+
+	// Resource is the resource the flag's value names
+	Resource CompletionResource
+}
+
+// CommandCompletions returns the completion details of all visible commands
+// in the commandList, sorted by name.
+func (Actor) CommandCompletions(commandList interface{}) []CommandCompletion {
+	handler := reflect.TypeOf(commandList)
+
+	completions := []CommandCompletion{}
+	for i := 0; i < handler.NumField(); i++ {
+		field := handler.Field(i)
+		if field.Tag.Get("command") == "" || field.Tag.Get("hidden") != "" {
+			continue
+		}
+
+		completion := CommandCompletion{
+			Name:        field.Tag.Get("command"),
+			Description: field.Tag.Get("description"),
+			Alias:       field.Tag.Get("alias"),
+			Flags:       []CompletionFlag{},
+			Arguments:   []CompletionResource{},
+		}
+
+		for _, commandField := range commandFields(field.Type) {
+			fieldTag := commandField.Tag
+
+			if fieldTag.Get("positional-args") != "" && commandField.Type.Kind() == reflect.Struct {
+				for k := 0; k < commandField.Type.NumField(); k++ {
+					argName := commandField.Type.Field(k).Tag.Get("positional-arg-name")
+					completion.Arguments = append(completion.Arguments, argumentCompletionResources[argName])
+				}
+				continue
+			}
+
+			if fieldTag.Get("hidden") != "" || (fieldTag.Get("short") == "" && fieldTag.Get("long") == "") {
+				continue
+			}
+
+			completionFlag := CompletionFlag{
+				Short:       fieldTag.Get("short"),
+				Long:        fieldTag.Get("long"),
+				Description: fieldTag.Get("description"),
+				TakesValue:  commandField.Type.Kind() != reflect.Bool,
+			}
+			if completionFlag.TakesValue {
+				completionFlag.Resource = flagCompletionResources[commandField.Name]
+			}
+			completion.Flags = append(completion.Flags, completionFlag)
+		}
+
+		completions = append(completions, completion)
+	}
+
+	sort.Slice(completions, func(i int, j int) bool {
+		return completions[i].Name < completions[j].Name
+	})
+
+	return completions
+}
@@ -0,0 +1,69 @@
+package sharedaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type completionCommandList struct {
+	Verbose  bool            `short:"v" description:"verbose and version flag"`
+	Target   targetCommand   `command:"target" alias:"t" description:"Set or view the targeted org or space"`
+	Scale    scaleCommand    `command:"scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
+	Internal internalCommand `command:"internal" hidden:"true" description:"Internal command"`
+}
+
+type targetCommand struct {
+	Organization string      `short:"o" description:"Organization"`
+	Space        string      `short:"s" description:"Space"`
+	usage        interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
+}
+
+type scaleArgs struct {
+	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Size    string `positional-arg-name:"SIZE" description:"The size"`
+}
+
+type scaleCommand struct {
+	RequiredArgs scaleArgs `positional-args:"yes"`
+	Instances    int       `short:"i" description:"Number of instances"`
+	Force        bool      `short:"f" long:"force" description:"Force restart of app without prompt"`
+	SkipChecks   bool      `long:"skip-checks" hidden:"true" description:"Skip checks"`
+}
+
+type internalCommand struct{}
+
+var _ = Describe("Completion Actions", func() {
+	var actor *Actor
+
+	BeforeEach(func() {
+		actor = NewActor()
+	})
+
+	Describe("CommandCompletions", func() {
+		It("returns the visible commands sorted by name with their flags and arguments", func() {
+			Expect(actor.CommandCompletions(completionCommandList{})).To(Equal([]CommandCompletion{
+				{
+					Name:        "scale",
+					Description: "Change or view the instance count, disk space limit, and memory limit for an app",
+					Flags: []CompletionFlag{
+						{Short: "i", Description: "Number of instances", TakesValue: true},
+						{Short: "f", Long: "force", Description: "Force restart of app without prompt"},
+					},
+					Arguments: []CompletionResource{AppCompletionResource, NoCompletionResource},
+				},
+				{
+					Name:        "target",
+					Description: "Set or view the targeted org or space",
+					Alias:       "t",
+					Flags: []CompletionFlag{
+						{Short: "o", Description: "Organization", TakesValue: true, Resource: OrgCompletionResource},
+						{Short: "s", Description: "Space", TakesValue: true, Resource: SpaceCompletionResource},
+					},
+					Arguments: []CompletionResource{},
+				},
+			}))
+		})
+	})
+})
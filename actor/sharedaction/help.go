@@ -88,9 +88,8 @@ func (Actor) CommandInfoByName(commandList interface{}, commandName string) (Com
 		Environment: []EnvironmentVariable{},
 	}
 
-	command := field.Type
-	for i := 0; i < command.NumField(); i++ {
-		fieldTag := command.Field(i).Tag
+	for _, commandField := range commandFields(field.Type) {
+		fieldTag := commandField.Tag
 
 		if fieldTag.Get("hidden") != "" {
 			continue
@@ -147,3 +146,18 @@ func (Actor) CommandInfos(commandList interface{}) map[string]CommandInfo {
 
 	return infos
 }
+
+// commandFields returns the fields of a command, including the fields of the
+// structs it embeds, such as flags shared between commands.
+func commandFields(command reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+	for i := 0; i < command.NumField(); i++ {
+		field := command.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			fields = append(fields, commandFields(field.Type)...)
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
@@ -24,6 +24,15 @@ type restageCommand struct {
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 }
 
+type listFlags struct {
+	SortBy string `long:"sort-by" description:"Sort the rows by COLUMN"`
+}
+
+type listCommand struct {
+	listFlags
+	usage interface{} `usage:"CF_NAME list"`
+}
+
 type helpCommand struct {
 	AllCommands bool        `short:"a" description:"All available CLI commands"`
 	usage       interface{} `usage:"CF_NAME help [COMMAND]"`
@@ -108,6 +117,23 @@ var _ = Describe("Help Actions", func() {
 			})
 		})
 
+		Context("when the command embeds flags", func() {
+			It("returns the embedded flags", func() {
+				commandInfo, err := actor.CommandInfoByName(struct {
+					List listCommand `command:"list" description:"List things"`
+				}{}, "list")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(commandInfo.Usage).To(Equal("CF_NAME list"))
+				Expect(commandInfo.Flags).To(ConsistOf(
+					CommandFlag{
+						Long:        "sort-by",
+						Description: "Sort the rows by COLUMN",
+					},
+				))
+			})
+		})
+
 		Context("when the command does not exist", func() {
 			It("returns err", func() {
 				_, err := actor.CommandInfoByName(commandList{}, "does-not-exist")
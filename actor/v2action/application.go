@@ -311,7 +311,7 @@ func (actor Actor) SetApplicationHealthCheckTypeByNameAndSpace(name string, spac
 // StartApplication restarts a given application. If already stopped, no stop
 // call will be sent.
 func (actor Actor) StartApplication(app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan ApplicationStateChange, <-chan string, <-chan error) {
-	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config)
+	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config, LogMessageFilter{})
 
 	appState := make(chan ApplicationStateChange)
 	allWarnings := make(chan string)
@@ -348,7 +348,7 @@ func (actor Actor) StartApplication(app Application, client NOAAClient, config C
 // RestartApplication restarts a given application. If already stopped, no stop
 // call will be sent.
 func (actor Actor) RestartApplication(app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan ApplicationStateChange, <-chan string, <-chan error) {
-	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config)
+	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config, LogMessageFilter{})
 
 	appState := make(chan ApplicationStateChange)
 	allWarnings := make(chan string)
@@ -400,7 +400,7 @@ func (actor Actor) RestartApplication(app Application, client NOAAClient, config
 // RestageApplication restarts a given application. If already stopped, no stop
 // call will be sent.
 func (actor Actor) RestageApplication(app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan ApplicationStateChange, <-chan string, <-chan error) {
-	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config)
+	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config, LogMessageFilter{})
 
 	appState := make(chan ApplicationStateChange)
 	allWarnings := make(chan string)
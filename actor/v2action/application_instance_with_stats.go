@@ -49,6 +49,11 @@ func newApplicationInstanceWithStats(id int) ApplicationInstanceWithStats {
 	return ApplicationInstanceWithStats{ID: id}
 }
 
+// Running returns true when the instance is running.
+func (instance ApplicationInstanceWithStats) Running() bool {
+	return instance.State == ApplicationInstanceState(ccv2.ApplicationInstanceRunning)
+}
+
 func (instance ApplicationInstanceWithStats) TimeSinceCreation() time.Time {
 	return time.Unix(int64(instance.Since), 0)
 }
@@ -36,6 +36,22 @@ var _ = Describe("Application Instance With Stats Actions", func() {
 				Expect(instance.TimeSinceCreation()).To(Equal(time.Unix(1485985587, 0)))
 			})
 		})
+
+		Describe("Running", func() {
+			Context("instance is running", func() {
+				It("returns true", func() {
+					instance.State = ApplicationInstanceState(ccv2.ApplicationInstanceRunning)
+					Expect(instance.Running()).To(BeTrue())
+				})
+			})
+
+			Context("instance is *not* running", func() {
+				It("returns false", func() {
+					instance.State = ApplicationInstanceState(ccv2.ApplicationInstanceStarting)
+					Expect(instance.Running()).To(BeFalse())
+				})
+			})
+		})
 	})
 
 	Describe("GetApplicationInstancesWithStatsByApplication", func() {
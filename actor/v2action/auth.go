@@ -1,6 +1,10 @@
 package v2action
 
-import "fmt"
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
 
 // Authenticate authenticates the user in UAA and sets the returned tokens in
 // the config.
@@ -21,3 +25,27 @@ func (actor Actor) Authenticate(config Config, username string, password string)
 	config.SetTokenInformation(accessToken, refreshToken, "")
 	return nil
 }
+
+// AuthenticateClient authenticates a client in UAA using the client
+// credentials grant. On success the client ID and secret are stored in the
+// config, in place of a refresh token, so that new access tokens can be
+// requested once the current one expires.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateClient(config Config, clientID string, clientSecret string) error {
+	config.UnsetOrganizationInformation()
+	config.UnsetSpaceInformation()
+
+	accessToken, err := actor.UAAClient.AuthenticateClient(clientID, clientSecret)
+	if err != nil {
+		config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	accessToken = fmt.Sprintf("bearer %s", accessToken)
+	config.SetTokenInformation(accessToken, "", "")
+	config.SetUAAClientCredentials(clientID, clientSecret)
+	config.SetUAAGrantType(string(uaa.GrantTypeClientCredentials))
+	return nil
+}
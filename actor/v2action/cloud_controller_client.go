@@ -14,6 +14,7 @@ type CloudControllerClient interface {
 	CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	CreateServiceBinding(appGUID string, serviceBindingGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
 	CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
+	Curl(method string, path string, body []byte) (ccv2.CurlResponse, ccv2.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error)
@@ -58,6 +59,8 @@ type CloudControllerClient interface {
 
 	API() string
 	APIVersion() string
+	AppSSHEndpoint() string
+	AppSSHHostKeyFingerprint() string
 	AuthorizationEndpoint() string
 	DopplerEndpoint() string
 	MinCLIVersion() string
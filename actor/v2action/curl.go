@@ -0,0 +1,14 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// CurlResponse is the response of the Cloud Controller to a request made with
+// Curl.
+type CurlResponse ccv2.CurlResponse
+
+// Curl makes a request with method to path on the Cloud Controller, returning
+// the response whatever its status code.
+func (actor Actor) Curl(method string, path string, body []byte) (CurlResponse, Warnings, error) {
+	response, warnings, err := actor.CloudControllerClient.Curl(method, path, body)
+	return CurlResponse(response), Warnings(warnings), err
+}
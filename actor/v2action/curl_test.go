@@ -0,0 +1,60 @@
+package v2action_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Curl Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("Curl", func() {
+		Context("when the request is made", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CurlReturns(
+					ccv2.CurlResponse{StatusCode: http.StatusOK, Body: []byte(`{"resources":[]}`)},
+					ccv2.Warnings{"curl-warning"},
+					nil)
+			})
+
+			It("returns the response and warnings", func() {
+				response, warnings, err := actor.Curl(http.MethodPut, "/v2/apps/some-guid", []byte(`{"state":"STOPPED"}`))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("curl-warning"))
+				Expect(response).To(Equal(CurlResponse{StatusCode: http.StatusOK, Body: []byte(`{"resources":[]}`)}))
+
+				Expect(fakeCloudControllerClient.CurlCallCount()).To(Equal(1))
+				method, path, body := fakeCloudControllerClient.CurlArgsForCall(0)
+				Expect(method).To(Equal(http.MethodPut))
+				Expect(path).To(Equal("/v2/apps/some-guid"))
+				Expect(body).To(Equal([]byte(`{"state":"STOPPED"}`)))
+			})
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CurlReturns(ccv2.CurlResponse{}, ccv2.Warnings{"curl-warning"}, errors.New("connection refused"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.Curl(http.MethodGet, "/v2/apps", nil)
+				Expect(err).To(MatchError("connection refused"))
+				Expect(warnings).To(ConsistOf("curl-warning"))
+			})
+		})
+	})
+})
@@ -1,8 +1,14 @@
 package v2action
 
 import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"code.cloudfoundry.org/cli/types"
 	"github.com/cloudfoundry/noaa"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
@@ -10,6 +16,11 @@ import (
 
 const StagingLog = "STG"
 
+// LogMergeWindow is how long log messages streamed from several apps are held
+// before they are passed on, so that messages that arrive slightly out of
+// order are still passed on in timestamp order.
+const LogMergeWindow = 250 * time.Millisecond
+
 type NOAATimeoutError struct{}
 
 func (NOAATimeoutError) Error() string {
@@ -17,6 +28,7 @@ func (NOAATimeoutError) Error() string {
 }
 
 type LogMessage struct {
+	appName        string
 	message        string
 	messageType    events.LogMessage_MessageType
 	timestamp      time.Time
@@ -24,6 +36,12 @@ type LogMessage struct {
 	sourceInstance string
 }
 
+// AppName returns the name of the app the message is from when it was
+// retrieved together with the logs of other apps, and "" otherwise.
+func (log LogMessage) AppName() string {
+	return log.appName
+}
+
 func (log LogMessage) Message() string {
 	return log.message
 }
@@ -51,6 +69,102 @@ func (log LogMessage) SourceInstance() string {
 	return log.sourceInstance
 }
 
+// LogMessageFilter selects the log messages returned by GetStreamingLogs and
+// GetRecentLogsForApplicationByNameAndSpace. The zero value selects every
+// message.
+type LogMessageFilter struct {
+	// SourceTypes keeps the messages from these sources, such as APP or RTR,
+	// ignoring case. A source also matches its subtypes, so APP matches
+	// APP/PROC/WEB.
+	SourceTypes []string
+	// SourceInstance keeps the messages from this instance.
+	SourceInstance types.NullInt
+	// Pattern keeps the messages that match it.
+	Pattern *regexp.Regexp
+	// Type keeps the messages of this type, either "OUT" or "ERR".
+	Type string
+	// Since keeps the messages written at or after it, unless it is zero.
+	Since time.Time
+	// Until keeps the messages written at or before it, unless it is zero.
+	Until time.Time
+}
+
+// Matches returns true when the filter selects the message.
+func (filter LogMessageFilter) Matches(message LogMessage) bool {
+	if !filter.Since.IsZero() && message.Timestamp().Before(filter.Since) {
+		return false
+	}
+
+	if !filter.Until.IsZero() && message.Timestamp().After(filter.Until) {
+		return false
+	}
+
+	if len(filter.SourceTypes) > 0 && !filter.matchesSourceType(message.SourceType()) {
+		return false
+	}
+
+	if filter.SourceInstance.IsSet && message.SourceInstance() != strconv.Itoa(filter.SourceInstance.Value) {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.Message()) {
+		return false
+	}
+
+	return filter.Type == "" || filter.Type == message.Type()
+}
+
+func (filter LogMessageFilter) matchesSourceType(sourceType string) bool {
+	sourceType = strings.ToUpper(sourceType)
+	for _, wanted := range filter.SourceTypes {
+		wanted = strings.ToUpper(wanted)
+		if sourceType == wanted || strings.HasPrefix(sourceType, wanted+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+type logMessageKey struct {
+	appName        string
+	message        string
+	messageType    events.LogMessage_MessageType
+	timestamp      int64
+	sourceType     string
+	sourceInstance string
+}
+
+// LogMessageSet is a set of log messages. It is used to skip the messages
+// that are streamed again after they were retrieved as recent logs.
+type LogMessageSet map[logMessageKey]struct{}
+
+// NewLogMessageSet returns a set of the messages.
+func NewLogMessageSet(messages []LogMessage) LogMessageSet {
+	set := LogMessageSet{}
+	for _, message := range messages {
+		set[message.key()] = struct{}{}
+	}
+	return set
+}
+
+// Contains returns true when the set contains a message with the same app,
+// text, type, timestamp and source as message.
+func (set LogMessageSet) Contains(message LogMessage) bool {
+	_, ok := set[message.key()]
+	return ok
+}
+
+func (log LogMessage) key() logMessageKey {
+	return logMessageKey{
+		appName:        log.appName,
+		message:        log.message,
+		messageType:    log.messageType,
+		timestamp:      log.timestamp.UnixNano(),
+		sourceType:     log.sourceType,
+		sourceInstance: log.sourceInstance,
+	}
+}
+
 func NewLogMessage(message string, messageType int, timestamp time.Time, sourceType string, sourceInstance string) *LogMessage {
 	return &LogMessage{
 		message:        message,
@@ -61,7 +175,9 @@ func NewLogMessage(message string, messageType int, timestamp time.Time, sourceT
 	}
 }
 
-func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error) {
+// GetStreamingLogs streams the log messages of the app that the filter
+// selects.
+func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config, filter LogMessageFilter) (<-chan *LogMessage, <-chan error) {
 	// Do not pass in token because client should have a TokenRefresher set
 	eventStream, errStream := client.TailingLogs(appGUID, "")
 
@@ -80,13 +196,16 @@ func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config)
 					break dance
 				}
 
-				messages <- &LogMessage{
+				message := &LogMessage{
 					message:        string(event.GetMessage()),
 					messageType:    event.GetMessageType(),
 					timestamp:      time.Unix(0, event.GetTimestamp()),
 					sourceInstance: event.GetSourceInstance(),
 					sourceType:     event.GetSourceType(),
 				}
+				if filter.Matches(*message) {
+					messages <- message
+				}
 			case err, ok := <-errStream:
 				if !ok {
 					break dance
@@ -106,7 +225,9 @@ func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config)
 	return messages, errs
 }
 
-func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) ([]LogMessage, Warnings, error) {
+// GetRecentLogsForApplicationByNameAndSpace returns the recent log messages
+// of the app that the filter selects, oldest first.
+func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config, filter LogMessageFilter) ([]LogMessage, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, allWarnings, err
@@ -122,25 +243,210 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 	var logMessages []LogMessage
 
 	for _, message := range noaaMessages {
-		logMessages = append(logMessages, LogMessage{
+		logMessage := LogMessage{
 			message:        string(message.GetMessage()),
 			messageType:    message.GetMessageType(),
 			timestamp:      time.Unix(0, message.GetTimestamp()),
 			sourceType:     message.GetSourceType(),
 			sourceInstance: message.GetSourceInstance(),
-		})
+		}
+		if filter.Matches(logMessage) {
+			logMessages = append(logMessages, logMessage)
+		}
 	}
 
 	return logMessages, allWarnings, nil
 }
 
-func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, Warnings, error) {
+// GetStreamingLogsForApplicationByNameAndSpace streams the log messages of
+// the app that the filter selects.
+func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config, filter LogMessageFilter) (<-chan *LogMessage, <-chan error, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, nil, allWarnings, err
 	}
 
-	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config)
+	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config, filter)
 
 	return messages, logErrs, allWarnings, err
 }
+
+// GetRecentLogsForApplicationsByNameAndSpace returns the recent log messages
+// of the apps that the filter selects, oldest first. Each message has the name
+// of its app.
+func (actor Actor) GetRecentLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client NOAAClient, config Config, filter LogMessageFilter) ([]LogMessage, Warnings, error) {
+	apps, allWarnings, err := actor.getApplicationsByNameAndSpace(appNames, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	messages, err := actor.getRecentLogsForApplications(apps, client, filter)
+	return messages, allWarnings, err
+}
+
+// GetRecentLogsForSpace returns the recent log messages of every app in the
+// space that the filter selects, oldest first. Each message has the name of
+// its app.
+func (actor Actor) GetRecentLogsForSpace(spaceGUID string, client NOAAClient, config Config, filter LogMessageFilter) ([]LogMessage, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	messages, err := actor.getRecentLogsForApplications(apps, client, filter)
+	return messages, warnings, err
+}
+
+// GetStreamingLogsForApplicationsByNameAndSpace streams the log messages of
+// the apps that the filter selects, merged in timestamp order. Each message
+// has the name of its app.
+func (actor Actor) GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client NOAAClient, config Config, filter LogMessageFilter) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	apps, allWarnings, err := actor.getApplicationsByNameAndSpace(appNames, spaceGUID)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	messages, logErrs := actor.getStreamingLogsForApplications(apps, client, config, filter)
+	return messages, logErrs, allWarnings, nil
+}
+
+// GetStreamingLogsForSpace streams the log messages of every app in the space
+// that the filter selects, merged in timestamp order. Each message has the
+// name of its app.
+func (actor Actor) GetStreamingLogsForSpace(spaceGUID string, client NOAAClient, config Config, filter LogMessageFilter) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	messages, logErrs := actor.getStreamingLogsForApplications(apps, client, config, filter)
+	return messages, logErrs, warnings, nil
+}
+
+func (actor Actor) getApplicationsByNameAndSpace(appNames []string, spaceGUID string) ([]Application, Warnings, error) {
+	var (
+		apps        []Application
+		allWarnings Warnings
+	)
+	for _, appName := range appNames {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		apps = append(apps, app)
+	}
+
+	return apps, allWarnings, nil
+}
+
+func (Actor) getRecentLogsForApplications(apps []Application, client NOAAClient, filter LogMessageFilter) ([]LogMessage, error) {
+	var logMessages []LogMessage
+	for _, app := range apps {
+		noaaMessages, err := client.RecentLogs(app.GUID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range noaaMessages {
+			logMessage := LogMessage{
+				appName:        app.Name,
+				message:        string(message.GetMessage()),
+				messageType:    message.GetMessageType(),
+				timestamp:      time.Unix(0, message.GetTimestamp()),
+				sourceType:     message.GetSourceType(),
+				sourceInstance: message.GetSourceInstance(),
+			}
+			if filter.Matches(logMessage) {
+				logMessages = append(logMessages, logMessage)
+			}
+		}
+	}
+
+	sort.SliceStable(logMessages, func(i int, j int) bool {
+		return logMessages[i].timestamp.Before(logMessages[j].timestamp)
+	})
+
+	return logMessages, nil
+}
+
+func (actor Actor) getStreamingLogsForApplications(apps []Application, client NOAAClient, config Config, filter LogMessageFilter) (<-chan *LogMessage, <-chan error) {
+	messages := make(chan *LogMessage)
+	errs := make(chan error)
+
+	received := make(chan *LogMessage)
+	var messagesWG, errsWG sync.WaitGroup
+	for _, app := range apps {
+		appMessages, appErrs := actor.GetStreamingLogs(app.GUID, client, config, filter)
+
+		messagesWG.Add(1)
+		go func(appName string) {
+			defer messagesWG.Done()
+			for message := range appMessages {
+				message.appName = appName
+				received <- message
+			}
+		}(app.Name)
+
+		errsWG.Add(1)
+		go func() {
+			defer errsWG.Done()
+			for err := range appErrs {
+				errs <- err
+			}
+		}()
+	}
+
+	go func() {
+		messagesWG.Wait()
+		close(received)
+	}()
+
+	go func() {
+		errsWG.Wait()
+		close(errs)
+	}()
+
+	go mergeLogMessages(received, messages)
+
+	return messages, errs
+}
+
+type heldLogMessage struct {
+	message  *LogMessage
+	received time.Time
+}
+
+// mergeLogMessages passes the messages received on in timestamp order, holding
+// each one for LogMergeWindow. It closes messages once received is closed.
+func mergeLogMessages(received <-chan *LogMessage, messages chan<- *LogMessage) {
+	defer close(messages)
+
+	ticker := time.NewTicker(LogMergeWindow / 5)
+	defer ticker.Stop()
+
+	var held []heldLogMessage
+	passOn := func(before time.Time) {
+		sort.SliceStable(held, func(i int, j int) bool {
+			return held[i].message.timestamp.Before(held[j].message.timestamp)
+		})
+
+		for len(held) > 0 && !held[0].received.After(before) {
+			messages <- held[0].message
+			held = held[1:]
+		}
+	}
+
+	for {
+		select {
+		case message, ok := <-received:
+			if !ok {
+				passOn(time.Now())
+				return
+			}
+			held = append(held, heldLogMessage{message: message, received: time.Now()})
+		case now := <-ticker.C:
+			passOn(now.Add(-LogMergeWindow))
+		}
+	}
+}
@@ -2,14 +2,17 @@ package v2action_test
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -46,10 +49,64 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("LogMessageFilter", func() {
+		var message LogMessage
+		messageTime := time.Unix(1000, 0)
+
+		BeforeEach(func() {
+			message = *NewLogMessage("GET /health 200", int(events.LogMessage_OUT), messageTime, "APP/PROC/WEB", "1")
+		})
+
+		DescribeTable("Matches",
+			func(filter LogMessageFilter, expected bool) {
+				Expect(filter.Matches(message)).To(Equal(expected))
+			},
+
+			Entry("the empty filter", LogMessageFilter{}, true),
+			Entry("the same source", LogMessageFilter{SourceTypes: []string{"rtr", "app"}}, true),
+			Entry("a different source", LogMessageFilter{SourceTypes: []string{"RTR"}}, false),
+			Entry("a source that is only a prefix", LogMessageFilter{SourceTypes: []string{"AP"}}, false),
+			Entry("the same instance", LogMessageFilter{SourceInstance: types.NullInt{IsSet: true, Value: 1}}, true),
+			Entry("a different instance", LogMessageFilter{SourceInstance: types.NullInt{IsSet: true, Value: 0}}, false),
+			Entry("a matching pattern", LogMessageFilter{Pattern: regexp.MustCompile(`/health \d+`)}, true),
+			Entry("a pattern that does not match", LogMessageFilter{Pattern: regexp.MustCompile(`POST`)}, false),
+			Entry("the same type", LogMessageFilter{Type: "OUT"}, true),
+			Entry("a different type", LogMessageFilter{Type: "ERR"}, false),
+			Entry("a window around the timestamp", LogMessageFilter{Since: messageTime.Add(-time.Second), Until: messageTime.Add(time.Second)}, true),
+			Entry("a window starting at the timestamp", LogMessageFilter{Since: messageTime}, true),
+			Entry("a window ending at the timestamp", LogMessageFilter{Until: messageTime}, true),
+			Entry("a window starting after the timestamp", LogMessageFilter{Since: messageTime.Add(time.Nanosecond)}, false),
+			Entry("a window ending before the timestamp", LogMessageFilter{Until: messageTime.Add(-time.Nanosecond)}, false),
+		)
+	})
+
+	Describe("LogMessageSet", func() {
+		It("contains the messages it was created with", func() {
+			set := NewLogMessageSet([]LogMessage{
+				*NewLogMessage("message 1", int(events.LogMessage_OUT), time.Unix(0, 1), "APP/PROC/WEB", "0"),
+				*NewLogMessage("message 2", int(events.LogMessage_ERR), time.Unix(0, 2), "RTR", "1"),
+			})
+
+			Expect(set.Contains(*NewLogMessage("message 1", int(events.LogMessage_OUT), time.Unix(0, 1), "APP/PROC/WEB", "0"))).To(BeTrue())
+			Expect(set.Contains(*NewLogMessage("message 2", int(events.LogMessage_ERR), time.Unix(0, 2), "RTR", "1"))).To(BeTrue())
+		})
+
+		It("does not contain messages that differ in text, timestamp or source", func() {
+			set := NewLogMessageSet([]LogMessage{
+				*NewLogMessage("message 1", int(events.LogMessage_OUT), time.Unix(0, 1), "APP/PROC/WEB", "0"),
+			})
+
+			Expect(set.Contains(*NewLogMessage("message 2", int(events.LogMessage_OUT), time.Unix(0, 1), "APP/PROC/WEB", "0"))).To(BeFalse())
+			Expect(set.Contains(*NewLogMessage("message 1", int(events.LogMessage_OUT), time.Unix(0, 2), "APP/PROC/WEB", "0"))).To(BeFalse())
+			Expect(set.Contains(*NewLogMessage("message 1", int(events.LogMessage_OUT), time.Unix(0, 1), "APP/PROC/WEB", "1"))).To(BeFalse())
+		})
+	})
+
 	Describe("GetStreamingLogs", func() {
 		var (
 			expectedAppGUID string
 
+			filter      LogMessageFilter
 			messages    <-chan *LogMessage
 			errs        <-chan error
 			eventStream chan *events.LogMessage
@@ -58,6 +115,7 @@ var _ = Describe("Logging Actions", func() {
 
 		BeforeEach(func() {
 			expectedAppGUID = "some-app-guid"
+			filter = LogMessageFilter{}
 
 			eventStream = make(chan *events.LogMessage)
 			errStream = make(chan error)
@@ -75,7 +133,7 @@ var _ = Describe("Logging Actions", func() {
 		})
 
 		JustBeforeEach(func() {
-			messages, errs = actor.GetStreamingLogs(expectedAppGUID, fakeNOAAClient, fakeConfig)
+			messages, errs = actor.GetStreamingLogs(expectedAppGUID, fakeNOAAClient, fakeConfig, filter)
 		})
 
 		Context("when receiving events", func() {
@@ -129,6 +187,18 @@ var _ = Describe("Logging Actions", func() {
 				Expect(message.SourceType()).To(Equal("some-source-type"))
 				Expect(message.SourceInstance()).To(Equal("some-source-instance"))
 			})
+
+			Context("when a filter is given", func() {
+				BeforeEach(func() {
+					filter = LogMessageFilter{Type: "ERR"}
+				})
+
+				It("passes through only the messages the filter selects", func() {
+					message := <-messages
+					Expect(message.Message()).To(Equal("message-2"))
+					Expect(message.Type()).To(Equal("ERR"))
+				})
+			})
 		})
 
 		Context("when receiving errors", func() {
@@ -256,7 +326,7 @@ var _ = Describe("Logging Actions", func() {
 				})
 
 				It("returns all the recent logs and warnings", func() {
-					messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+					messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(warnings).To(ConsistOf("some-app-warnings"))
 					Expect(messages[0].Message()).To(Equal("message-1"))
@@ -271,6 +341,17 @@ var _ = Describe("Logging Actions", func() {
 					Expect(messages[1].SourceType()).To(Equal("some-source-type"))
 					Expect(messages[1].SourceInstance()).To(Equal("some-source-instance"))
 				})
+
+				Context("when a filter is given", func() {
+					It("returns only the recent logs the filter selects", func() {
+						messages, _, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{
+							Pattern: regexp.MustCompile("-2$"),
+						})
+						Expect(err).ToNot(HaveOccurred())
+						Expect(messages).To(HaveLen(1))
+						Expect(messages[0].Message()).To(Equal("message-2"))
+					})
+				})
 			})
 
 			Context("when NOAA errors", func() {
@@ -282,7 +363,7 @@ var _ = Describe("Logging Actions", func() {
 				})
 
 				It("returns error and warnings", func() {
-					_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+					_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
 					Expect(err).To(MatchError(expectedErr))
 					Expect(warnings).To(ConsistOf("some-app-warnings"))
 				})
@@ -302,7 +383,7 @@ var _ = Describe("Logging Actions", func() {
 			})
 
 			It("returns error and warnings", func() {
-				_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+				_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
 
@@ -387,7 +468,7 @@ var _ = Describe("Logging Actions", func() {
 			It("converts them to log messages and passes them through the messages channel", func() {
 				var err error
 				var warnings Warnings
-				messages, logErrs, warnings, err = actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+				messages, logErrs, warnings, err = actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
@@ -421,7 +502,7 @@ var _ = Describe("Logging Actions", func() {
 			})
 
 			It("returns error and warnings", func() {
-				_, _, warnings, err := actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+				_, _, warnings, err := actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
 
@@ -429,4 +510,145 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("logs for several apps", func() {
+		var (
+			appStreams map[string]chan *events.LogMessage
+			errStreams map[string]chan error
+		)
+
+		newLogMessage := func(message string, timestamp int64) *events.LogMessage {
+			outMessage := events.LogMessage_OUT
+			sourceType := "APP/PROC/WEB"
+			sourceInstance := "0"
+			return &events.LogMessage{
+				Message:        []byte(message),
+				MessageType:    &outMessage,
+				Timestamp:      &timestamp,
+				SourceType:     &sourceType,
+				SourceInstance: &sourceInstance,
+			}
+		}
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+				apps := []ccv2.Application{
+					{Name: "app-1", GUID: "app-1-guid"},
+					{Name: "app-2", GUID: "app-2-guid"},
+				}
+				if queries[0].Filter == ccv2.NameFilter {
+					for _, app := range apps {
+						if app.Name == queries[0].Values[0] {
+							return []ccv2.Application{app}, ccv2.Warnings{app.Name + "-warning"}, nil
+						}
+					}
+					return nil, nil, nil
+				}
+				return apps, ccv2.Warnings{"space-apps-warning"}, nil
+			}
+
+			fakeNOAAClient.RecentLogsStub = func(appGUID string, _ string) ([]*events.LogMessage, error) {
+				switch appGUID {
+				case "app-1-guid":
+					return []*events.LogMessage{newLogMessage("app-1-message-1", 10), newLogMessage("app-1-message-2", 30)}, nil
+				default:
+					return []*events.LogMessage{newLogMessage("app-2-message-1", 20)}, nil
+				}
+			}
+
+			appStreams = map[string]chan *events.LogMessage{
+				"app-1-guid": make(chan *events.LogMessage),
+				"app-2-guid": make(chan *events.LogMessage),
+			}
+			errStreams = map[string]chan error{
+				"app-1-guid": make(chan error),
+				"app-2-guid": make(chan error),
+			}
+			fakeNOAAClient.TailingLogsStub = func(appGUID string, _ string) (<-chan *events.LogMessage, <-chan error) {
+				return appStreams[appGUID], errStreams[appGUID]
+			}
+		})
+
+		expectMergedRecentLogs := func(messages []LogMessage) {
+			Expect(messages).To(HaveLen(3))
+			Expect(messages[0].AppName()).To(Equal("app-1"))
+			Expect(messages[0].Message()).To(Equal("app-1-message-1"))
+			Expect(messages[1].AppName()).To(Equal("app-2"))
+			Expect(messages[1].Message()).To(Equal("app-2-message-1"))
+			Expect(messages[2].AppName()).To(Equal("app-1"))
+			Expect(messages[2].Message()).To(Equal("app-1-message-2"))
+		}
+
+		expectMergedStreamingLogs := func(messages <-chan *LogMessage, logErrs <-chan error) {
+			go func() {
+				appStreams["app-2-guid"] <- newLogMessage("app-2-message-1", 20)
+				appStreams["app-1-guid"] <- newLogMessage("app-1-message-1", 10)
+				errStreams["app-2-guid"] <- errors.New("some-log-error")
+			}()
+
+			Eventually(logErrs).Should(Receive(MatchError("some-log-error")))
+
+			var message *LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.AppName()).To(Equal("app-1"))
+			Expect(message.Message()).To(Equal("app-1-message-1"))
+
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.AppName()).To(Equal("app-2"))
+			Expect(message.Message()).To(Equal("app-2-message-1"))
+
+			for guid := range appStreams {
+				close(appStreams[guid])
+				close(errStreams[guid])
+			}
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		}
+
+		Describe("GetRecentLogsForApplicationsByNameAndSpace", func() {
+			It("returns the recent logs of all the apps in timestamp order", func() {
+				messages, warnings, err := actor.GetRecentLogsForApplicationsByNameAndSpace([]string{"app-1", "app-2"}, "some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-1-warning", "app-2-warning"))
+				expectMergedRecentLogs(messages)
+			})
+
+			Context("when one of the apps cannot be found", func() {
+				It("returns an ApplicationNotFoundError", func() {
+					_, _, err := actor.GetRecentLogsForApplicationsByNameAndSpace([]string{"app-1", "app-3"}, "some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
+					Expect(err).To(MatchError(ApplicationNotFoundError{Name: "app-3"}))
+					Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Describe("GetRecentLogsForSpace", func() {
+			It("returns the recent logs of all the apps in the space in timestamp order", func() {
+				messages, warnings, err := actor.GetRecentLogsForSpace("some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("space-apps-warning"))
+				expectMergedRecentLogs(messages)
+			})
+		})
+
+		Describe("GetStreamingLogsForApplicationsByNameAndSpace", func() {
+			It("merges the streams of all the apps in timestamp order", func() {
+				messages, logErrs, warnings, err := actor.GetStreamingLogsForApplicationsByNameAndSpace([]string{"app-1", "app-2"}, "some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-1-warning", "app-2-warning"))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+				expectMergedStreamingLogs(messages, logErrs)
+			})
+		})
+
+		Describe("GetStreamingLogsForSpace", func() {
+			It("merges the streams of all the apps in the space in timestamp order", func() {
+				messages, logErrs, warnings, err := actor.GetStreamingLogsForSpace("some-space-guid", fakeNOAAClient, fakeConfig, LogMessageFilter{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("space-apps-warning"))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+				expectMergedStreamingLogs(messages, logErrs)
+			})
+		})
+	})
 })
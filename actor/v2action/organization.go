@@ -72,6 +72,21 @@ func (actor Actor) GetOrganizationByName(orgName string) (Organization, Warnings
 	return Organization(orgs[0]), Warnings(warnings), nil
 }
 
+// GetOrganizations returns all the Organizations the user has access to.
+func (actor Actor) GetOrganizations() ([]Organization, Warnings, error) {
+	ccOrgs, warnings, err := actor.CloudControllerClient.GetOrganizations()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	orgs := make([]Organization, len(ccOrgs))
+	for i, ccOrg := range ccOrgs {
+		orgs[i] = Organization(ccOrg)
+	}
+
+	return orgs, Warnings(warnings), nil
+}
+
 // DeleteOrganization deletes the Organization associated with the provided
 // GUID. Once the deletion request is sent, it polls the deletion job until
 // it's finished.
@@ -94,6 +94,59 @@ var _ = Describe("Org Actions", func() {
 		})
 	})
 
+	Describe("GetOrganizations", func() {
+		var (
+			orgs     []Organization
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			orgs, warnings, err = actor.GetOrganizations()
+		})
+
+		Context("when getting the orgs succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{
+						{GUID: "org-1-guid", Name: "org-1"},
+						{GUID: "org-2-guid", Name: "org-2"},
+					},
+					ccv2.Warnings{"warning-1", "warning-2"},
+					nil)
+			})
+
+			It("returns all the orgs and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgs).To(Equal([]Organization{
+					{GUID: "org-1-guid", Name: "org-1"},
+					{GUID: "org-2-guid", Name: "org-2"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(BeEmpty())
+			})
+		})
+
+		Context("when getting the orgs fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get orgs error")
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					nil,
+					ccv2.Warnings{"warning-1", "warning-2"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("GetOrganizationByName", func() {
 		var (
 			org      Organization
@@ -1,5 +1,39 @@
 package v2action
 
+// SSHAuthentication is what is needed to open an SSH session to an
+// application instance.
+type SSHAuthentication struct {
+	// Endpoint is the address of the SSH proxy.
+	Endpoint string
+
+	// HostKeyFingerprint is the fingerprint of the SSH proxy's host key.
+	HostKeyFingerprint string
+
+	// Passcode is a one time passcode for a single session.
+	Passcode string
+}
+
 func (actor Actor) GetSSHPasscode() (string, error) {
 	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
 }
+
+// GetSSHAuthentication returns the SSH proxy endpoint of the targeted Cloud
+// Controller along with a new one time passcode.
+func (actor Actor) GetSSHAuthentication() (SSHAuthentication, error) {
+	passcode, err := actor.GetSSHPasscode()
+	if err != nil {
+		return SSHAuthentication{}, err
+	}
+
+	return SSHAuthentication{
+		Endpoint:           actor.CloudControllerClient.AppSSHEndpoint(),
+		HostKeyFingerprint: actor.CloudControllerClient.AppSSHHostKeyFingerprint(),
+		Passcode:           passcode,
+	}, nil
+}
+
+// AppSSHEndpoint returns the SSH proxy endpoint of the targeted Cloud
+// Controller.
+func (actor Actor) AppSSHEndpoint() string {
+	return actor.CloudControllerClient.AppSSHEndpoint()
+}
@@ -11,15 +11,17 @@ import (
 
 var _ = Describe("SSH Actions", func() {
 	var (
-		actor         *Actor
-		fakeConfig    *v2actionfakes.FakeConfig
-		fakeUAAClient *v2actionfakes.FakeUAAClient
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+		fakeUAAClient             *v2actionfakes.FakeUAAClient
 	)
 
 	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
 		fakeConfig = new(v2actionfakes.FakeConfig)
 		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
-		actor = NewActor(nil, fakeUAAClient, fakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeUAAClient, fakeConfig)
 	})
 
 	Describe("GetSSHPasscode", func() {
@@ -68,4 +70,45 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSSHAuthentication", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.AppSSHEndpointReturns("ssh.example.com:2222")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-fingerprint")
+		})
+
+		Context("when the ssh passcode is retrieved", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetSSHPasscodeReturns("s3curep4ss", nil)
+			})
+
+			It("returns the ssh endpoint with the passcode", func() {
+				authentication, err := actor.GetSSHAuthentication()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(authentication).To(Equal(SSHAuthentication{
+					Endpoint:           "ssh.example.com:2222",
+					HostKeyFingerprint: "some-fingerprint",
+					Passcode:           "s3curep4ss",
+				}))
+			})
+		})
+
+		Context("when an error is encountered getting the ssh passcode", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetSSHPasscodeReturns("", errors.New("failed fetching code"))
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetSSHAuthentication()
+				Expect(err).To(MatchError("failed fetching code"))
+			})
+		})
+	})
+
+	Describe("AppSSHEndpoint", func() {
+		It("returns the SSH endpoint of the Cloud Controller", func() {
+			fakeCloudControllerClient.AppSSHEndpointReturns("ssh.example.com:2222")
+			Expect(actor.AppSSHEndpoint()).To(Equal("ssh.example.com:2222"))
+		})
+	})
 })
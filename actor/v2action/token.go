@@ -1,5 +1,10 @@
 package v2action
 
+import "code.cloudfoundry.org/cli/api/uaa"
+
+// AccessTokenClaims are the decoded claims of a UAA access token.
+type AccessTokenClaims uaa.AccessTokenClaims
+
 func (actor Actor) RefreshAccessToken(refreshToken string) (string, error) {
 	tokens, err := actor.UAAClient.RefreshAccessToken(refreshToken)
 	if err != nil {
@@ -11,3 +16,14 @@ func (actor Actor) RefreshAccessToken(refreshToken string) (string, error) {
 
 	return tokens.AuthorizationToken(), nil
 }
+
+// DecodeAccessToken decodes the user, client, scopes and expiry of the
+// provided access token. The token's signature is not verified.
+func (actor Actor) DecodeAccessToken(accessToken string) (AccessTokenClaims, error) {
+	claims, err := uaa.DecodeAccessToken(accessToken)
+	if err != nil {
+		return AccessTokenClaims{}, err
+	}
+
+	return AccessTokenClaims(claims), nil
+}
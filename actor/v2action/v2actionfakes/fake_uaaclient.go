@@ -25,6 +25,20 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthenticateClientStub        func(clientID string, clientSecret string) (string, error)
+	authenticateClientMutex       sync.RWMutex
+	authenticateClientArgsForCall []struct {
+		clientID     string
+		clientSecret string
+	}
+	authenticateClientReturns struct {
+		result1 string
+		result2 error
+	}
+	authenticateClientReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	CreateUserStub        func(username string, password string, origin string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -126,6 +140,58 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthenticateClient(clientID string, clientSecret string) (string, error) {
+	fake.authenticateClientMutex.Lock()
+	ret, specificReturn := fake.authenticateClientReturnsOnCall[len(fake.authenticateClientArgsForCall)]
+	fake.authenticateClientArgsForCall = append(fake.authenticateClientArgsForCall, struct {
+		clientID     string
+		clientSecret string
+	}{clientID, clientSecret})
+	fake.recordInvocation("AuthenticateClient", []interface{}{clientID, clientSecret})
+	fake.authenticateClientMutex.Unlock()
+	if fake.AuthenticateClientStub != nil {
+		return fake.AuthenticateClientStub(clientID, clientSecret)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.authenticateClientReturns.result1, fake.authenticateClientReturns.result2
+}
+
+func (fake *FakeUAAClient) AuthenticateClientCallCount() int {
+	fake.authenticateClientMutex.RLock()
+	defer fake.authenticateClientMutex.RUnlock()
+	return len(fake.authenticateClientArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateClientArgsForCall(i int) (string, string) {
+	fake.authenticateClientMutex.RLock()
+	defer fake.authenticateClientMutex.RUnlock()
+	return fake.authenticateClientArgsForCall[i].clientID, fake.authenticateClientArgsForCall[i].clientSecret
+}
+
+func (fake *FakeUAAClient) AuthenticateClientReturns(result1 string, result2 error) {
+	fake.AuthenticateClientStub = nil
+	fake.authenticateClientReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthenticateClientReturnsOnCall(i int, result1 string, result2 error) {
+	fake.AuthenticateClientStub = nil
+	if fake.authenticateClientReturnsOnCall == nil {
+		fake.authenticateClientReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authenticateClientReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) CreateUser(username string, password string, origin string) (uaa.User, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -287,6 +353,8 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateClientMutex.RLock()
+	defer fake.authenticateClientMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
@@ -74,6 +74,22 @@ func (actor Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string
 	return Application(apps[0]), Warnings(warnings), nil
 }
 
+// GetApplicationsBySpace returns the applications in the given space.
+func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warnings, error) {
+	ccv3Apps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+		"space_guids": []string{spaceGUID},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	apps := make([]Application, len(ccv3Apps))
+	for i, app := range ccv3Apps {
+		apps[i] = Application(app)
+	}
+	return apps, Warnings(warnings), nil
+}
+
 type CreateApplicationInput struct {
 	AppName    string
 	SpaceGUID  string
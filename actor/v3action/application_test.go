@@ -96,6 +96,54 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsBySpace", func() {
+		Context("when the cloud controller returns the applications", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{Name: "some-app-name-1", GUID: "some-app-guid-1"},
+						{Name: "some-app-name-2", GUID: "some-app-guid-2"},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the applications and warnings", func() {
+				apps, warnings, err := actor.GetApplicationsBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{
+					{Name: "some-app-name-1", GUID: "some-app-guid-1"},
+					{Name: "some-app-name-2", GUID: "some-app-guid-2"},
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					"space_guids": []string{"some-space-guid"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{},
+					ccv3.Warnings{"some-warning"},
+					expectedError)
+			})
+
+			It("returns the warnings and the error", func() {
+				_, warnings, err := actor.GetApplicationsBySpace("some-space-guid")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError(expectedError))
+			})
+		})
+	})
+
 	Describe("GetApplicationByNameAndSpace", func() {
 		Context("when the app exists", func() {
 			BeforeEach(func() {
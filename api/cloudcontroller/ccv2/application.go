@@ -44,6 +44,9 @@ type Application struct {
 	// DetectedStartCommand is the command used to start the application.
 	DetectedStartCommand types.FilteredString
 
+	// Diego is true when the application runs on Diego.
+	Diego bool
+
 	// DiskQuota is the disk given to each instance, in megabytes.
 	DiskQuota uint64
 
@@ -176,6 +179,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 			Command              string            `json:"command"`
 			DetectedBuildpack    string            `json:"detected_buildpack"`
 			DetectedStartCommand string            `json:"detected_start_command"`
+			Diego                bool              `json:"diego"`
 			DiskQuota            uint64            `json:"disk_quota"`
 			DockerImage          string            `json:"docker_image"`
 			DockerCredentials    DockerCredentials `json:"docker_credentials"`
@@ -204,6 +208,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	application.Diego = ccApp.Entity.Diego
 	application.DiskQuota = ccApp.Entity.DiskQuota
 	application.DockerImage = ccApp.Entity.DockerImage
 	application.DockerCredentials = ccApp.Entity.DockerCredentials
@@ -98,6 +98,7 @@ var _ = Describe("Application", func() {
 							"buildpack": "ruby 1.6.29",
 							"command": "some-command",
 							"detected_start_command": "echo 'I am a banana'",
+							"diego": true,
 							"disk_quota": 586,
 							"detected_buildpack": null,
 							"docker_credentials": {
@@ -146,6 +147,7 @@ var _ = Describe("Application", func() {
 					Command:              types.FilteredString{IsSet: true, Value: "some-command"},
 					DetectedBuildpack:    types.FilteredString{},
 					DetectedStartCommand: types.FilteredString{IsSet: true, Value: "echo 'I am a banana'"},
+					Diego:                true,
 					DiskQuota:            586,
 					DockerCredentials: DockerCredentials{
 						Username: "docker-username",
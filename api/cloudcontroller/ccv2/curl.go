@@ -0,0 +1,55 @@
+package ccv2
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// CurlResponse is the response of the Cloud Controller to a request made with
+// Curl.
+type CurlResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Header contains the HTTP headers of the response.
+	Header http.Header
+
+	// Body is the unparsed body of the response.
+	Body []byte
+}
+
+// Curl makes a request with method to path, which is relative to the Cloud
+// Controller URL and may be any V2 or V3 endpoint including a query string.
+// The request goes through the same connection wrappers as every other
+// request. Unlike the other requests, a response with an error status code is
+// returned as is rather than as an error.
+func (client *Client) Curl(method string, path string, body []byte) (CurlResponse, Warnings, error) {
+	var requestBody io.ReadSeeker
+	if len(body) > 0 {
+		requestBody = bytes.NewReader(body)
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		Method: method,
+		URI:    path,
+		Body:   requestBody,
+	})
+	if err != nil {
+		return CurlResponse{}, nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	if response.HTTPResponse == nil {
+		return CurlResponse{}, response.Warnings, err
+	}
+
+	return CurlResponse{
+		StatusCode: response.HTTPResponse.StatusCode,
+		Header:     response.HTTPResponse.Header,
+		Body:       response.RawResponse,
+	}, response.Warnings, nil
+}
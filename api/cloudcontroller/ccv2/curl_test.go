@@ -0,0 +1,71 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Curl", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Context("when the request succeeds", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/apps", "names=some-app"),
+					VerifyHeaderKV("Content-Type", "application/json"),
+					VerifyBody([]byte(`{"name":"some-app"}`)),
+					RespondWith(http.StatusCreated, `{"guid":"some-app-guid"}`, http.Header{
+						"X-Cf-Warnings": {"this is a warning"},
+						"Location":      {"/v3/apps/some-app-guid"},
+					}),
+				),
+			)
+		})
+
+		It("returns the response and warnings", func() {
+			response, warnings, err := client.Curl(http.MethodPost, "/v3/apps?names=some-app", []byte(`{"name":"some-app"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(response.Header.Get("Location")).To(Equal("/v3/apps/some-app-guid"))
+			Expect(response.Body).To(MatchJSON(`{"guid":"some-app-guid"}`))
+		})
+	})
+
+	Context("when the Cloud Controller returns an error status code", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid"),
+					RespondWith(http.StatusNotFound, `{"code":100004,"description":"The app could not be found: some-app-guid","error_code":"CF-AppNotFound"}`),
+				),
+			)
+		})
+
+		It("returns the response instead of an error", func() {
+			response, _, err := client.Curl(http.MethodGet, "/v2/apps/some-app-guid", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			Expect(string(response.Body)).To(ContainSubstring("CF-AppNotFound"))
+		})
+	})
+
+	Context("when the request cannot be made", func() {
+		It("returns the error", func() {
+			_, _, err := client.Curl("BAD METHOD", "/v2/apps", nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -15,6 +15,9 @@ import (
 
 // RequestLoggerOutput is the interface for displaying logs
 type RequestLoggerOutput interface {
+	DisplayAttempt(attempt int) error
+	DisplayBodySize(size int64) error
+	DisplayElapsedTime(elapsed time.Duration) error
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
 	DisplayJSONBody(body []byte) error
@@ -22,6 +25,7 @@ type RequestLoggerOutput interface {
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
+	DisplayURL(url string) error
 	HandleInternalError(err error)
 	Start() error
 	Stop() error
@@ -32,6 +36,9 @@ type RequestLoggerOutput interface {
 type RequestLogger struct {
 	connection cloudcontroller.Connection
 	output     RequestLoggerOutput
+
+	lastRequest *cloudcontroller.Request
+	attempt     int
 }
 
 // NewRequestLogger returns a pointer to a RequestLogger wrapper
@@ -49,15 +56,26 @@ func (logger *RequestLogger) Wrap(innerconnection cloudcontroller.Connection) cl
 
 // Make records the request and the response to UI
 func (logger *RequestLogger) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	// The retry and authentication wrappers resend the same request, so seeing
+	// it again means this is another attempt at it.
+	if request == logger.lastRequest {
+		logger.attempt++
+	} else {
+		logger.lastRequest = request
+		logger.attempt = 1
+	}
+
 	err := logger.displayRequest(request)
 	if err != nil {
 		logger.output.HandleInternalError(err)
 	}
 
+	startTime := time.Now()
 	err = logger.connection.Make(request, passedResponse)
+	elapsed := time.Since(startTime)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(passedResponse, elapsed)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -85,6 +103,18 @@ func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) er
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayURL(request.URL.String())
+	if err != nil {
+		return err
+	}
+	err = logger.output.DisplayAttempt(logger.attempt)
+	if err != nil {
+		return err
+	}
+	err = logger.output.DisplayBodySize(request.ContentLength)
+	if err != nil {
+		return err
+	}
 	err = logger.displaySortedHeaders(request.Header)
 	if err != nil {
 		return err
@@ -118,7 +148,7 @@ func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) er
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Response) error {
+func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Response, elapsed time.Duration) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -133,6 +163,14 @@ func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Res
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayElapsedTime(elapsed)
+	if err != nil {
+		return err
+	}
+	err = logger.output.DisplayBodySize(int64(len(passedResponse.RawResponse)))
+	if err != nil {
+		return err
+	}
 	err = logger.displaySortedHeaders(passedResponse.HTTPResponse.Header)
 	if err != nil {
 		return err
@@ -1,6 +1,8 @@
 package wrapper
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -26,9 +28,10 @@ type TokenCache interface {
 // UAAAuthentication wraps connections and adds authentication headers to all
 // requests
 type UAAAuthentication struct {
-	connection cloudcontroller.Connection
-	client     UAAClient
-	cache      TokenCache
+	connection  cloudcontroller.Connection
+	client      UAAClient
+	cache       TokenCache
+	refreshSkew time.Duration
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -51,26 +54,40 @@ func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
 }
 
+// SetTokenRefreshSkew sets how long before the access token expires the
+// wrapper will refresh it.
+func (t *UAAAuthentication) SetTokenRefreshSkew(skew time.Duration) {
+	t.refreshSkew = skew
+}
+
 // Make adds authentication headers to the passed in request and then calls the
 // wrapped connection's Make. If the client is not set on the wrapper, it will
 // not add any header or handle any authentication errors.
+//
+// The access token is refreshed before the request is made if it expires
+// within the token refresh skew, so that requests with bodies that cannot be
+// resent are not rejected for an expired token.
 func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	if t.client == nil {
 		return t.connection.Make(request, passedResponse)
 	}
 
+	if t.accessTokenExpiresSoon() {
+		err := t.refreshToken()
+		if err != nil {
+			return err
+		}
+	}
+
 	request.Header.Set("Authorization", t.cache.AccessToken())
 
 	requestErr := t.connection.Make(request, passedResponse)
 	if _, ok := requestErr.(ccerror.InvalidAuthTokenError); ok {
-		tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		err := t.refreshToken()
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if request.Body != nil {
 			err = request.ResetBody()
 			if err != nil {
@@ -86,3 +103,25 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 
 	return requestErr
 }
+
+// accessTokenExpiresSoon returns true if the cached access token is a JWT
+// that expires within the token refresh skew. Tokens that cannot be decoded
+// are left for the server to reject.
+func (t *UAAAuthentication) accessTokenExpiresSoon() bool {
+	claims, err := uaa.DecodeAccessToken(t.cache.AccessToken())
+	if err != nil {
+		return false
+	}
+	return claims.ExpiresWithin(time.Now(), t.refreshSkew)
+}
+
+func (t *UAAAuthentication) refreshToken() error {
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return err
+	}
+
+	t.cache.SetAccessToken(tokens.AuthorizationToken())
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -106,6 +107,67 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when the token expires within the refresh skew", func() {
+			BeforeEach(func() {
+				inner.SetTokenRefreshSkew(time.Minute)
+				inMemoryCache.SetAccessToken(buildAccessToken(time.Now().Add(30 * time.Second)))
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token before making the request", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+			})
+
+			Context("when refreshing the token fails", func() {
+				BeforeEach(func() {
+					fakeClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{}, errors.New("refresh failed"))
+				})
+
+				It("returns the error without making the request", func() {
+					err := wrapper.Make(request, nil)
+					Expect(err).To(MatchError("refresh failed"))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the token expires after the refresh skew", func() {
+			var accessToken string
+
+			BeforeEach(func() {
+				inner.SetTokenRefreshSkew(time.Minute)
+				accessToken = buildAccessToken(time.Now().Add(time.Hour))
+				inMemoryCache.SetAccessToken(accessToken)
+			})
+
+			It("does not refresh the token", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal(accessToken))
+			})
+		})
+
 		Context("when the token is invalid", func() {
 			var (
 				expectedBody string
@@ -0,0 +1,28 @@
+package plugin
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+)
+
+// DownloadPluginSignature returns the detached signature of a plugin binary
+// published at signatureURL. It returns a pluginerror.NotFoundError when the
+// binary has no signature.
+func (client *Client) DownloadPluginSignature(signatureURL string) ([]byte, error) {
+	request, err := client.newGETRequest(signatureURL)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{}
+	err = client.connection.Make(request, &response, nil)
+	if err != nil {
+		if response.HTTPResponse != nil && response.HTTPResponse.StatusCode == http.StatusNotFound {
+			return nil, pluginerror.NotFoundError{URL: signatureURL}
+		}
+		return nil, err
+	}
+
+	return response.RawResponse, nil
+}
@@ -0,0 +1,68 @@
+package plugin_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("DownloadPluginSignature", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Context("when the signature exists", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/plugin-1.sig"),
+					RespondWith(http.StatusOK, "some-signature"),
+				),
+			)
+		})
+
+		It("returns the signature", func() {
+			signature, err := client.DownloadPluginSignature(server.URL() + "/plugin-1.sig")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(signature).To(Equal([]byte("some-signature")))
+		})
+	})
+
+	Context("when the signature does not exist", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/plugin-1.sig"),
+					RespondWith(http.StatusNotFound, nil),
+				),
+			)
+		})
+
+		It("returns a NotFoundError", func() {
+			_, err := client.DownloadPluginSignature(server.URL() + "/plugin-1.sig")
+			Expect(err).To(MatchError(pluginerror.NotFoundError{URL: server.URL() + "/plugin-1.sig"}))
+		})
+	})
+
+	Context("when downloading the signature errors", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/plugin-1.sig"),
+					RespondWith(http.StatusTeapot, nil),
+				),
+			)
+		})
+
+		It("returns a RawHTTPStatusError", func() {
+			_, err := client.DownloadPluginSignature(server.URL() + "/plugin-1.sig")
+			Expect(err).To(MatchError(pluginerror.RawHTTPStatusError{Status: "418 I'm a teapot", RawResponse: []byte("")}))
+		})
+	})
+})
@@ -12,9 +12,10 @@ type PluginRepository struct {
 }
 
 type PluginBinary struct {
-	Platform string `json:"platform"`
-	URL      string `json:"url"`
-	Checksum string `json:"checksum"`
+	Platform     string `json:"platform"`
+	URL          string `json:"url"`
+	Checksum     string `json:"checksum"`
+	SignatureURL string `json:"signature_url,omitempty"`
 }
 
 type Plugin struct {
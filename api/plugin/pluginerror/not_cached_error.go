@@ -0,0 +1,13 @@
+package pluginerror
+
+import "fmt"
+
+// NotCachedError is returned when a request is made offline and there is no
+// cached response for its URL.
+type NotCachedError struct {
+	URL string
+}
+
+func (e NotCachedError) Error() string {
+	return fmt.Sprintf("%s is not cached", e.URL)
+}
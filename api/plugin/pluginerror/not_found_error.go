@@ -0,0 +1,13 @@
+package pluginerror
+
+import "fmt"
+
+// NotFoundError is returned when the server responds with 404 Not Found to a
+// request for an optional resource, such as a plugin signature.
+type NotFoundError struct {
+	URL string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found", e.URL)
+}
@@ -0,0 +1,284 @@
+package wrapper
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+)
+
+// CacheFallbackHandler is called when a request fails and the cached response
+// is used instead.
+type CacheFallbackHandler func(url string, cachedAt time.Time, err error)
+
+// CacheRequest is a wrapper that caches the responses to GET requests, such as
+// plugin repository indexes and plugin binaries, in a directory. Cached
+// responses are revalidated with their ETag, and are used when the server
+// cannot be reached or responds with a 5XX status code. When offline, all GET
+// requests are answered from the cache. When the cached bodies add up to more
+// than the maximum size, the least recently cached responses are removed.
+type CacheRequest struct {
+	dir        string
+	maxSize    int64
+	offline    bool
+	onFallback CacheFallbackHandler
+	connection plugin.Connection
+}
+
+type cachedResponse struct {
+	URL        string    `json:"url"`
+	StatusCode int       `json:"status_code"`
+	Status     string    `json:"status"`
+	ETag       string    `json:"etag,omitempty"`
+	CachedAt   time.Time `json:"cached_at"`
+	BodySHA256 string    `json:"body_sha256"`
+	body       []byte
+}
+
+// NewCacheRequest returns a pointer to a CacheRequest wrapper that caches up
+// to maxSize bytes of responses in dir.
+func NewCacheRequest(dir string, maxSize int64, offline bool, onFallback CacheFallbackHandler) *CacheRequest {
+	return &CacheRequest{
+		dir:        dir,
+		maxSize:    maxSize,
+		offline:    offline,
+		onFallback: onFallback,
+	}
+}
+
+// Wrap sets the connection in the CacheRequest and returns itself.
+func (cache *CacheRequest) Wrap(innerconnection plugin.Connection) plugin.Connection {
+	cache.connection = innerconnection
+	return cache
+}
+
+// Make answers the request from the cache when offline. Otherwise it makes
+// the request, conditionally if the response is cached, and caches the
+// response.
+func (cache *CacheRequest) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
+	if request.Method != http.MethodGet {
+		return cache.connection.Make(request, passedResponse, proxyReader)
+	}
+
+	url := request.URL.String()
+	cached, isCached := cache.load(url)
+
+	if cache.offline {
+		if !isCached {
+			return pluginerror.NotCachedError{URL: url}
+		}
+		return cached.populate(request, passedResponse)
+	}
+
+	if isCached && cached.ETag != "" {
+		request.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	// The result is decoded here rather than by the inner connection, because
+	// a 304 Not Modified response has no body to decode.
+	result := passedResponse.Result
+	passedResponse.Result = nil
+	err := cache.connection.Make(request, passedResponse, proxyReader)
+	passedResponse.Result = result
+
+	if err != nil {
+		if isCached && isUnavailable(err, passedResponse) {
+			if cache.onFallback != nil {
+				cache.onFallback(url, cached.CachedAt, err)
+			}
+			return cached.populate(request, passedResponse)
+		}
+
+		if passedResponse.HTTPResponse != nil && passedResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+			cache.save(url, passedResponse)
+		}
+		return err
+	}
+
+	if isCached && passedResponse.HTTPResponse.StatusCode == http.StatusNotModified {
+		return cached.populate(request, passedResponse)
+	}
+
+	cache.save(url, passedResponse)
+	return decodeResult(passedResponse)
+}
+
+func (cache *CacheRequest) load(url string) (cachedResponse, bool) {
+	path := cache.path(url)
+
+	rawMetadata, err := ioutil.ReadFile(path + ".json")
+	if err != nil {
+		return cachedResponse{}, false
+	}
+
+	var cached cachedResponse
+	err = json.Unmarshal(rawMetadata, &cached)
+	if err != nil || cached.URL != url {
+		return cachedResponse{}, false
+	}
+
+	cached.body, err = ioutil.ReadFile(path)
+	if err != nil || bodySHA256(cached.body) != cached.BodySHA256 {
+		return cachedResponse{}, false
+	}
+
+	return cached, true
+}
+
+// save caches the response. Failing to cache a response does not fail the
+// request, so errors are ignored. The body and the metadata are each replaced
+// atomically, and the metadata records the checksum of the body, so a
+// response that was only partly saved is never loaded.
+func (cache *CacheRequest) save(url string, response *plugin.Response) {
+	cached := cachedResponse{
+		URL:        url,
+		StatusCode: response.HTTPResponse.StatusCode,
+		Status:     response.HTTPResponse.Status,
+		ETag:       response.HTTPResponse.Header.Get("ETag"),
+		CachedAt:   time.Now(),
+		BodySHA256: bodySHA256(response.RawResponse),
+	}
+
+	rawMetadata, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	err = os.MkdirAll(cache.dir, 0700)
+	if err != nil {
+		return
+	}
+
+	path := cache.path(url)
+	err = cache.writeFile(path, response.RawResponse)
+	if err != nil {
+		return
+	}
+	err = cache.writeFile(path+".json", rawMetadata)
+	if err != nil {
+		return
+	}
+
+	cache.prune(path)
+}
+
+// writeFile writes data to a temporary file in the cache directory and then
+// renames it to path, so that path is never left partly written.
+func (cache *CacheRequest) writeFile(path string, data []byte) error {
+	file, err := ioutil.TempFile(cache.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+	return err
+}
+
+// prune removes the least recently cached responses, other than the one at
+// keepPath, until the cached bodies add up to no more than the maximum size.
+func (cache *CacheRequest) prune(keepPath string) {
+	files, err := ioutil.ReadDir(cache.dir)
+	if err != nil {
+		return
+	}
+
+	var (
+		bodies    []os.FileInfo
+		totalSize int64
+	)
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || filepath.Ext(file.Name()) == ".json" {
+			continue
+		}
+		totalSize += file.Size()
+		if file.Name() != filepath.Base(keepPath) {
+			bodies = append(bodies, file)
+		}
+	}
+
+	sort.Slice(bodies, func(i int, j int) bool {
+		return bodies[i].ModTime().Before(bodies[j].ModTime())
+	})
+
+	for len(bodies) > 0 && totalSize > cache.maxSize {
+		path := filepath.Join(cache.dir, bodies[0].Name())
+		_ = os.Remove(path + ".json")
+		_ = os.Remove(path)
+		totalSize -= bodies[0].Size()
+		bodies = bodies[1:]
+	}
+}
+
+func (cache *CacheRequest) path(url string) string {
+	sum := sha1.Sum([]byte(url))
+	return filepath.Join(cache.dir, hex.EncodeToString(sum[:]))
+}
+
+func bodySHA256(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// populate fills in the response as if the server had sent the cached
+// response.
+func (cached cachedResponse) populate(request *http.Request, passedResponse *plugin.Response) error {
+	passedResponse.HTTPResponse = &http.Response{
+		Status:     cached.Status,
+		StatusCode: cached.StatusCode,
+		Header:     http.Header{},
+		Request:    request,
+	}
+	passedResponse.RawResponse = cached.body
+
+	if cached.StatusCode >= 400 {
+		return pluginerror.RawHTTPStatusError{
+			Status:      cached.Status,
+			RawResponse: cached.body,
+		}
+	}
+
+	return decodeResult(passedResponse)
+}
+
+// isUnavailable returns true if the request failed because the server could
+// not be reached or failed to respond.
+func isUnavailable(err error, passedResponse *plugin.Response) bool {
+	switch err.(type) {
+	case pluginerror.RequestError:
+		return true
+	case pluginerror.RawHTTPStatusError:
+		return passedResponse.HTTPResponse != nil && passedResponse.HTTPResponse.StatusCode >= 500
+	default:
+		return false
+	}
+}
+
+func decodeResult(passedResponse *plugin.Response) error {
+	if passedResponse.Result == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewBuffer(passedResponse.RawResponse))
+	decoder.UseNumber()
+	return decoder.Decode(passedResponse.Result)
+}
@@ -0,0 +1,299 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	. "code.cloudfoundry.org/cli/api/plugin/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache Request", func() {
+	var (
+		fakeConnection *pluginfakes.FakeConnection
+		cacheDir       string
+		maxSize        int64
+		offline        bool
+		fallbackURLs   []string
+		fallbackErrs   []error
+		wrapper        plugin.Connection
+
+		makeRequest func(url string) (map[string]string, *plugin.Response, error)
+		respondWith func(statusCode int, etag string, body string)
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(pluginfakes.FakeConnection)
+
+		var err error
+		cacheDir, err = ioutil.TempDir("", "plugin-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		maxSize = 1024 * 1024
+		offline = false
+		fallbackURLs = nil
+		fallbackErrs = nil
+
+		makeRequest = func(url string) (map[string]string, *plugin.Response, error) {
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			var result map[string]string
+			response := &plugin.Response{Result: &result}
+			err = wrapper.Make(request, response, nil)
+			return result, response, err
+		}
+
+		respondWith = func(statusCode int, etag string, body string) {
+			fakeConnection.MakeStub = func(request *http.Request, passedResponse *plugin.Response, _ plugin.ProxyReader) error {
+				Expect(passedResponse.Result).To(BeNil())
+
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: statusCode,
+					Status:     http.StatusText(statusCode),
+					Header:     http.Header{},
+				}
+				if etag != "" {
+					passedResponse.HTTPResponse.Header.Set("ETag", etag)
+				}
+				passedResponse.RawResponse = []byte(body)
+
+				if statusCode >= 400 {
+					return pluginerror.RawHTTPStatusError{Status: http.StatusText(statusCode), RawResponse: []byte(body)}
+				}
+				return nil
+			}
+		}
+	})
+
+	JustBeforeEach(func() {
+		wrapper = NewCacheRequest(cacheDir, maxSize, offline, func(url string, cachedAt time.Time, err error) {
+			Expect(cachedAt).To(BeTemporally("~", time.Now(), time.Minute))
+			fallbackURLs = append(fallbackURLs, url)
+			fallbackErrs = append(fallbackErrs, err)
+		}).Wrap(fakeConnection)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(cacheDir)
+	})
+
+	Context("when the response is not cached", func() {
+		BeforeEach(func() {
+			respondWith(http.StatusOK, `"some-etag"`, `{"name":"some-value"}`)
+		})
+
+		It("makes the request unconditionally and decodes the response", func() {
+			result, _, err := makeRequest("https://example.com/list")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(map[string]string{"name": "some-value"}))
+
+			request, _, _ := fakeConnection.MakeArgsForCall(0)
+			Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+		})
+	})
+
+	Context("when the response is cached", func() {
+		BeforeEach(func() {
+			respondWith(http.StatusOK, `"some-etag"`, `{"name":"some-value"}`)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest(http.MethodGet, "https://example.com/list", nil)
+			Expect(err).ToNot(HaveOccurred())
+			onlineWrapper := NewCacheRequest(cacheDir, maxSize, false, nil).Wrap(fakeConnection)
+			Expect(onlineWrapper.Make(request, &plugin.Response{}, nil)).To(Succeed())
+		})
+
+		It("revalidates it with its ETag", func() {
+			respondWith(http.StatusNotModified, "", "")
+
+			result, response, err := makeRequest("https://example.com/list")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(map[string]string{"name": "some-value"}))
+			Expect(response.RawResponse).To(Equal([]byte(`{"name":"some-value"}`)))
+
+			request, _, _ := fakeConnection.MakeArgsForCall(1)
+			Expect(request.Header.Get("If-None-Match")).To(Equal(`"some-etag"`))
+		})
+
+		It("replaces it when it has changed", func() {
+			respondWith(http.StatusOK, `"other-etag"`, `{"name":"other-value"}`)
+
+			result, _, err := makeRequest("https://example.com/list")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(map[string]string{"name": "other-value"}))
+
+			fakeConnection.MakeReturns(errors.New("should not be called"))
+			offlineWrapper := NewCacheRequest(cacheDir, maxSize, true, nil).Wrap(fakeConnection)
+			request, err := http.NewRequest(http.MethodGet, "https://example.com/list", nil)
+			Expect(err).ToNot(HaveOccurred())
+			response := &plugin.Response{}
+			Expect(offlineWrapper.Make(request, response, nil)).To(Succeed())
+			Expect(response.RawResponse).To(Equal([]byte(`{"name":"other-value"}`)))
+		})
+
+		Context("when the server cannot be reached", func() {
+			var requestErr error
+
+			BeforeEach(func() {
+				requestErr = pluginerror.RequestError{Err: errors.New("connection refused")}
+			})
+
+			It("falls back to the cached response", func() {
+				fakeConnection.MakeStub = nil
+				fakeConnection.MakeReturns(requestErr)
+
+				result, _, err := makeRequest("https://example.com/list")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(map[string]string{"name": "some-value"}))
+
+				Expect(fallbackURLs).To(Equal([]string{"https://example.com/list"}))
+				Expect(fallbackErrs).To(Equal([]error{requestErr}))
+			})
+		})
+
+		Context("when the server responds with a 5XX status code", func() {
+			It("falls back to the cached response", func() {
+				respondWith(http.StatusBadGateway, "", "")
+
+				result, _, err := makeRequest("https://example.com/list")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(map[string]string{"name": "some-value"}))
+				Expect(fallbackURLs).To(HaveLen(1))
+			})
+		})
+
+		Context("when the server responds with a 4XX status code", func() {
+			It("returns the error", func() {
+				respondWith(http.StatusForbidden, "", "")
+
+				_, _, err := makeRequest("https://example.com/list")
+				Expect(err).To(MatchError(pluginerror.RawHTTPStatusError{Status: "Forbidden", RawResponse: []byte{}}))
+				Expect(fallbackURLs).To(BeEmpty())
+			})
+		})
+
+		Context("when offline", func() {
+			BeforeEach(func() {
+				offline = true
+			})
+
+			It("returns the cached response without making the request", func() {
+				result, _, err := makeRequest("https://example.com/list")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(map[string]string{"name": "some-value"}))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+
+			Context("when the cached body does not match the checksum in its metadata", func() {
+				It("returns a NotCachedError", func() {
+					files, err := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(files).To(HaveLen(1))
+					Expect(ioutil.WriteFile(strings.TrimSuffix(files[0], ".json"), []byte(`{"name":"tampered"}`), 0600)).To(Succeed())
+
+					_, _, err = makeRequest("https://example.com/list")
+					Expect(err).To(MatchError(pluginerror.NotCachedError{URL: "https://example.com/list"}))
+				})
+			})
+		})
+
+		It("stores only the body and the metadata", func() {
+			files, err := ioutil.ReadDir(cacheDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+		})
+	})
+
+	Context("when the cached responses add up to more than the maximum size", func() {
+		BeforeEach(func() {
+			maxSize = 30
+			respondWith(http.StatusOK, "", `{"name":"some-value"}`)
+		})
+
+		It("removes the least recently cached responses", func() {
+			_, _, err := makeRequest("https://example.com/list-1")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = makeRequest("https://example.com/list-2")
+			Expect(err).ToNot(HaveOccurred())
+
+			offlineWrapper := NewCacheRequest(cacheDir, maxSize, true, nil).Wrap(fakeConnection)
+
+			request, err := http.NewRequest(http.MethodGet, "https://example.com/list-1", nil)
+			Expect(err).ToNot(HaveOccurred())
+			err = offlineWrapper.Make(request, &plugin.Response{}, nil)
+			Expect(err).To(MatchError(pluginerror.NotCachedError{URL: "https://example.com/list-1"}))
+
+			request, err = http.NewRequest(http.MethodGet, "https://example.com/list-2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(offlineWrapper.Make(request, &plugin.Response{}, nil)).To(Succeed())
+		})
+	})
+
+	Context("when the server cannot be reached and the response is not cached", func() {
+		It("returns the error", func() {
+			fakeConnection.MakeReturns(pluginerror.RequestError{Err: errors.New("connection refused")})
+
+			_, _, err := makeRequest("https://example.com/list")
+			Expect(err).To(MatchError("connection refused"))
+			Expect(fallbackURLs).To(BeEmpty())
+		})
+	})
+
+	Context("when offline and the response is not cached", func() {
+		BeforeEach(func() {
+			offline = true
+		})
+
+		It("returns a NotCachedError", func() {
+			_, _, err := makeRequest("https://example.com/list")
+			Expect(err).To(MatchError(pluginerror.NotCachedError{URL: "https://example.com/list"}))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the server responds with 404 Not Found", func() {
+		BeforeEach(func() {
+			respondWith(http.StatusNotFound, "", "not found")
+		})
+
+		It("caches the response so that it can be replayed offline", func() {
+			_, _, err := makeRequest("https://example.com/plugin.sig")
+			Expect(err).To(HaveOccurred())
+
+			offlineWrapper := NewCacheRequest(cacheDir, maxSize, true, nil).Wrap(fakeConnection)
+			request, err := http.NewRequest(http.MethodGet, "https://example.com/plugin.sig", nil)
+			Expect(err).ToNot(HaveOccurred())
+			response := &plugin.Response{}
+			err = offlineWrapper.Make(request, response, nil)
+			Expect(err).To(MatchError(pluginerror.RawHTTPStatusError{Status: "Not Found", RawResponse: []byte("not found")}))
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusNotFound))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the request is not a GET request", func() {
+		It("passes it through without caching", func() {
+			request, err := http.NewRequest(http.MethodPost, "https://example.com/list", nil)
+			Expect(err).ToNot(HaveOccurred())
+			response := &plugin.Response{}
+
+			Expect(wrapper.Make(request, response, nil)).To(Succeed())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			files, err := ioutil.ReadDir(cacheDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		})
+	})
+})
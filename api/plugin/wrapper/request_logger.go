@@ -14,13 +14,17 @@ import (
 
 // RequestLoggerOutput is the interface for displaying logs
 type RequestLoggerOutput interface {
+	DisplayAttempt(attempt int) error
+	DisplayBodySize(size int64) error
 	DisplayDump(dump string) error
+	DisplayElapsedTime(elapsed time.Duration) error
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
 	DisplayJSONBody(body []byte) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
+	DisplayURL(url string) error
 	HandleInternalError(err error)
 	Start() error
 	Stop() error
@@ -31,6 +35,9 @@ type RequestLoggerOutput interface {
 type RequestLogger struct {
 	connection plugin.Connection
 	output     RequestLoggerOutput
+
+	lastRequest *http.Request
+	attempt     int
 }
 
 // NewRequestLogger returns a pointer to a RequestLogger wrapper
@@ -48,15 +55,26 @@ func (logger *RequestLogger) Wrap(innerconnection plugin.Connection) plugin.Conn
 
 // Make records the request and the response to UI
 func (logger *RequestLogger) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
+	// The retry and authentication wrappers resend the same request, so seeing
+	// it again means this is another attempt at it.
+	if request == logger.lastRequest {
+		logger.attempt++
+	} else {
+		logger.lastRequest = request
+		logger.attempt = 1
+	}
+
 	err := logger.displayRequest(request)
 	if err != nil {
 		logger.output.HandleInternalError(err)
 	}
 
+	startTime := time.Now()
 	err = logger.connection.Make(request, passedResponse, proxyReader)
+	elapsed := time.Since(startTime)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(passedResponse, elapsed)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -84,6 +102,18 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayURL(request.URL.String())
+	if err != nil {
+		return err
+	}
+	err = logger.output.DisplayAttempt(logger.attempt)
+	if err != nil {
+		return err
+	}
+	err = logger.output.DisplayBodySize(request.ContentLength)
+	if err != nil {
+		return err
+	}
 	err = logger.displaySortedHeaders(request.Header)
 	if err != nil {
 		return err
@@ -106,7 +136,7 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *plugin.Response) error {
+func (logger *RequestLogger) displayResponse(passedResponse *plugin.Response, elapsed time.Duration) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -121,6 +151,14 @@ func (logger *RequestLogger) displayResponse(passedResponse *plugin.Response) er
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayElapsedTime(elapsed)
+	if err != nil {
+		return err
+	}
+	err = logger.output.DisplayBodySize(int64(len(passedResponse.RawResponse)))
+	if err != nil {
+		return err
+	}
 	err = logger.displaySortedHeaders(passedResponse.HTTPResponse.Header)
 	if err != nil {
 		return err
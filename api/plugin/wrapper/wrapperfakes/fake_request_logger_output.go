@@ -9,6 +9,28 @@ import (
 )
 
 type FakeRequestLoggerOutput struct {
+	DisplayAttemptStub        func(attempt int) error
+	displayAttemptMutex       sync.RWMutex
+	displayAttemptArgsForCall []struct {
+		attempt int
+	}
+	displayAttemptReturns struct {
+		result1 error
+	}
+	displayAttemptReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DisplayBodySizeStub        func(size int64) error
+	displayBodySizeMutex       sync.RWMutex
+	displayBodySizeArgsForCall []struct {
+		size int64
+	}
+	displayBodySizeReturns struct {
+		result1 error
+	}
+	displayBodySizeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayDumpStub        func(dump string) error
 	displayDumpMutex       sync.RWMutex
 	displayDumpArgsForCall []struct {
@@ -20,6 +42,17 @@ type FakeRequestLoggerOutput struct {
 	displayDumpReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisplayElapsedTimeStub        func(elapsed time.Duration) error
+	displayElapsedTimeMutex       sync.RWMutex
+	displayElapsedTimeArgsForCall []struct {
+		elapsed time.Duration
+	}
+	displayElapsedTimeReturns struct {
+		result1 error
+	}
+	displayElapsedTimeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayHeaderStub        func(name string, value string) error
 	displayHeaderMutex       sync.RWMutex
 	displayHeaderArgsForCall []struct {
@@ -91,6 +124,17 @@ type FakeRequestLoggerOutput struct {
 	displayTypeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisplayURLStub        func(url string) error
+	displayURLMutex       sync.RWMutex
+	displayURLArgsForCall []struct {
+		url string
+	}
+	displayURLReturns struct {
+		result1 error
+	}
+	displayURLReturnsOnCall map[int]struct {
+		result1 error
+	}
 	HandleInternalErrorStub        func(err error)
 	handleInternalErrorMutex       sync.RWMutex
 	handleInternalErrorArgsForCall []struct {
@@ -118,6 +162,102 @@ type FakeRequestLoggerOutput struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeRequestLoggerOutput) DisplayAttempt(attempt int) error {
+	fake.displayAttemptMutex.Lock()
+	ret, specificReturn := fake.displayAttemptReturnsOnCall[len(fake.displayAttemptArgsForCall)]
+	fake.displayAttemptArgsForCall = append(fake.displayAttemptArgsForCall, struct {
+		attempt int
+	}{attempt})
+	fake.recordInvocation("DisplayAttempt", []interface{}{attempt})
+	fake.displayAttemptMutex.Unlock()
+	if fake.DisplayAttemptStub != nil {
+		return fake.DisplayAttemptStub(attempt)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.displayAttemptReturns.result1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayAttemptCallCount() int {
+	fake.displayAttemptMutex.RLock()
+	defer fake.displayAttemptMutex.RUnlock()
+	return len(fake.displayAttemptArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayAttemptArgsForCall(i int) int {
+	fake.displayAttemptMutex.RLock()
+	defer fake.displayAttemptMutex.RUnlock()
+	return fake.displayAttemptArgsForCall[i].attempt
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayAttemptReturns(result1 error) {
+	fake.DisplayAttemptStub = nil
+	fake.displayAttemptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayAttemptReturnsOnCall(i int, result1 error) {
+	fake.DisplayAttemptStub = nil
+	if fake.displayAttemptReturnsOnCall == nil {
+		fake.displayAttemptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayAttemptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayBodySize(size int64) error {
+	fake.displayBodySizeMutex.Lock()
+	ret, specificReturn := fake.displayBodySizeReturnsOnCall[len(fake.displayBodySizeArgsForCall)]
+	fake.displayBodySizeArgsForCall = append(fake.displayBodySizeArgsForCall, struct {
+		size int64
+	}{size})
+	fake.recordInvocation("DisplayBodySize", []interface{}{size})
+	fake.displayBodySizeMutex.Unlock()
+	if fake.DisplayBodySizeStub != nil {
+		return fake.DisplayBodySizeStub(size)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.displayBodySizeReturns.result1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayBodySizeCallCount() int {
+	fake.displayBodySizeMutex.RLock()
+	defer fake.displayBodySizeMutex.RUnlock()
+	return len(fake.displayBodySizeArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayBodySizeArgsForCall(i int) int64 {
+	fake.displayBodySizeMutex.RLock()
+	defer fake.displayBodySizeMutex.RUnlock()
+	return fake.displayBodySizeArgsForCall[i].size
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayBodySizeReturns(result1 error) {
+	fake.DisplayBodySizeStub = nil
+	fake.displayBodySizeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayBodySizeReturnsOnCall(i int, result1 error) {
+	fake.DisplayBodySizeStub = nil
+	if fake.displayBodySizeReturnsOnCall == nil {
+		fake.displayBodySizeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayBodySizeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRequestLoggerOutput) DisplayDump(dump string) error {
 	fake.displayDumpMutex.Lock()
 	ret, specificReturn := fake.displayDumpReturnsOnCall[len(fake.displayDumpArgsForCall)]
@@ -166,6 +306,54 @@ func (fake *FakeRequestLoggerOutput) DisplayDumpReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakeRequestLoggerOutput) DisplayElapsedTime(elapsed time.Duration) error {
+	fake.displayElapsedTimeMutex.Lock()
+	ret, specificReturn := fake.displayElapsedTimeReturnsOnCall[len(fake.displayElapsedTimeArgsForCall)]
+	fake.displayElapsedTimeArgsForCall = append(fake.displayElapsedTimeArgsForCall, struct {
+		elapsed time.Duration
+	}{elapsed})
+	fake.recordInvocation("DisplayElapsedTime", []interface{}{elapsed})
+	fake.displayElapsedTimeMutex.Unlock()
+	if fake.DisplayElapsedTimeStub != nil {
+		return fake.DisplayElapsedTimeStub(elapsed)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.displayElapsedTimeReturns.result1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayElapsedTimeCallCount() int {
+	fake.displayElapsedTimeMutex.RLock()
+	defer fake.displayElapsedTimeMutex.RUnlock()
+	return len(fake.displayElapsedTimeArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayElapsedTimeArgsForCall(i int) time.Duration {
+	fake.displayElapsedTimeMutex.RLock()
+	defer fake.displayElapsedTimeMutex.RUnlock()
+	return fake.displayElapsedTimeArgsForCall[i].elapsed
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayElapsedTimeReturns(result1 error) {
+	fake.DisplayElapsedTimeStub = nil
+	fake.displayElapsedTimeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayElapsedTimeReturnsOnCall(i int, result1 error) {
+	fake.DisplayElapsedTimeStub = nil
+	if fake.displayElapsedTimeReturnsOnCall == nil {
+		fake.displayElapsedTimeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayElapsedTimeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRequestLoggerOutput) DisplayHeader(name string, value string) error {
 	fake.displayHeaderMutex.Lock()
 	ret, specificReturn := fake.displayHeaderReturnsOnCall[len(fake.displayHeaderArgsForCall)]
@@ -464,6 +652,54 @@ func (fake *FakeRequestLoggerOutput) DisplayTypeReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakeRequestLoggerOutput) DisplayURL(url string) error {
+	fake.displayURLMutex.Lock()
+	ret, specificReturn := fake.displayURLReturnsOnCall[len(fake.displayURLArgsForCall)]
+	fake.displayURLArgsForCall = append(fake.displayURLArgsForCall, struct {
+		url string
+	}{url})
+	fake.recordInvocation("DisplayURL", []interface{}{url})
+	fake.displayURLMutex.Unlock()
+	if fake.DisplayURLStub != nil {
+		return fake.DisplayURLStub(url)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.displayURLReturns.result1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayURLCallCount() int {
+	fake.displayURLMutex.RLock()
+	defer fake.displayURLMutex.RUnlock()
+	return len(fake.displayURLArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayURLArgsForCall(i int) string {
+	fake.displayURLMutex.RLock()
+	defer fake.displayURLMutex.RUnlock()
+	return fake.displayURLArgsForCall[i].url
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayURLReturns(result1 error) {
+	fake.DisplayURLStub = nil
+	fake.displayURLReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayURLReturnsOnCall(i int, result1 error) {
+	fake.DisplayURLStub = nil
+	if fake.displayURLReturnsOnCall == nil {
+		fake.displayURLReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayURLReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRequestLoggerOutput) HandleInternalError(err error) {
 	fake.handleInternalErrorMutex.Lock()
 	fake.handleInternalErrorArgsForCall = append(fake.handleInternalErrorArgsForCall, struct {
@@ -571,8 +807,14 @@ func (fake *FakeRequestLoggerOutput) StopReturnsOnCall(i int, result1 error) {
 func (fake *FakeRequestLoggerOutput) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.displayAttemptMutex.RLock()
+	defer fake.displayAttemptMutex.RUnlock()
+	fake.displayBodySizeMutex.RLock()
+	defer fake.displayBodySizeMutex.RUnlock()
 	fake.displayDumpMutex.RLock()
 	defer fake.displayDumpMutex.RUnlock()
+	fake.displayElapsedTimeMutex.RLock()
+	defer fake.displayElapsedTimeMutex.RUnlock()
 	fake.displayHeaderMutex.RLock()
 	defer fake.displayHeaderMutex.RUnlock()
 	fake.displayHostMutex.RLock()
@@ -585,6 +827,8 @@ func (fake *FakeRequestLoggerOutput) Invocations() map[string][][]interface{} {
 	defer fake.displayResponseHeaderMutex.RUnlock()
 	fake.displayTypeMutex.RLock()
 	defer fake.displayTypeMutex.RUnlock()
+	fake.displayURLMutex.RLock()
+	defer fake.displayURLMutex.RUnlock()
 	fake.handleInternalErrorMutex.RLock()
 	defer fake.handleInternalErrorMutex.RUnlock()
 	fake.startMutex.RLock()
@@ -0,0 +1,77 @@
+package uaa
+
+import (
+	"strings"
+	"time"
+
+	"github.com/SermoDigital/jose/jws"
+)
+
+// AccessTokenClaims are the claims of a UAA access token that are of interest
+// to the CLI.
+type AccessTokenClaims struct {
+	// UserName is the name of the user the token was granted to. It is empty
+	// for tokens granted to clients.
+	UserName string
+
+	// UserID is the GUID of the user the token was granted to.
+	UserID string
+
+	// ClientID is the ID of the client that requested the token.
+	ClientID string
+
+	// GrantType is the OAuth grant that was used to obtain the token.
+	GrantType string
+
+	// Scopes are the scopes granted to the token.
+	Scopes []string
+
+	// IssuedAt is when the token was issued.
+	IssuedAt time.Time
+
+	// ExpiresAt is when the token expires. It is zero if the token does not
+	// expire.
+	ExpiresAt time.Time
+}
+
+// DecodeAccessToken decodes the claims of a UAA JWT access token without
+// verifying its signature. The token may be prefixed with its type, e.g.
+// "bearer".
+func DecodeAccessToken(accessToken string) (AccessTokenClaims, error) {
+	if index := strings.LastIndex(accessToken, " "); index >= 0 {
+		accessToken = accessToken[index+1:]
+	}
+
+	token, err := jws.ParseJWT([]byte(accessToken))
+	if err != nil {
+		return AccessTokenClaims{}, err
+	}
+
+	claims := token.Claims()
+	decoded := AccessTokenClaims{}
+	decoded.UserName, _ = claims.Get("user_name").(string)
+	decoded.UserID, _ = claims.Get("user_id").(string)
+	decoded.ClientID, _ = claims.Get("client_id").(string)
+	decoded.GrantType, _ = claims.Get("grant_type").(string)
+	decoded.IssuedAt, _ = claims.IssuedAt()
+	decoded.ExpiresAt, _ = claims.Expiration()
+
+	if scopes, ok := claims.Get("scope").([]interface{}); ok {
+		for _, scope := range scopes {
+			if scope, ok := scope.(string); ok {
+				decoded.Scopes = append(decoded.Scopes, scope)
+			}
+		}
+	}
+
+	return decoded, nil
+}
+
+// ExpiresWithin returns true if the token expires within the given duration
+// of the provided time. Tokens without an expiry never expire.
+func (claims AccessTokenClaims) ExpiresWithin(now time.Time, duration time.Duration) bool {
+	if claims.ExpiresAt.IsZero() {
+		return false
+	}
+	return !now.Add(duration).Before(claims.ExpiresAt)
+}
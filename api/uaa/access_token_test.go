@@ -0,0 +1,86 @@
+package uaa_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Access Token", func() {
+	Describe("DecodeAccessToken", func() {
+		var accessToken string
+
+		BeforeEach(func() {
+			accessToken = "bearer eyJhbGciOiJSUzI1NiIsImtpZCI6ImxlZ2FjeS10b2tlbi1rZXkiLCJ0eXAiOiJKV1QifQ.eyJqdGkiOiI3YzZkMDA2MjA2OTI0NmViYWI0ZjBmZjY3NGQ3Zjk4OSIsInN1YiI6Ijk1MTliZTNlLTQ0ZDktNDBkMC1hYjlhLWY0YWNlMTFkZjE1OSIsInNjb3BlIjpbIm9wZW5pZCIsInJvdXRpbmcucm91dGVyX2dyb3Vwcy53cml0ZSIsInNjaW0ucmVhZCIsImNsb3VkX2NvbnRyb2xsZXIuYWRtaW4iLCJ1YWEudXNlciIsInJvdXRpbmcucm91dGVyX2dyb3Vwcy5yZWFkIiwiY2xvdWRfY29udHJvbGxlci5yZWFkIiwicGFzc3dvcmQud3JpdGUiLCJjbG91ZF9jb250cm9sbGVyLndyaXRlIiwiZG9wcGxlci5maXJlaG9zZSIsInNjaW0ud3JpdGUiXSwiY2xpZW50X2lkIjoiY2YiLCJjaWQiOiJjZiIsImF6cCI6ImNmIiwiZ3JhbnRfdHlwZSI6InBhc3N3b3JkIiwidXNlcl9pZCI6Ijk1MTliZTNlLTQ0ZDktNDBkMC1hYjlhLWY0YWNlMTFkZjE1OSIsIm9yaWdpbiI6InVhYSIsInVzZXJfbmFtZSI6ImFkbWluIiwiZW1haWwiOiJhZG1pbiIsImF1dGhfdGltZSI6MTQ3MzI4NDU3NywicmV2X3NpZyI6IjZiMjdkYTZjIiwiaWF0IjoxNDczMjg0NTc3LCJleHAiOjE0NzMyODUxNzcsImlzcyI6Imh0dHBzOi8vdWFhLmJvc2gtbGl0ZS5jb20vb2F1dGgvdG9rZW4iLCJ6aWQiOiJ1YWEiLCJhdWQiOlsiY2YiLCJvcGVuaWQiLCJyb3V0aW5nLnJvdXRlcl9ncm91cHMiLCJzY2ltIiwiY2xvdWRfY29udHJvbGxlciIsInVhYSIsInBhc3N3b3JkIiwiZG9wcGxlciJdfQ.OcH_w9yIKJkEcTZMThIs-qJAHk3G0JwNjG-aomVH9hKye4ciFO6IMQMLKmCBrrAQVc7ST1SZZwq7gv12Dq__6Jp-hai0a2_ADJK-Vc9YXyNZKgYTWIeVNGM1JGdHgFSrBR2Lz7IIrH9HqeN8plrKV5HzU8uI9LL4lyOCjbXJ9cM"
+		})
+
+		It("returns the claims of the token", func() {
+			claims, err := DecodeAccessToken(accessToken)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims).To(Equal(AccessTokenClaims{
+				UserName:  "admin",
+				UserID:    "9519be3e-44d9-40d0-ab9a-f4ace11df159",
+				ClientID:  "cf",
+				GrantType: "password",
+				Scopes: []string{
+					"openid",
+					"routing.router_groups.write",
+					"scim.read",
+					"cloud_controller.admin",
+					"uaa.user",
+					"routing.router_groups.read",
+					"cloud_controller.read",
+					"password.write",
+					"cloud_controller.write",
+					"doppler.firehose",
+					"scim.write",
+				},
+				IssuedAt:  time.Unix(1473284577, 0),
+				ExpiresAt: time.Unix(1473285177, 0),
+			}))
+		})
+
+		Context("when the token has no type prefix", func() {
+			It("returns the claims of the token", func() {
+				claims, err := DecodeAccessToken(accessToken[len("bearer "):])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(claims.UserName).To(Equal("admin"))
+			})
+		})
+
+		Context("when the token is not a JWT", func() {
+			It("returns an error", func() {
+				_, err := DecodeAccessToken("bearer not-a-jwt")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ExpiresWithin", func() {
+		var (
+			now    time.Time
+			claims AccessTokenClaims
+		)
+
+		BeforeEach(func() {
+			now = time.Unix(1473285000, 0)
+			claims = AccessTokenClaims{ExpiresAt: now.Add(time.Minute)}
+		})
+
+		It("returns true when the token expires within the duration", func() {
+			Expect(claims.ExpiresWithin(now, 2*time.Minute)).To(BeTrue())
+			Expect(claims.ExpiresWithin(now, time.Minute)).To(BeTrue())
+		})
+
+		It("returns false when the token expires after the duration", func() {
+			Expect(claims.ExpiresWithin(now, 30*time.Second)).To(BeFalse())
+		})
+
+		It("returns false when the token has no expiry", func() {
+			Expect(AccessTokenClaims{}.ExpiresWithin(now, time.Hour)).To(BeFalse())
+		})
+	})
+})
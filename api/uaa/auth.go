@@ -8,6 +8,16 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
+// GrantType is the type of OAuth grant used to obtain an access token.
+type GrantType string
+
+const (
+	// GrantTypePassword authenticates a user with a username and password.
+	GrantTypePassword GrantType = "password"
+	// GrantTypeClientCredentials authenticates a client with its ID and secret.
+	GrantTypeClientCredentials GrantType = "client_credentials"
+)
+
 // AuthResponse contains the access token and refresh token which are granted
 // after UAA has authorized a user.
 type AuthResponse struct {
@@ -21,7 +31,7 @@ func (client Client) Authenticate(username string, password string) (string, str
 	requestBody := url.Values{}
 	requestBody.Set("username", username)
 	requestBody.Set("password", password)
-	requestBody.Set("grant_type", "password")
+	requestBody.Set("grant_type", string(GrantTypePassword))
 
 	request, err := client.newRequest(requestOptions{
 		RequestName: internal.PostOAuthTokenRequest,
@@ -43,3 +53,31 @@ func (client Client) Authenticate(username string, password string) (string, str
 	err = client.connection.Make(request, &response)
 	return responseBody.AccessToken, responseBody.RefreshToken, err
 }
+
+// AuthenticateClient sends a client ID and secret to UAA using the client
+// credentials grant then returns an access token. No refresh token is granted
+// to clients.
+func (client Client) AuthenticateClient(clientID string, clientSecret string) (string, error) {
+	requestBody := url.Values{}
+	requestBody.Set("grant_type", string(GrantTypeClientCredentials))
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthTokenRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return "", err
+	}
+	request.SetBasicAuth(clientID, clientSecret)
+
+	responseBody := AuthResponse{}
+	response := Response{
+		Result: &responseBody,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseBody.AccessToken, err
+}
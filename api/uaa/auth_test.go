@@ -79,4 +79,56 @@ var _ = Describe("Auth", func() {
 			})
 		})
 	})
+
+	Describe("AuthenticateClient", func() {
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				response := `{
+						"access_token":"some-access-token",
+						"token_type":"bearer"
+					}`
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+						VerifyBasicAuth("some-client-id", "some-client-secret"),
+						VerifyBody([]byte("grant_type=client_credentials")),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("authenticates with the client credentials provided", func() {
+				accessToken, err := client.AuthenticateClient("some-client-id", "some-client-secret")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(accessToken).To(Equal("some-access-token"))
+			})
+		})
+
+		Context("when an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+						"error": "some-error",
+						"error_description": "some-description"
+					}`
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						RespondWith(http.StatusTeapot, response),
+					))
+			})
+
+			It("returns the error", func() {
+				_, err := client.AuthenticateClient("some-client-id", "some-client-secret")
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
 })
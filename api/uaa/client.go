@@ -15,8 +15,9 @@ import (
 
 // Client is the UAA client
 type Client struct {
-	id     string
-	secret string
+	id        string
+	secret    string
+	grantType GrantType
 
 	connection Connection
 	router     *internal.Router
@@ -41,6 +42,10 @@ type Config struct {
 	// ClientSecret is the UAA client secret the client will use.
 	ClientSecret string
 
+	// GrantType is the grant type that was used to obtain the current access
+	// token. It determines how RefreshAccessToken gets a new access token.
+	GrantType GrantType
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -62,8 +67,9 @@ func NewClient(config Config) *Client {
 	)
 
 	client := Client{
-		id:     config.ClientID,
-		secret: config.ClientSecret,
+		id:        config.ClientID,
+		secret:    config.ClientSecret,
+		grantType: config.GrantType,
 
 		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
 		userAgent:  userAgent,
@@ -22,13 +22,25 @@ func (refreshTokenResponse RefreshedTokens) AuthorizationToken() string {
 }
 
 // RefreshAccessToken refreshes the current access token.
+//
+// Clients authenticated with the client credentials grant are not given a
+// refresh token, so when the client is configured with that grant type a new
+// access token is requested with the client's credentials instead and the
+// provided refresh token is ignored.
 func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens, error) {
-	body := strings.NewReader(url.Values{
-		"client_id":     {client.id},
-		"client_secret": {client.secret},
-		"grant_type":    {"refresh_token"},
-		"refresh_token": {refreshToken},
-	}.Encode())
+	var body *strings.Reader
+	if client.grantType == GrantTypeClientCredentials {
+		body = strings.NewReader(url.Values{
+			"grant_type": {string(GrantTypeClientCredentials)},
+		}.Encode())
+	} else {
+		body = strings.NewReader(url.Values{
+			"client_id":     {client.id},
+			"client_secret": {client.secret},
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {refreshToken},
+		}.Encode())
+	}
 
 	request, err := client.newRequest(requestOptions{
 		RequestName: internal.PostOAuthTokenRequest,
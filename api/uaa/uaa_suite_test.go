@@ -58,6 +58,10 @@ var _ = BeforeEach(func() {
 })
 
 func NewTestUAAClientAndStore() *Client {
+	return NewTestUAAClientAndStoreWithGrantType("")
+}
+
+func NewTestUAAClientAndStoreWithGrantType(grantType GrantType) *Client {
 	SetupBootstrapResponse()
 
 	client := NewClient(Config{
@@ -65,6 +69,7 @@ func NewTestUAAClientAndStore() *Client {
 		AppVersion:        "Unknown",
 		ClientID:          "client-id",
 		ClientSecret:      "client-secret",
+		GrantType:         grantType,
 		SkipSSLValidation: true,
 	})
 
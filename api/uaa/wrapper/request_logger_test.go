@@ -80,6 +80,9 @@ var _ = Describe("Request Logger", func() {
 			host := fakeOutput.DisplayHostArgsForCall(0)
 			Expect(host).To(Equal("foo.bar.com"))
 
+			Expect(fakeOutput.DisplayURLCallCount()).To(Equal(1))
+			Expect(fakeOutput.DisplayURLArgsForCall(0)).To(MatchRegexp("^https://foo\\.bar\\.com/banana\\?(?:query1=a&query2=b|query2=b&query1=a)$"))
+
 			Expect(fakeOutput.DisplayHeaderCallCount()).To(BeNumerically(">=", 3))
 			name, value := fakeOutput.DisplayHeaderArgsForCall(0)
 			Expect(name).To(Equal("Abc"))
@@ -92,6 +95,25 @@ var _ = Describe("Request Logger", func() {
 			Expect(value).To(Equal("bar"))
 		})
 
+		It("outputs the attempt and the size of the request", func() {
+			Expect(fakeOutput.DisplayAttemptCallCount()).To(Equal(1))
+			Expect(fakeOutput.DisplayAttemptArgsForCall(0)).To(Equal(1))
+
+			Expect(fakeOutput.DisplayBodySizeCallCount()).To(BeNumerically(">=", 1))
+			Expect(fakeOutput.DisplayBodySizeArgsForCall(0)).To(Equal(int64(0)))
+		})
+
+		Context("when the same request is made again", func() {
+			JustBeforeEach(func() {
+				makeErr = wrapper.Make(request, response)
+			})
+
+			It("increments the attempt", func() {
+				Expect(fakeOutput.DisplayAttemptCallCount()).To(Equal(2))
+				Expect(fakeOutput.DisplayAttemptArgsForCall(1)).To(Equal(2))
+			})
+		})
+
 		Context("when an authorization header is in the request", func() {
 			BeforeEach(func() {
 				request.Header = http.Header{"Authorization": []string{"should not be shown"}}
@@ -190,6 +212,14 @@ var _ = Describe("Request Logger", func() {
 				}
 			})
 
+			It("outputs the elapsed time and the size of the response", func() {
+				Expect(fakeOutput.DisplayElapsedTimeCallCount()).To(Equal(1))
+				Expect(fakeOutput.DisplayElapsedTimeArgsForCall(0)).To(BeNumerically(">=", 0))
+
+				Expect(fakeOutput.DisplayBodySizeCallCount()).To(Equal(2))
+				Expect(fakeOutput.DisplayBodySizeArgsForCall(1)).To(Equal(int64(len("some-response-body"))))
+			})
+
 			It("outputs the response", func() {
 				Expect(makeErr).NotTo(HaveOccurred())
 
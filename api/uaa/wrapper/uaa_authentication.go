@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 )
@@ -29,9 +30,10 @@ type TokenCache interface {
 // UAAAuthentication wraps connections and adds authentication headers to all
 // requests
 type UAAAuthentication struct {
-	connection uaa.Connection
-	client     UAAClient
-	cache      TokenCache
+	connection  uaa.Connection
+	client      UAAClient
+	cache       TokenCache
+	refreshSkew time.Duration
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -54,8 +56,15 @@ func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
 }
 
+// SetTokenRefreshSkew sets how long before the access token expires the
+// wrapper will refresh it.
+func (t *UAAAuthentication) SetTokenRefreshSkew(skew time.Duration) {
+	t.refreshSkew = skew
+}
+
 // Make adds authentication headers to the passed in request and then calls the
-// wrapped connection's Make
+// wrapped connection's Make. The access token is refreshed before the request
+// is made if it expires within the token refresh skew.
 func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Response) error {
 	if t.client == nil {
 		return t.connection.Make(request, passedResponse)
@@ -78,18 +87,22 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 		}
 	}
 
+	if t.accessTokenExpiresSoon() {
+		err = t.refreshToken()
+		if err != nil {
+			return err
+		}
+	}
+
 	request.Header.Set("Authorization", t.cache.AccessToken())
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
-		tokens, refreshErr := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		refreshErr := t.refreshToken()
 		if refreshErr != nil {
 			return refreshErr
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
@@ -100,6 +113,28 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 	return err
 }
 
+// accessTokenExpiresSoon returns true if the cached access token is a JWT
+// that expires within the token refresh skew. Tokens that cannot be decoded
+// are left for the server to reject.
+func (t *UAAAuthentication) accessTokenExpiresSoon() bool {
+	claims, err := uaa.DecodeAccessToken(t.cache.AccessToken())
+	if err != nil {
+		return false
+	}
+	return claims.ExpiresWithin(time.Now(), t.refreshSkew)
+}
+
+func (t *UAAAuthentication) refreshToken() error {
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return err
+	}
+
+	t.cache.SetAccessToken(tokens.AuthorizationToken())
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+	return nil
+}
+
 // The authentication header is not added to token refresh requests or login
 // requests.
 func skipAuthenticationHeader(request *http.Request, body []byte) bool {
@@ -108,5 +143,6 @@ func skipAuthenticationHeader(request *http.Request, body []byte) bool {
 	return strings.Contains(request.URL.String(), "/oauth/token") &&
 		request.Method == http.MethodPost &&
 		(strings.Contains(stringBody, "grant_type=refresh_token") ||
-			strings.Contains(stringBody, "grant_type=password"))
+			strings.Contains(stringBody, "grant_type=password") ||
+			strings.Contains(stringBody, "grant_type=client_credentials"))
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -105,6 +106,73 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when the token expires within the refresh skew", func() {
+			BeforeEach(func() {
+				request = &http.Request{
+					Header: http.Header{},
+				}
+				inner.SetTokenRefreshSkew(time.Minute)
+				inMemoryCache.SetAccessToken(buildAccessToken(time.Now().Add(30 * time.Second)))
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token before making the request", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+			})
+
+			Context("when refreshing the token fails", func() {
+				BeforeEach(func() {
+					fakeClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{}, errors.New("refresh failed"))
+				})
+
+				It("returns the error without making the request", func() {
+					err := wrapper.Make(request, nil)
+					Expect(err).To(MatchError("refresh failed"))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the token expires after the refresh skew", func() {
+			var accessToken string
+
+			BeforeEach(func() {
+				request = &http.Request{
+					Header: http.Header{},
+				}
+				inner.SetTokenRefreshSkew(time.Minute)
+				accessToken = buildAccessToken(time.Now().Add(time.Hour))
+				inMemoryCache.SetAccessToken(accessToken)
+			})
+
+			It("does not refresh the token", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal(accessToken))
+			})
+		})
+
 		Context("when the token is invalid", func() {
 			var expectedBody string
 
@@ -213,5 +281,31 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
 			})
 		})
+
+		Context("when logging in with client credentials", func() {
+			var originalAuthHeader string
+			BeforeEach(func() {
+				body := strings.NewReader(url.Values{
+					"grant_type": {"client_credentials"},
+				}.Encode())
+
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+				Expect(err).NotTo(HaveOccurred())
+				request.SetBasicAuth("some-user", "some-password")
+				originalAuthHeader = request.Header.Get("Authorization")
+
+				inMemoryCache.SetAccessToken("some-access-token")
+
+				err = wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not change the 'Authorization' header", func() {
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+				request, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+			})
+		})
 	})
 })
@@ -2,7 +2,10 @@ package wrapper_test
 
 import (
 	"bytes"
+	"encoding/base64"
+	"fmt"
 	"log"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -34,3 +37,11 @@ var _ = SynchronizedAfterSuite(func() {
 var _ = BeforeEach(func() {
 	server.Reset()
 })
+
+// buildAccessToken returns an unsigned bearer JWT that expires at the
+// provided time.
+func buildAccessToken(expiration time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"user_name":"some-user","exp":%d}`, expiration.Unix())))
+	return fmt.Sprintf("bearer %s.%s.c2lnbmF0dXJl", header, claims)
+}
@@ -186,6 +186,11 @@ func (uaa UAARepository) RefreshAuthToken() (string, error) {
 		"grant_type":    {"refresh_token"},
 		"scope":         {""},
 	}
+	if uaa.config.UAAGrantType() == "client_credentials" {
+		data = url.Values{
+			"grant_type": {"client_credentials"},
+		}
+	}
 
 	apiErr := uaa.getAuthToken(data)
 	updatedToken := uaa.config.AccessToken()
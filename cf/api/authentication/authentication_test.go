@@ -204,6 +204,22 @@ var _ = Describe("AuthenticationRepository", func() {
 				_, apiErr = auth.RefreshAuthToken()
 			})
 
+			Context("when a client credentials grant was used", func() {
+				BeforeEach(func() {
+					setupTestServer(clientCredentialsRefreshRequest)
+					config.SetUAAOAuthClient("some-client")
+					config.SetUAAOAuthClientSecret("some-secret")
+					config.SetUAAGrantType("client_credentials")
+				})
+
+				It("requests a new token with the client credentials", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(config.AccessToken()).To(Equal("BEARER my_access_token"))
+					Expect(config.RefreshToken()).To(BeEmpty())
+				})
+			})
+
 			Context("when the refresh token has expired", func() {
 				BeforeEach(func() {
 					setupTestServer(refreshTokenExpiredRequestError)
@@ -361,6 +377,34 @@ var successfulLoginMatcher = func(request *http.Request) {
 	Expect(request.Form.Get("scope")).To(Equal(""))
 }
 
+var clientCredentialsRefreshRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: http.Header{
+		"accept":        {"application/json"},
+		"content-type":  {"application/x-www-form-urlencoded"},
+		"authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte("some-client:some-secret"))},
+	},
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		if err != nil {
+			Fail(fmt.Sprintf("Failed to parse form: %s", err))
+			return
+		}
+
+		Expect(request.Form.Get("grant_type")).To(Equal("client_credentials"))
+		Expect(request.Form.Get("refresh_token")).To(BeEmpty())
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_access_token",
+  "token_type": "BEARER",
+  "expires_in": 98765
+} `},
+}
+
 var unsuccessfulLoginRequest = testnet.TestRequest{
 	Method: "POST",
 	Path:   "/oauth/token",
@@ -20,6 +20,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/plugin/rpc"
 	"code.cloudfoundry.org/cli/util/spellcheck"
 
@@ -113,21 +114,18 @@ func Main(traceEnv string, args []string) {
 		for _, req := range reqs {
 			err = req.Execute()
 			if err != nil {
-				deps.UI.Failed(err.Error())
-				os.Exit(1)
+				failed(deps.UI, err)
 			}
 		}
 
 		err = cmd.Execute(flagContext)
 		if err != nil {
-			deps.UI.Failed(err.Error())
-			os.Exit(1)
+			failed(deps.UI, err)
 		}
 
 		err = warningsCollector.PrintWarnings()
 		if err != nil {
-			deps.UI.Failed(err.Error())
-			os.Exit(1)
+			failed(deps.UI, err)
 		}
 
 		os.Exit(0)
@@ -159,6 +157,20 @@ func Main(traceEnv string, args []string) {
 	}
 }
 
+// failed displays err with its error code, if it has one, and exits with the
+// exit status of its error category.
+func failed(ui terminal.UI, err error) {
+	ui.Failed(err.Error())
+
+	translatedErr := HandleError(err)
+	if code := translatableerror.ErrorCode(translatedErr); code != "" {
+		ui.Say(T("Error code: {{.ErrorCode}}", map[string]interface{}{
+			"ErrorCode": code,
+		}))
+	}
+	os.Exit(int(translatableerror.ExitStatusFor(translatedErr)))
+}
+
 func suggestCommands(cmdName string, ui terminal.UI, cmdsList []string) {
 	cmdSuggester := spellcheck.NewCommandSuggester(cmdsList)
 	recommendedCmds := cmdSuggester.Recommend(cmdName)
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// HandleError converts the errors returned by the legacy commands and their
+// requirements to the equivalent translatable error, so that legacy failures
+// are given the same error code and exit status as the new commands. Errors
+// without an equivalent are returned unchanged.
+func HandleError(err error) error {
+	switch e := err.(type) {
+	case requirements.NoAPIEndpointError:
+		return translatableerror.NoAPISetError{}
+	case requirements.NotLoggedInError:
+		return translatableerror.NotLoggedInError{}
+	case requirements.NoOrgTargetedError:
+		return translatableerror.NoOrganizationTargetedError{}
+	case requirements.NoSpaceTargetedError:
+		if e.NoOrgTargeted {
+			return translatableerror.NoOrganizationTargetedError{}
+		}
+		return translatableerror.NoSpaceTargetedError{}
+
+	case *errors.InvalidTokenError:
+		return translatableerror.InvalidRefreshTokenError{}
+
+	case *errors.ModelNotFoundError:
+		switch e.ModelType {
+		case "App":
+			return translatableerror.ApplicationNotFoundError{Name: e.ModelName}
+		case "Organization":
+			return translatableerror.OrganizationNotFoundError{Name: e.ModelName}
+		case "Space":
+			return translatableerror.SpaceNotFoundError{Name: e.ModelName}
+		case "Service instance":
+			return translatableerror.ServiceInstanceNotFoundError{Name: e.ModelName}
+		case "Stack":
+			return translatableerror.StackNotFoundError{Name: e.ModelName}
+		case "security group":
+			return translatableerror.SecurityGroupNotFoundError{Name: e.ModelName}
+		}
+
+	case *errors.StagingFailedError:
+		return translatableerror.StagingFailedError{Message: e.Error()}
+
+	case *errors.InvalidSSLCert:
+		return translatableerror.InvalidSSLCertError{API: e.URL}
+	case *errors.RequestError:
+		return translatableerror.APIRequestError{Err: e}
+
+	case *errors.AsyncTimeoutError:
+		return translatableerror.JobTimeoutError{}
+	case *errors.StagingTimeoutError:
+		return translatableerror.StagingTimeoutError{AppName: e.AppName, Timeout: e.Timeout}
+	case *errors.StartupTimeoutError:
+		return translatableerror.StartupTimeoutError{}
+	}
+
+	return err
+}
@@ -0,0 +1,47 @@
+package cmd_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HandleError", func() {
+	DescribeTable("gives legacy errors the code and exit status of the equivalent translatable error",
+		func(err error, expectedCode string, expectedStatus translatableerror.ExitStatus) {
+			translatedErr := HandleError(err)
+			Expect(translatableerror.ErrorCode(translatedErr)).To(Equal(expectedCode))
+			Expect(translatableerror.ExitStatusFor(translatedErr)).To(Equal(expectedStatus))
+		},
+
+		Entry("no API endpoint", requirements.NoAPIEndpointError{}, "NoAPISet", translatableerror.ExitStatusNotTargeted),
+		Entry("not logged in", requirements.NotLoggedInError{}, "NotLoggedIn", translatableerror.ExitStatusNotLoggedIn),
+		Entry("invalid token", errors.NewInvalidTokenError("expired"), "InvalidRefreshToken", translatableerror.ExitStatusNotLoggedIn),
+		Entry("no org targeted", requirements.NoOrgTargetedError{}, "NoOrganizationTargeted", translatableerror.ExitStatusNotTargeted),
+		Entry("no org and space targeted", requirements.NoSpaceTargetedError{NoOrgTargeted: true}, "NoOrganizationTargeted", translatableerror.ExitStatusNotTargeted),
+		Entry("no space targeted", requirements.NoSpaceTargetedError{}, "NoSpaceTargeted", translatableerror.ExitStatusNotTargeted),
+		Entry("app not found", errors.NewModelNotFoundError("App", "some-app"), "ApplicationNotFound", translatableerror.ExitStatusNotFound),
+		Entry("org not found", errors.NewModelNotFoundError("Organization", "some-org"), "OrganizationNotFound", translatableerror.ExitStatusNotFound),
+		Entry("space not found", errors.NewModelNotFoundError("Space", "some-space"), "SpaceNotFound", translatableerror.ExitStatusNotFound),
+		Entry("service instance not found", errors.NewModelNotFoundError("Service instance", "some-service"), "ServiceInstanceNotFound", translatableerror.ExitStatusNotFound),
+		Entry("staging failed", errors.NewStagingFailedError("some-reason"), "StagingFailed", translatableerror.ExitStatusStagingFailed),
+		Entry("invalid SSL certificate", errors.NewInvalidSSLCert("api.example.com", "unknown authority"), "InvalidSSLCert", translatableerror.ExitStatusAPIUnreachable),
+		Entry("request failed", errors.NewRequestError("Error performing request"), "APIRequestFailed", translatableerror.ExitStatusAPIUnreachable),
+		Entry("async job timed out", errors.NewAsyncTimeoutError("/v2/jobs/some-job"), "JobTimeout", translatableerror.ExitStatusTimeout),
+		Entry("staging timed out", errors.NewStagingTimeoutError("some-app", time.Minute), "StagingTimeout", translatableerror.ExitStatusTimeout),
+		Entry("start timed out", errors.NewStartupTimeoutError("Start app timeout"), "StartupTimeout", translatableerror.ExitStatusTimeout),
+		Entry("other model not found", errors.NewModelNotFoundError("Domain", "example.com"), "", translatableerror.ExitStatusFailure),
+		Entry("any other error", errors.New("some-error"), "", translatableerror.ExitStatusFailure),
+	)
+
+	It("returns errors without an equivalent unchanged", func() {
+		err := errors.New("some-error")
+		Expect(HandleError(err)).To(Equal(err))
+	})
+})
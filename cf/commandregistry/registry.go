@@ -26,9 +26,13 @@ func initI18nFunc() bool {
 		if ce, ok := err.(translatableerror.EmptyConfigError); ok {
 			configErrTemplate = ce.Error()
 		} else {
+			errMsg := err.Error()
+			if translatableErr, ok := err.(translatableerror.TranslatableError); ok {
+				errMsg = translatableErr.Translate(Init(&configv3.Config{}))
+			}
 			fmt.Println(FailureColor("FAILED"))
-			fmt.Println("Error read/writing config: ", err.Error())
-			os.Exit(1)
+			fmt.Println("Error read/writing config: ", errMsg)
+			os.Exit(int(translatableerror.ExitStatusFor(err)))
 		}
 	}
 
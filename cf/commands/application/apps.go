@@ -27,18 +27,26 @@ type ListApps struct {
 	pluginCall      bool
 }
 
+// appsColumns are the untranslated columns of the apps table, which the
+// --columns, --sort-by and --filter flags refer to.
+var appsColumns = []string{"name", "requested state", "instances", "memory", "disk", "urls"}
+
 func init() {
 	commandregistry.Register(&ListApps{})
 }
 
 func (cmd *ListApps) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	uihelpers.AddTableViewFlags(fs)
+
 	return commandregistry.CommandMetadata{
 		Name:        "apps",
 		ShortName:   "a",
 		Description: T("List all apps in the target space"),
 		Usage: []string{
-			"CF_NAME apps",
+			"CF_NAME apps" + uihelpers.TableViewUsage,
 		},
+		Flags: fs,
 	}
 }
 
@@ -69,6 +77,11 @@ func (cmd *ListApps) SetDependency(deps commandregistry.Dependency, pluginCall b
 }
 
 func (cmd *ListApps) Execute(c flags.FlagContext) error {
+	view, err := uihelpers.TableView(c, appsColumns)
+	if err != nil {
+		return err
+	}
+
 	cmd.ui.Say(T("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
 			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -99,6 +112,7 @@ func (cmd *ListApps) Execute(c flags.FlagContext) error {
 		// T("app ports"),
 		T("urls"),
 	})
+	table.SetView(view, appsColumns)
 
 	for _, application := range apps {
 		var urls []string
@@ -0,0 +1,203 @@
+package application_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("scp command", func() {
+	var (
+		ui *testterm.FakeUI
+
+		sshCodeGetter         *commandsfakes.FakeSSHCodeGetter
+		originalSSHCodeGetter commandregistry.Command
+
+		requirementsFactory *requirementsfakes.FakeFactory
+		configRepo          coreconfig.Repository
+		deps                commandregistry.Dependency
+
+		fakeSecureShell *sshfakes.FakeSecureShell
+		testServer      *httptest.Server
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		deps = commandregistry.Dependency{Gateways: make(map[string]net.Gateway)}
+
+		//save original command and restore later
+		originalSSHCodeGetter = commandregistry.Commands.FindCommand("ssh-code")
+
+		sshCodeGetter = new(commandsfakes.FakeSSHCodeGetter)
+		sshCodeGetter.SetDependencyStub = func(_ commandregistry.Dependency, _ bool) commandregistry.Command {
+			return sshCodeGetter
+		}
+		sshCodeGetter.MetaDataReturns(commandregistry.CommandMetadata{Name: "ssh-code"})
+
+		fakeSecureShell = new(sshfakes.FakeSecureShell)
+		deps.WildcardDependency = fakeSecureShell
+
+		getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/info",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body:   getInfoResponseBody,
+			},
+		})
+		testServer, _ = testnet.NewServer([]testnet.TestRequest{getRequest})
+		configRepo.SetAPIEndpoint(testServer.URL)
+		deps.Gateways["cloud-controller"] = net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{}, new(tracefakes.FakePrinter), "")
+
+		app := models.Application{}
+		app.Name = "my-app"
+		app.State = "started"
+		app.GUID = "my-app-guid"
+		app.Diego = true
+		app.InstanceCount = 2
+
+		applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+		applicationReq.GetApplicationReturns(app)
+		requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+
+		//restore original command
+		commandregistry.Register(originalSSHCodeGetter)
+	})
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+
+		//inject fake 'sshCodeGetter' into registry
+		commandregistry.Register(sshCodeGetter)
+
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("scp").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("scp", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided exactly two args", func() {
+			Expect(runCommand("my-app:/tmp/a")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires SOURCE and DESTINATION as arguments"},
+			))
+		})
+
+		It("fails with usage when neither path is on an app instance", func() {
+			Expect(runCommand("a", "b")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Exactly one path must be on an app instance"},
+				[]string{"USAGE:"},
+			))
+		})
+
+		It("fails requirements when not logged in", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
+			Expect(runCommand("my-app:/tmp/a", "a")).To(BeFalse())
+		})
+
+		It("requires the app named in the remote path", func() {
+			runCommand("my-app:/tmp/a", "a")
+			Expect(requirementsFactory.NewApplicationRequirementArgsForCall(0)).To(Equal("my-app"))
+		})
+	})
+
+	Context("when the app instance does not exist", func() {
+		It("returns an error", func() {
+			Expect(runCommand("my-app:2:/tmp/a", "a")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"The specified application instance does not exist"},
+			))
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the source is on an app instance", func() {
+		It("connects to the instance and downloads", func() {
+			Expect(runCommand("-r", "my-app:1:/tmp/a", "a")).To(BeTrue())
+
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+			Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+				AppName:             "my-app",
+				Index:               1,
+				SkipRemoteExecution: true,
+			}))
+
+			Expect(fakeSecureShell.DownloadCallCount()).To(Equal(1))
+			remotePath, localPath, recursive, progress := fakeSecureShell.DownloadArgsForCall(0)
+			Expect(remotePath).To(Equal("/tmp/a"))
+			Expect(localPath).To(Equal("a"))
+			Expect(recursive).To(BeTrue())
+			Expect(progress).NotTo(BeNil())
+
+			Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the destination is on an app instance", func() {
+		It("connects to the instance and uploads", func() {
+			Expect(runCommand("-k", "a", "my-app:/tmp/a")).To(BeTrue())
+
+			Expect(fakeSecureShell.ConnectArgsForCall(0).SkipHostValidation).To(BeTrue())
+			Expect(fakeSecureShell.UploadCallCount()).To(Equal(1))
+			localPath, remotePath, recursive, _ := fakeSecureShell.UploadArgsForCall(0)
+			Expect(localPath).To(Equal("a"))
+			Expect(remotePath).To(Equal("/tmp/a"))
+			Expect(recursive).To(BeFalse())
+		})
+	})
+
+	Context("when connecting fails", func() {
+		It("notifies users", func() {
+			fakeSecureShell.ConnectReturns(errors.New("dial error"))
+
+			Expect(runCommand("a", "my-app:/tmp/a")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Error opening SSH connection", "dial error"},
+			))
+		})
+	})
+
+	Context("when copying fails", func() {
+		It("notifies users", func() {
+			fakeSecureShell.UploadReturns(errors.New("scp: /tmp/a: Permission denied"))
+
+			Expect(runCommand("a", "my-app:/tmp/a")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Error copying files", "Permission denied"},
+			))
+		})
+	})
+})
@@ -1,7 +1,6 @@
 package application
 
 import (
-	"errors"
 	"fmt"
 	"os"
 	"sort"
@@ -19,6 +18,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api/logs"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -187,7 +187,7 @@ func (cmd *Start) WatchStaging(app models.Application, orgName, spaceName string
 	cmd.ui.Say("")
 
 	if !isStaged {
-		return models.Application{}, fmt.Errorf("%s failed to stage within %f minutes", app.Name, cmd.StagingTimeout.Minutes())
+		return models.Application{}, errors.NewStagingTimeoutError(app.Name, cmd.StagingTimeout)
 	}
 
 	if app.InstanceCount > 0 {
@@ -330,7 +330,7 @@ func (cmd *Start) waitForInstancesToStage(app models.Application) (bool, error)
 	if app.PackageState == "FAILED" {
 		cmd.ui.Say("")
 		if app.StagingFailedReason == "NoAppDetectedError" {
-			return false, errors.New(T(`{{.Err}}
+			return false, errors.NewStagingFailedError(T(`{{.Err}}
 			
 TIP: Buildpacks are detected when the "{{.PushCommand}}" is executed from within the directory that contains the app source code.
 
@@ -343,7 +343,7 @@ Use '{{.Command}}' for more in depth log information.`,
 					"BuildpackCommand": terminal.CommandColor(fmt.Sprintf("%s buildpacks", cf.Name)),
 					"Command":          terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))}))
 		}
-		return false, errors.New(T("{{.Err}}\n\nTIP: use '{{.Command}}' for more information",
+		return false, errors.NewStagingFailedError(T("{{.Err}}\n\nTIP: use '{{.Command}}' for more information",
 			map[string]interface{}{
 				"Err":     app.StagingFailedReason,
 				"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))}))
@@ -365,7 +365,7 @@ func (cmd *Start) waitForOneRunningInstance(app models.Application) error {
 			tipMsg := T("Start app timeout\n\nTIP: Application must be listening on the right port. Instead of hard coding the port, use the $PORT environment variable.") + "\n\n"
 			tipMsg += T("Use '{{.Command}}' for more information", map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))})
 
-			return errors.New(tipMsg)
+			return errors.NewStartupTimeoutError(tipMsg)
 
 		default:
 			count, err := cmd.fetchInstanceCount(app.GUID)
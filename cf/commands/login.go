@@ -259,7 +259,7 @@ func (cmd Login) setOrganization(c flags.FlagContext) (bool, error) {
 	orgName := c.String("o")
 
 	if orgName == "" {
-		orgs, err := cmd.orgRepo.ListOrgs(maxChoices)
+		orgs, err := cmd.orgRepo.ListOrgs(cmd.choicesLimit())
 		if err != nil {
 			return false, errors.New(T("Error finding available orgs\n{{.APIErr}}",
 				map[string]interface{}{"APIErr": err.Error()}))
@@ -312,7 +312,7 @@ func (cmd Login) setSpace(c flags.FlagContext) error {
 		var availableSpaces []models.Space
 		err := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
 			availableSpaces = append(availableSpaces, space)
-			return (len(availableSpaces) < maxChoices)
+			return cmd.choicesLimit() == 0 || len(availableSpaces) < maxChoices
 		})
 		if err != nil {
 			return errors.New(T("Error finding available spaces\n{{.Err}}",
@@ -358,7 +358,21 @@ func (cmd Login) targetSpace(space models.Space) {
 		map[string]interface{}{"SpaceName": terminal.EntityNameColor(space.Name)}))
 }
 
+// choicesLimit returns the number of orgs or spaces to fetch for the user to
+// choose from. The interactive prompt can filter any number of them, so there
+// is no limit when the UI is interactive.
+func (cmd Login) choicesLimit() int {
+	if cmd.ui.IsInteractive() {
+		return 0
+	}
+	return maxChoices
+}
+
 func (cmd Login) promptForName(names []string, listPrompt, itemPrompt string) string {
+	if cmd.ui.IsInteractive() {
+		return cmd.ui.AskForSelection(listPrompt, names)
+	}
+
 	nameIndex := 0
 	var nameString string
 	for nameIndex < 1 || nameIndex > len(names) {
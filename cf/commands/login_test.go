@@ -305,6 +305,31 @@ var _ = Describe("Login Command", func() {
 				Expect(orgRepo.FindByNameArgsForCall(0)).To(Equal("my-org-1"))
 				Expect(Config.OrganizationFields().GUID).To(Equal("my-org-guid-1"))
 			})
+
+			Context("when the UI is interactive", func() {
+				BeforeEach(func() {
+					ui.Interactive = true
+				})
+
+				It("fetches all the orgs and lets the user select one with the interactive prompt", func() {
+					ui.Inputs = []string{"api.example.com", "user@example.com", "password", "my-org-1", "my-space"}
+
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(orgRepo.ListOrgsArgsForCall(0)).To(Equal(0))
+					Expect(ui.SelectionPrompts).To(Equal([]string{
+						"Select an org (or press enter to skip):",
+						"Select a space (or press enter to skip):",
+					}))
+					Expect(ui.SelectionOptions[0]).To(HaveLen(60))
+					Expect(ui.SelectionOptions[1]).To(Equal([]string{"my-space", "some-space"}))
+					Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"There are too many options to display"}))
+
+					Expect(orgRepo.FindByNameArgsForCall(0)).To(Equal("my-org-1"))
+					Expect(Config.OrganizationFields().GUID).To(Equal("my-org-guid-1"))
+					Expect(spaceRepo.FindByNameArgsForCall(0)).To(Equal("my-space"))
+				})
+			})
 		})
 
 		Describe("when there is only a single org and space", func() {
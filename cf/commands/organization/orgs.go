@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/cf/uihelpers"
 	"code.cloudfoundry.org/cli/plugin/models"
 )
 
@@ -24,18 +25,26 @@ type ListOrgs struct {
 	pluginCall      bool
 }
 
+// orgsColumns are the untranslated columns of the orgs table, which the
+// --columns, --sort-by and --filter flags refer to.
+var orgsColumns = []string{"name"}
+
 func init() {
 	commandregistry.Register(&ListOrgs{})
 }
 
 func (cmd *ListOrgs) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	uihelpers.AddTableViewFlags(fs)
+
 	return commandregistry.CommandMetadata{
 		Name:        "orgs",
 		ShortName:   "o",
 		Description: T("List all orgs"),
 		Usage: []string{
-			"CF_NAME orgs",
+			"CF_NAME orgs" + uihelpers.TableViewUsage,
 		},
+		Flags: fs,
 	}
 }
 
@@ -65,11 +74,17 @@ func (cmd *ListOrgs) SetDependency(deps commandregistry.Dependency, pluginCall b
 }
 
 func (cmd ListOrgs) Execute(fc flags.FlagContext) error {
+	view, err := uihelpers.TableView(fc, orgsColumns)
+	if err != nil {
+		return err
+	}
+
 	cmd.ui.Say(T("Getting orgs as {{.Username}}...\n",
 		map[string]interface{}{"Username": terminal.EntityNameColor(cmd.config.Username())}))
 
 	noOrgs := true
 	table := cmd.ui.Table([]string{T("name")})
+	table.SetView(view, orgsColumns)
 
 	orgs, err := cmd.orgRepo.ListOrgs(orgLimit)
 	if err != nil {
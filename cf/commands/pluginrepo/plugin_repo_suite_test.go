@@ -15,7 +15,7 @@ func TestPluginRepo(t *testing.T) {
 	config := configuration.NewRepositoryWithDefaults()
 	i18n.T = i18n.Init(config)
 
-	_ = pluginrepo.RepoPlugins{}
+	_ = pluginrepo.ListPluginRepos{}
 
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "PluginRepo Suite")
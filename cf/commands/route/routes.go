@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/cf/uihelpers"
 )
 
 type ListRoutes struct {
@@ -23,6 +24,10 @@ type ListRoutes struct {
 	config     coreconfig.Reader
 }
 
+// routesColumns are the untranslated columns of the routes table, which the
+// --columns, --sort-by and --filter flags refer to.
+var routesColumns = []string{"space", "host", "domain", "port", "path", "type", "apps", "service"}
+
 func init() {
 	commandregistry.Register(&ListRoutes{})
 }
@@ -30,13 +35,14 @@ func init() {
 func (cmd *ListRoutes) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["orglevel"] = &flags.BoolFlag{Name: "orglevel", Usage: T("List all the routes for all spaces of current organization")}
+	uihelpers.AddTableViewFlags(fs)
 
 	return commandregistry.CommandMetadata{
 		Name:        "routes",
 		ShortName:   "r",
 		Description: T("List all routes in the current space or the current organization"),
 		Usage: []string{
-			"CF_NAME routes [--orglevel]",
+			"CF_NAME routes [--orglevel]" + uihelpers.TableViewUsage,
 		},
 		Flags: fs,
 	}
@@ -68,6 +74,11 @@ func (cmd *ListRoutes) SetDependency(deps commandregistry.Dependency, pluginCall
 }
 
 func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
+	view, err := uihelpers.TableView(c, routesColumns)
+	if err != nil {
+		return err
+	}
+
 	orglevel := c.Bool("orglevel")
 
 	if orglevel {
@@ -86,9 +97,10 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
 	}
 
 	table := cmd.ui.Table([]string{T("space"), T("host"), T("domain"), T("port"), T("path"), T("type"), T("apps"), T("service")})
+	table.SetView(view, routesColumns)
 
 	d := make(map[string]models.DomainFields)
-	err := cmd.domainRepo.ListDomainsForOrg(cmd.config.OrganizationFields().GUID, func(domain models.DomainFields) bool {
+	err = cmd.domainRepo.ListDomainsForOrg(cmd.config.OrganizationFields().GUID, func(domain models.DomainFields) bool {
 		d[domain.GUID] = domain
 		return true
 	})
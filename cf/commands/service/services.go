@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/cf/uihelpers"
 )
 
 type ListServices struct {
@@ -22,18 +23,26 @@ type ListServices struct {
 	pluginCall         bool
 }
 
+// servicesColumns are the untranslated columns of the services table, which the
+// --columns, --sort-by and --filter flags refer to.
+var servicesColumns = []string{"name", "service", "plan", "bound apps", "last operation"}
+
 func init() {
 	commandregistry.Register(&ListServices{})
 }
 
 func (cmd *ListServices) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	uihelpers.AddTableViewFlags(fs)
+
 	return commandregistry.CommandMetadata{
 		Name:        "services",
 		ShortName:   "s",
 		Description: T("List all service instances in the target space"),
 		Usage: []string{
-			"CF_NAME services",
+			"CF_NAME services" + uihelpers.TableViewUsage,
 		},
+		Flags: fs,
 	}
 }
 
@@ -64,6 +73,11 @@ func (cmd *ListServices) SetDependency(deps commandregistry.Dependency, pluginCa
 }
 
 func (cmd *ListServices) Execute(fc flags.FlagContext) error {
+	view, err := uihelpers.TableView(fc, servicesColumns)
+	if err != nil {
+		return err
+	}
+
 	cmd.ui.Say(T("Getting services in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 		map[string]interface{}{
 			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -86,6 +100,7 @@ func (cmd *ListServices) Execute(fc flags.FlagContext) error {
 	}
 
 	table := cmd.ui.Table([]string{T("name"), T("service"), T("plan"), T("bound apps"), T("last operation")})
+	table.SetView(view, servicesColumns)
 
 	for _, instance := range serviceInstances {
 		var serviceColumn string
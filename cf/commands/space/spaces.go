@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/cf/uihelpers"
 	"code.cloudfoundry.org/cli/plugin/models"
 )
 
@@ -23,17 +24,25 @@ type ListSpaces struct {
 	pluginCall  bool
 }
 
+// spacesColumns are the untranslated columns of the spaces table, which the
+// --columns, --sort-by and --filter flags refer to.
+var spacesColumns = []string{"name"}
+
 func init() {
 	commandregistry.Register(&ListSpaces{})
 }
 
 func (cmd *ListSpaces) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	uihelpers.AddTableViewFlags(fs)
+
 	return commandregistry.CommandMetadata{
 		Name:        "spaces",
 		Description: T("List all spaces in an org"),
 		Usage: []string{
-			T("CF_NAME spaces"),
+			T("CF_NAME spaces") + uihelpers.TableViewUsage,
 		},
+		Flags: fs,
 	}
 
 }
@@ -65,6 +74,11 @@ func (cmd *ListSpaces) SetDependency(deps commandregistry.Dependency, pluginCall
 }
 
 func (cmd *ListSpaces) Execute(c flags.FlagContext) error {
+	view, err := uihelpers.TableView(c, spacesColumns)
+	if err != nil {
+		return err
+	}
+
 	cmd.ui.Say(T("Getting spaces in org {{.TargetOrgName}} as {{.CurrentUser}}...\n",
 		map[string]interface{}{
 			"TargetOrgName": terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -73,7 +87,8 @@ func (cmd *ListSpaces) Execute(c flags.FlagContext) error {
 
 	foundSpaces := false
 	table := cmd.ui.Table([]string{T("name")})
-	err := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
+	table.SetView(view, spacesColumns)
+	err = cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
 		table.Add(space.Name)
 		foundSpaces = true
 
@@ -41,7 +41,7 @@ func Load() {
 	_ = featureflag.ShowFeatureFlag{}
 	_ = organization.ListOrgs{}
 	_ = plugin.Plugins{}
-	_ = pluginrepo.RepoPlugins{}
+	_ = pluginrepo.ListPluginRepos{}
 	_ = quota.CreateQuota{}
 	_ = route.CreateRoute{}
 	_ = routergroups.RouterGroups{}
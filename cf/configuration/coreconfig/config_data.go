@@ -29,6 +29,8 @@ type Data struct {
 	AccessToken              string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	UAAGrantType             string
+	CredentialHelper         string
 	SSHOAuthClient           string
 	RefreshToken             string
 	OrganizationFields       models.OrganizationFields
@@ -39,8 +41,10 @@ type Data struct {
 	ColorEnabled             string
 	Locale                   string
 	PluginRepos              []models.PluginRepo
+	PluginTrustedKeys        []models.PluginTrustedKey
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
+	TargetProfile            string
 }
 
 func NewData() *Data {
@@ -21,6 +21,8 @@ var _ = Describe("V3 Config files", func() {
 		"AccessToken": "the-access-token",
 		"UAAOAuthClient": "cf-oauth-client-id",
 		"UAAOAuthClientSecret": "cf-oauth-client-secret",
+		"UAAGrantType": "",
+		"CredentialHelper": "",
 		"SSHOAuthClient": "ssh-oauth-client-id",
 		"RefreshToken": "the-refresh-token",
 		"OrganizationFields": {
@@ -52,8 +54,15 @@ var _ = Describe("V3 Config files", func() {
 			"URL": "http://repo.com"
 		}
 		],
+		"PluginTrustedKeys": [
+		{
+			"Name": "key1",
+			"PublicKey": "the-public-key"
+		}
+		],
 		"MinCLIVersion": "6.0.0",
-		"MinRecommendedCLIVersion": "6.9.0"
+		"MinRecommendedCLIVersion": "6.9.0",
+		"TargetProfile": ""
 	}`
 
 	// V2 by virtue of ConfigVersion only
@@ -147,6 +156,12 @@ var _ = Describe("V3 Config files", func() {
 						URL:  "http://repo.com",
 					},
 				},
+				PluginTrustedKeys: []models.PluginTrustedKey{
+					{
+						Name:      "key1",
+						PublicKey: "the-public-key",
+					},
+				},
 			}
 
 			jsonData, err := data.JSONMarshalV3()
@@ -198,6 +213,12 @@ var _ = Describe("V3 Config files", func() {
 						URL:  "http://repo.com",
 					},
 				},
+				PluginTrustedKeys: []models.PluginTrustedKey{
+					{
+						Name:      "key1",
+						PublicKey: "the-public-key",
+					},
+				},
 			}
 
 			actualData := coreconfig.NewData()
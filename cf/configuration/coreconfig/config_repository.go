@@ -1,11 +1,13 @@
 package coreconfig
 
 import (
+	"os"
 	"strings"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 )
@@ -17,6 +19,12 @@ type ConfigRepository struct {
 	initOnce     *sync.Once
 	persistor    configuration.Persistor
 	onError      func(error)
+
+	storedCredentials credentialhelper.Credentials
+
+	profilePersistor configuration.Persistor
+	profileName      string
+	defaultTarget    *Data
 }
 
 type CCInfo struct {
@@ -33,6 +41,15 @@ func NewRepositoryFromFilepath(filepath string, errorHandler func(error)) Reposi
 	if errorHandler == nil {
 		return nil
 	}
+
+	if profile := os.Getenv("CF_PROFILE"); profile != "" {
+		return NewRepositoryWithTargetProfile(
+			configuration.NewDiskPersistor(filepath),
+			configuration.NewDiskPersistor(TargetProfilePath(filepath, profile)),
+			profile,
+			errorHandler,
+		)
+	}
 	return NewRepositoryFromPersistor(configuration.NewDiskPersistor(filepath), errorHandler)
 }
 
@@ -67,6 +84,7 @@ type Reader interface {
 	AccessToken() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
+	UAAGrantType() string
 	SSHOAuthClient() string
 	RefreshToken() string
 
@@ -113,6 +131,7 @@ type ReadWriter interface {
 	SetAccessToken(string)
 	SetUAAOAuthClient(string)
 	SetUAAOAuthClientSecret(string)
+	SetUAAGrantType(string)
 	SetSSHOAuthClient(string)
 	SetRefreshToken(string)
 	SetOrganizationFields(models.OrganizationFields)
@@ -139,6 +158,12 @@ type Repository interface {
 func (c *ConfigRepository) init() {
 	c.initOnce.Do(func() {
 		err := c.persistor.Load(c.data)
+		if err == nil && c.profilePersistor != nil {
+			err = c.loadTargetProfile()
+		}
+		if err == nil {
+			err = c.loadCredentials()
+		}
 		if err != nil {
 			c.onError(err)
 		}
@@ -160,12 +185,100 @@ func (c *ConfigRepository) write(cb func()) {
 
 	cb()
 
-	err := c.persistor.Save(c.data)
+	err := c.storeCredentials()
+	if err == nil {
+		err = c.save()
+	}
 	if err != nil {
 		c.onError(err)
 	}
 }
 
+// CREDENTIAL HELPER
+
+func (c *ConfigRepository) credentialHelper() string {
+	if helper := os.Getenv("CF_CREDENTIAL_HELPER"); helper != "" {
+		return helper
+	}
+	return c.data.CredentialHelper
+}
+
+// loadCredentials reads the tokens for the current target from the
+// credential helper, unless the config file still contains tokens.
+func (c *ConfigRepository) loadCredentials() error {
+	c.storedCredentials = credentialhelper.Credentials{ServerURL: c.credentialsKey()}
+
+	helper := c.credentialHelper()
+	if helper == "" || c.data.Target == "" || c.data.AccessToken != "" || c.data.RefreshToken != "" {
+		return nil
+	}
+
+	credentials, err := credentialhelper.NewHelper(helper).Get(c.credentialsKey())
+	if err == credentialhelper.ErrCredentialsNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	c.data.AccessToken = credentials.AccessToken
+	c.data.RefreshToken = credentials.RefreshToken
+	c.storedCredentials = credentials
+	return nil
+}
+
+// storeCredentials saves changed tokens with the credential helper, or erases
+// them when they have been cleared. The tokens of a previous target are left
+// in the helper.
+func (c *ConfigRepository) storeCredentials() error {
+	helper := c.credentialHelper()
+	if helper == "" || c.data.Target == "" {
+		return nil
+	}
+
+	credentials := credentialhelper.Credentials{
+		ServerURL:    c.credentialsKey(),
+		AccessToken:  c.data.AccessToken,
+		RefreshToken: c.data.RefreshToken,
+	}
+	if credentials == c.storedCredentials {
+		return nil
+	}
+
+	var err error
+	if credentials.AccessToken != "" || credentials.RefreshToken != "" {
+		err = credentialhelper.NewHelper(helper).Store(credentials)
+	} else {
+		err = credentialhelper.NewHelper(helper).Erase(credentials.ServerURL)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.storedCredentials = credentials
+	return nil
+}
+
+// credentialsKey returns the server URL the tokens are stored under in the
+// credential helper, which includes the name of the current target profile
+// like configv3 does.
+func (c *ConfigRepository) credentialsKey() string {
+	if c.data.TargetProfile == "" {
+		return c.data.Target
+	}
+	return c.data.Target + "#" + c.data.TargetProfile
+}
+
+func (c *ConfigRepository) dataWithoutCredentials() *Data {
+	if c.credentialHelper() == "" {
+		return c.data
+	}
+
+	data := *c.data
+	data.AccessToken = ""
+	data.RefreshToken = ""
+	return &data
+}
+
 // CLOSERS
 
 func (c *ConfigRepository) Close() {
@@ -250,6 +363,13 @@ func (c *ConfigRepository) UAAOAuthClientSecret() (clientID string) {
 	return
 }
 
+func (c *ConfigRepository) UAAGrantType() (grantType string) {
+	c.read(func() {
+		grantType = c.data.UAAGrantType
+	})
+	return
+}
+
 func (c *ConfigRepository) SSHOAuthClient() (clientID string) {
 	c.read(func() {
 		clientID = c.data.SSHOAuthClient
@@ -431,6 +551,11 @@ func (c *ConfigRepository) ClearSession() {
 	c.write(func() {
 		c.data.AccessToken = ""
 		c.data.RefreshToken = ""
+		if c.data.UAAGrantType == "client_credentials" {
+			c.data.UAAOAuthClient = "cf"
+			c.data.UAAOAuthClientSecret = ""
+			c.data.UAAGrantType = ""
+		}
 		c.data.OrganizationFields = models.OrganizationFields{}
 		c.data.SpaceFields = models.SpaceFields{}
 	})
@@ -502,6 +627,12 @@ func (c *ConfigRepository) SetUAAOAuthClientSecret(clientID string) {
 	})
 }
 
+func (c *ConfigRepository) SetUAAGrantType(grantType string) {
+	c.write(func() {
+		c.data.UAAGrantType = grantType
+	})
+}
+
 func (c *ConfigRepository) SetSSHOAuthClient(clientID string) {
 	c.write(func() {
 		c.data.SSHOAuthClient = clientID
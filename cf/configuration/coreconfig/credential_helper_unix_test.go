@@ -0,0 +1,132 @@
+// +build !windows
+
+package coreconfig_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/configuration"
+	"code.cloudfoundry.org/cli/cf/configuration/configurationfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
+	fakehelper "code.cloudfoundry.org/cli/util/testhelpers/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Configuration Repository with a credential helper", func() {
+	var (
+		config    coreconfig.Repository
+		persistor *configurationfakes.FakePersistor
+		helperDir string
+		helper    credentialhelper.Helper
+		savedData *coreconfig.Data
+	)
+
+	BeforeEach(func() {
+		var err error
+		helperDir, err = ioutil.TempDir("", "cli-credential-helper-tests")
+		Expect(err).ToNot(HaveOccurred())
+
+		executable, err := fakehelper.WriteFakeHelper(helperDir)
+		Expect(err).ToNot(HaveOccurred())
+		helper = credentialhelper.NewHelper(executable)
+		Expect(os.Setenv("CF_CREDENTIAL_HELPER", executable)).To(Succeed())
+
+		Expect(helper.Store(credentialhelper.Credentials{
+			ServerURL:    "https://api.foo.com",
+			AccessToken:  "stored-access-token",
+			RefreshToken: "stored-refresh-token",
+		})).To(Succeed())
+
+		persistor = new(configurationfakes.FakePersistor)
+		persistor.ExistsReturns(true)
+		persistor.LoadStub = func(data configuration.DataInterface) error {
+			data.(*coreconfig.Data).Target = "https://api.foo.com"
+			return nil
+		}
+		persistor.SaveStub = func(data configuration.DataInterface) error {
+			saved := *data.(*coreconfig.Data)
+			savedData = &saved
+			return nil
+		}
+		config = coreconfig.NewRepositoryFromPersistor(persistor, func(err error) { panic(err) })
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv("CF_CREDENTIAL_HELPER")).To(Succeed())
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	It("reads the tokens from the credential helper", func() {
+		Expect(config.AccessToken()).To(Equal("stored-access-token"))
+		Expect(config.RefreshToken()).To(Equal("stored-refresh-token"))
+	})
+
+	It("stores new tokens with the credential helper and leaves them out of the config file", func() {
+		config.SetAccessToken("new-access-token")
+
+		credentials, err := helper.Get("https://api.foo.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials.AccessToken).To(Equal("new-access-token"))
+		Expect(credentials.RefreshToken).To(Equal("stored-refresh-token"))
+
+		Expect(savedData.Target).To(Equal("https://api.foo.com"))
+		Expect(savedData.AccessToken).To(BeEmpty())
+		Expect(savedData.RefreshToken).To(BeEmpty())
+		Expect(config.AccessToken()).To(Equal("new-access-token"))
+	})
+
+	It("erases the tokens from the credential helper when the session is cleared", func() {
+		config.ClearSession()
+
+		_, err := helper.Get("https://api.foo.com")
+		Expect(err).To(MatchError(credentialhelper.ErrCredentialsNotFound))
+	})
+
+	It("keeps the tokens of the previous API when the API changes", func() {
+		config.SetAPIEndpoint("https://api.bar.com")
+		config.ClearSession()
+
+		credentials, err := helper.Get("https://api.foo.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials.AccessToken).To(Equal("stored-access-token"))
+
+		_, err = helper.Get("https://api.bar.com")
+		Expect(err).To(MatchError(credentialhelper.ErrCredentialsNotFound))
+	})
+
+	Context("when a target profile is selected", func() {
+		BeforeEach(func() {
+			Expect(helper.Store(credentialhelper.Credentials{
+				ServerURL:    "https://api.foo.com#some-profile",
+				AccessToken:  "profile-access-token",
+				RefreshToken: "profile-refresh-token",
+			})).To(Succeed())
+
+			profilePersistor := new(configurationfakes.FakePersistor)
+			profilePersistor.ExistsReturns(true)
+			profilePersistor.LoadStub = func(data configuration.DataInterface) error {
+				data.(*coreconfig.Data).Target = "https://api.foo.com"
+				return nil
+			}
+			config = coreconfig.NewRepositoryWithTargetProfile(persistor, profilePersistor, "some-profile", func(err error) { panic(err) })
+		})
+
+		It("keeps the tokens of the profile apart from other targets on the same API", func() {
+			Expect(config.AccessToken()).To(Equal("profile-access-token"))
+
+			config.SetAccessToken("new-profile-access-token")
+
+			credentials, err := helper.Get("https://api.foo.com#some-profile")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials.AccessToken).To(Equal("new-profile-access-token"))
+
+			credentials, err = helper.Get("https://api.foo.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials.AccessToken).To(Equal("stored-access-token"))
+		})
+	})
+})
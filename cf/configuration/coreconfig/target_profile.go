@@ -0,0 +1,92 @@
+package coreconfig
+
+import (
+	"errors"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/configuration"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+// NewRepositoryWithTargetProfile returns a repository that reads and writes
+// the target information, such as the API endpoint, tokens and targeted org
+// and space, with profilePersistor, and all other settings with persistor.
+// This is how the config is stored when a target profile is selected with
+// CF_PROFILE.
+func NewRepositoryWithTargetProfile(persistor configuration.Persistor, profilePersistor configuration.Persistor, profileName string, errorHandler func(error)) Repository {
+	repo := NewRepositoryFromPersistor(persistor, errorHandler).(*ConfigRepository)
+	repo.profilePersistor = profilePersistor
+	repo.profileName = profileName
+	return repo
+}
+
+// TargetProfilePath returns the path of the named target profile stored next
+// to the config file at configPath.
+func TargetProfilePath(configPath string, profileName string) string {
+	return filepath.Join(filepath.Dir(configPath), "targets", filepath.Base(profileName)+".json")
+}
+
+func (c *ConfigRepository) loadTargetProfile() error {
+	if !c.profilePersistor.Exists() {
+		return errors.New(T("Target {{.Name}} not found.", map[string]interface{}{
+			"Name": c.profileName,
+		}))
+	}
+
+	profile := NewData()
+	err := c.profilePersistor.Load(profile)
+	if err != nil {
+		return err
+	}
+
+	c.defaultTarget = NewData()
+	copyTargetInformation(c.defaultTarget, c.data)
+	c.defaultTarget.TargetProfile = c.data.TargetProfile
+
+	copyTargetInformation(c.data, profile)
+	c.data.TargetProfile = c.profileName
+	return nil
+}
+
+// save writes the config. When a target profile is selected, the target
+// information is written to the profile and the config file keeps the target
+// information it was loaded with.
+func (c *ConfigRepository) save() error {
+	data := c.dataWithoutCredentials()
+	if c.profilePersistor == nil {
+		return c.persistor.Save(data)
+	}
+
+	profile := new(Data)
+	copyTargetInformation(profile, data)
+	err := c.profilePersistor.Save(profile)
+	if err != nil {
+		return err
+	}
+
+	configData := *data
+	copyTargetInformation(&configData, c.defaultTarget)
+	configData.TargetProfile = c.defaultTarget.TargetProfile
+	return c.persistor.Save(&configData)
+}
+
+// copyTargetInformation copies the fields stored in a target profile.
+func copyTargetInformation(dst *Data, src *Data) {
+	dst.Target = src.Target
+	dst.APIVersion = src.APIVersion
+	dst.AuthorizationEndpoint = src.AuthorizationEndpoint
+	dst.DopplerEndPoint = src.DopplerEndPoint
+	dst.UaaEndpoint = src.UaaEndpoint
+	dst.RoutingAPIEndpoint = src.RoutingAPIEndpoint
+	dst.AccessToken = src.AccessToken
+	dst.RefreshToken = src.RefreshToken
+	dst.SSHOAuthClient = src.SSHOAuthClient
+	dst.UAAOAuthClient = src.UAAOAuthClient
+	dst.UAAOAuthClientSecret = src.UAAOAuthClientSecret
+	dst.UAAGrantType = src.UAAGrantType
+	dst.OrganizationFields = src.OrganizationFields
+	dst.SpaceFields = src.SpaceFields
+	dst.SSLDisabled = src.SSLDisabled
+	dst.MinCLIVersion = src.MinCLIVersion
+	dst.MinRecommendedCLIVersion = src.MinRecommendedCLIVersion
+}
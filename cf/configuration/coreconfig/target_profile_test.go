@@ -0,0 +1,90 @@
+package coreconfig_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/configuration"
+	"code.cloudfoundry.org/cli/cf/configuration/configurationfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Configuration Repository with a target profile", func() {
+	var (
+		config           coreconfig.Repository
+		persistor        *configurationfakes.FakePersistor
+		profilePersistor *configurationfakes.FakePersistor
+		configErr        error
+	)
+
+	BeforeEach(func() {
+		persistor = new(configurationfakes.FakePersistor)
+		persistor.ExistsReturns(true)
+		persistor.LoadStub = func(data configuration.DataInterface) error {
+			configData := data.(*coreconfig.Data)
+			configData.Target = "https://api.foo.com"
+			configData.AccessToken = "foo-access-token"
+			configData.ColorEnabled = "true"
+			configData.PluginTrustedKeys = []models.PluginTrustedKey{{Name: "some-key"}}
+			return nil
+		}
+
+		profilePersistor = new(configurationfakes.FakePersistor)
+		profilePersistor.ExistsReturns(true)
+		profilePersistor.LoadStub = func(data configuration.DataInterface) error {
+			profileData := data.(*coreconfig.Data)
+			profileData.Target = "https://api.bar.com"
+			profileData.AccessToken = "bar-access-token"
+			profileData.OrganizationFields = models.OrganizationFields{Name: "bar-org"}
+			return nil
+		}
+
+		configErr = nil
+		config = coreconfig.NewRepositoryWithTargetProfile(persistor, profilePersistor, "bar", func(err error) {
+			configErr = err
+		})
+	})
+
+	It("reads the target from the profile and the other settings from the config file", func() {
+		Expect(config.APIEndpoint()).To(Equal("https://api.bar.com"))
+		Expect(config.AccessToken()).To(Equal("bar-access-token"))
+		Expect(config.OrganizationFields().Name).To(Equal("bar-org"))
+		Expect(config.ColorEnabled()).To(Equal("true"))
+		Expect(configErr).ToNot(HaveOccurred())
+	})
+
+	It("writes the target to the profile and the other settings to the config file", func() {
+		config.SetSpaceFields(models.SpaceFields{Name: "bar-space"})
+		config.SetColorEnabled("false")
+
+		Expect(profilePersistor.SaveCallCount()).To(Equal(2))
+		profileData := profilePersistor.SaveArgsForCall(1).(*coreconfig.Data)
+		Expect(profileData.Target).To(Equal("https://api.bar.com"))
+		Expect(profileData.SpaceFields.Name).To(Equal("bar-space"))
+		Expect(profileData.ColorEnabled).To(BeEmpty())
+		Expect(profileData.PluginTrustedKeys).To(BeEmpty())
+
+		Expect(persistor.SaveCallCount()).To(Equal(2))
+		configData := persistor.SaveArgsForCall(1).(*coreconfig.Data)
+		Expect(configData.Target).To(Equal("https://api.foo.com"))
+		Expect(configData.AccessToken).To(Equal("foo-access-token"))
+		Expect(configData.SpaceFields.Name).To(BeEmpty())
+		Expect(configData.ColorEnabled).To(Equal("false"))
+		Expect(configData.PluginTrustedKeys).To(HaveLen(1))
+	})
+
+	Context("when the profile does not exist", func() {
+		BeforeEach(func() {
+			i18n.T = i18n.Init(testconfig.NewRepositoryWithDefaults())
+			profilePersistor.ExistsReturns(false)
+		})
+
+		It("reports an error", func() {
+			config.APIEndpoint()
+			Expect(configErr).To(MatchError("Target bar not found."))
+		})
+	})
+})
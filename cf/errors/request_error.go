@@ -0,0 +1,13 @@
+package errors
+
+type RequestError struct {
+	message string
+}
+
+func NewRequestError(message string) error {
+	return &RequestError{message: message}
+}
+
+func (err *RequestError) Error() string {
+	return err.message
+}
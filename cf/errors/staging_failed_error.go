@@ -0,0 +1,13 @@
+package errors
+
+type StagingFailedError struct {
+	message string
+}
+
+func NewStagingFailedError(message string) error {
+	return &StagingFailedError{message: message}
+}
+
+func (err *StagingFailedError) Error() string {
+	return err.message
+}
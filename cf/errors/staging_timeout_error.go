@@ -0,0 +1,22 @@
+package errors
+
+import (
+	"fmt"
+	"time"
+)
+
+type StagingTimeoutError struct {
+	AppName string
+	Timeout time.Duration
+}
+
+func NewStagingTimeoutError(appName string, timeout time.Duration) error {
+	return &StagingTimeoutError{
+		AppName: appName,
+		Timeout: timeout,
+	}
+}
+
+func (err *StagingTimeoutError) Error() string {
+	return fmt.Sprintf("%s failed to stage within %f minutes", err.AppName, err.Timeout.Minutes())
+}
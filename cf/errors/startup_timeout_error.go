@@ -0,0 +1,13 @@
+package errors
+
+type StartupTimeoutError struct {
+	message string
+}
+
+func NewStartupTimeoutError(message string) error {
+	return &StartupTimeoutError{message: message}
+}
+
+func (err *StartupTimeoutError) Error() string {
+	return err.message
+}
@@ -160,6 +160,7 @@ func newAppPresenter() appPresenter {
 					presentCommand("disable-ssh"),
 					presentCommand("ssh-enabled"),
 					presentCommand("ssh"),
+					presentCommand("scp"),
 				},
 			},
 		}, {
@@ -385,7 +386,6 @@ func newAppPresenter() appPresenter {
 					presentCommand("add-plugin-repo"),
 					presentCommand("remove-plugin-repo"),
 					presentCommand("list-plugin-repos"),
-					presentCommand("repo-plugins"),
 				},
 			},
 		}, {
@@ -0,0 +1,6 @@
+package models
+
+type PluginTrustedKey struct {
+	Name      string
+	PublicKey string
+}
@@ -3,8 +3,6 @@ package requirements
 import (
 	"fmt"
 
-	"errors"
-
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	. "code.cloudfoundry.org/cli/cf/i18n"
@@ -21,14 +19,20 @@ func NewAPIEndpointRequirement(config coreconfig.Reader) APIEndpointRequirement
 
 func (req APIEndpointRequirement) Execute() error {
 	if req.config.APIEndpoint() == "" {
-		loginTip := terminal.CommandColor(fmt.Sprintf("%s login", cf.Name))
-		apiTip := terminal.CommandColor(fmt.Sprintf("%s api", cf.Name))
-		return errors.New(T("No API endpoint set. Use '{{.LoginTip}}' or '{{.APITip}}' to target an endpoint.",
-			map[string]interface{}{
-				"LoginTip": loginTip,
-				"APITip":   apiTip,
-			}))
+		return NoAPIEndpointError{}
 	}
 
 	return nil
 }
+
+type NoAPIEndpointError struct{}
+
+func (NoAPIEndpointError) Error() string {
+	loginTip := terminal.CommandColor(fmt.Sprintf("%s login", cf.Name))
+	apiTip := terminal.CommandColor(fmt.Sprintf("%s api", cf.Name))
+	return T("No API endpoint set. Use '{{.LoginTip}}' or '{{.APITip}}' to target an endpoint.",
+		map[string]interface{}{
+			"LoginTip": loginTip,
+			"APITip":   apiTip,
+		})
+}
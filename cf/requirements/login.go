@@ -1,8 +1,6 @@
 package requirements
 
 import (
-	"errors"
-
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
@@ -23,8 +21,14 @@ func (req LoginRequirement) Execute() error {
 	}
 
 	if !req.config.IsLoggedIn() {
-		return errors.New(terminal.NotLoggedInText())
+		return NotLoggedInError{}
 	}
 
 	return nil
 }
+
+type NotLoggedInError struct{}
+
+func (NotLoggedInError) Error() string {
+	return terminal.NotLoggedInText()
+}
@@ -1,7 +1,6 @@
 package requirements
 
 import (
-	"errors"
 	"fmt"
 
 	"code.cloudfoundry.org/cli/cf"
@@ -28,8 +27,7 @@ func NewTargetedOrgRequirement(config coreconfig.Reader) TargetedOrgRequirement
 
 func (req targetedOrgAPIRequirement) Execute() error {
 	if !req.config.HasOrganization() {
-		message := fmt.Sprintf(T("No org targeted, use '{{.Command}}' to target an org.", map[string]interface{}{"Command": terminal.CommandColor(cf.Name + " target -o ORG")}))
-		return errors.New(message)
+		return NoOrgTargetedError{}
 	}
 
 	return nil
@@ -38,3 +36,9 @@ func (req targetedOrgAPIRequirement) Execute() error {
 func (req targetedOrgAPIRequirement) GetOrganizationFields() (org models.OrganizationFields) {
 	return req.config.OrganizationFields()
 }
+
+type NoOrgTargetedError struct{}
+
+func (NoOrgTargetedError) Error() string {
+	return fmt.Sprintf(T("No org targeted, use '{{.Command}}' to target an org.", map[string]interface{}{"Command": terminal.CommandColor(cf.Name + " target -o ORG")}))
+}
@@ -3,8 +3,6 @@ package requirements
 import (
 	"fmt"
 
-	"errors"
-
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	. "code.cloudfoundry.org/cli/cf/i18n"
@@ -21,14 +19,23 @@ func NewTargetedSpaceRequirement(config coreconfig.Reader) TargetedSpaceRequirem
 
 func (req TargetedSpaceRequirement) Execute() error {
 	if !req.config.HasOrganization() {
-		message := fmt.Sprintf(T("No org and space targeted, use '{{.Command}}' to target an org and space", map[string]interface{}{"Command": terminal.CommandColor(cf.Name + " target -o ORG -s SPACE")}))
-		return errors.New(message)
+		return NoSpaceTargetedError{NoOrgTargeted: true}
 	}
 
 	if !req.config.HasSpace() {
-		message := fmt.Sprintf(T("No space targeted, use '{{.Command}}' to target a space.", map[string]interface{}{"Command": terminal.CommandColor("cf target -s")}))
-		return errors.New(message)
+		return NoSpaceTargetedError{}
 	}
 
 	return nil
 }
+
+type NoSpaceTargetedError struct {
+	NoOrgTargeted bool
+}
+
+func (e NoSpaceTargetedError) Error() string {
+	if e.NoOrgTargeted {
+		return fmt.Sprintf(T("No org and space targeted, use '{{.Command}}' to target an org and space", map[string]interface{}{"Command": terminal.CommandColor(cf.Name + " target -o ORG -s SPACE")}))
+	}
+	return fmt.Sprintf(T("No space targeted, use '{{.Command}}' to target a space.", map[string]interface{}{"Command": terminal.CommandColor("cf target -s")}))
+}
@@ -0,0 +1,86 @@
+package options
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/flags"
+)
+
+type SCPOptions struct {
+	AppName            string
+	Index              uint
+	RemotePath         string
+	LocalPath          string
+	Upload             bool
+	Recursive          bool
+	SkipHostValidation bool
+}
+
+func NewSCPOptions(fc flags.FlagContext) (*SCPOptions, error) {
+	scpOptions := &SCPOptions{}
+
+	scpOptions.Recursive = fc.Bool("r")
+	scpOptions.SkipHostValidation = fc.Bool("k")
+
+	source, destination := fc.Args()[0], fc.Args()[1]
+
+	sourceApp, sourceIndex, sourcePath, sourceIsRemote := parseRemotePath(source)
+	destinationApp, destinationIndex, destinationPath, destinationIsRemote := parseRemotePath(destination)
+
+	switch {
+	case sourceIsRemote && !destinationIsRemote:
+		scpOptions.AppName = sourceApp
+		scpOptions.Index = sourceIndex
+		scpOptions.RemotePath = sourcePath
+		scpOptions.LocalPath = destination
+	case destinationIsRemote && !sourceIsRemote:
+		scpOptions.AppName = destinationApp
+		scpOptions.Index = destinationIndex
+		scpOptions.RemotePath = destinationPath
+		scpOptions.LocalPath = source
+		scpOptions.Upload = true
+	default:
+		return scpOptions, errors.New("Exactly one path must be on an app instance, given as APP_NAME[:INDEX]:PATH")
+	}
+
+	return scpOptions, nil
+}
+
+// SSHOptions returns the options for connecting to the app instance.
+func (o *SCPOptions) SSHOptions() *SSHOptions {
+	return &SSHOptions{
+		AppName:             o.AppName,
+		Index:               o.Index,
+		SkipHostValidation:  o.SkipHostValidation,
+		SkipRemoteExecution: true,
+	}
+}
+
+// parseRemotePath splits an APP_NAME[:INDEX]:PATH argument. Arguments without
+// a colon, with a path separator before the first colon, or with a volume
+// name are local paths.
+func parseRemotePath(arg string) (string, uint, string, bool) {
+	parts := strings.SplitN(arg, ":", 3)
+	if len(parts) < 2 || parts[0] == "" || strings.ContainsAny(parts[0], `/\`) || filepath.VolumeName(arg) != "" {
+		return "", 0, "", false
+	}
+
+	appName, path := parts[0], strings.Join(parts[1:], ":")
+
+	var index uint
+	if len(parts) == 3 {
+		if i, err := strconv.ParseUint(parts[1], 10, 32); err == nil {
+			index = uint(i)
+			path = parts[2]
+		}
+	}
+
+	if path == "" {
+		path = "."
+	}
+
+	return appName, index, path, true
+}
@@ -0,0 +1,132 @@
+package options_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCPOptions", func() {
+	var (
+		opts       *options.SCPOptions
+		args       []string
+		parseError error
+		fc         flags.FlagContext
+	)
+
+	Describe("Parse", func() {
+		BeforeEach(func() {
+			fc = flags.New()
+			fc.NewBoolFlag("r", "", "")
+			fc.NewBoolFlag("skip-host-validation", "k", "")
+
+			args = []string{}
+			parseError = nil
+		})
+
+		JustBeforeEach(func() {
+			err := fc.Parse(args...)
+			Expect(err).NotTo(HaveOccurred())
+
+			opts, parseError = options.NewSCPOptions(fc)
+		})
+
+		Context("when the source is on an app instance", func() {
+			BeforeEach(func() {
+				args = append(args, "app-1:/home/vcap/heap.dump", "local/heap.dump")
+			})
+
+			It("downloads from the first instance of the app", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.AppName).To(Equal("app-1"))
+				Expect(opts.Index).To(BeZero())
+				Expect(opts.RemotePath).To(Equal("/home/vcap/heap.dump"))
+				Expect(opts.LocalPath).To(Equal("local/heap.dump"))
+				Expect(opts.Upload).To(BeFalse())
+			})
+		})
+
+		Context("when the destination is on an app instance with an index", func() {
+			BeforeEach(func() {
+				args = append(args, "-r", "-k", "some-dir", "app-1:2:/tmp")
+			})
+
+			It("uploads to that instance", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.AppName).To(Equal("app-1"))
+				Expect(opts.Index).To(Equal(uint(2)))
+				Expect(opts.RemotePath).To(Equal("/tmp"))
+				Expect(opts.LocalPath).To(Equal("some-dir"))
+				Expect(opts.Upload).To(BeTrue())
+				Expect(opts.Recursive).To(BeTrue())
+				Expect(opts.SkipHostValidation).To(BeTrue())
+			})
+
+			It("returns the options to connect to the instance", func() {
+				Expect(opts.SSHOptions()).To(Equal(&options.SSHOptions{
+					AppName:             "app-1",
+					Index:               2,
+					SkipHostValidation:  true,
+					SkipRemoteExecution: true,
+				}))
+			})
+		})
+
+		Context("when the remote path contains a colon that does not follow an index", func() {
+			BeforeEach(func() {
+				args = append(args, "app-1:logs:old", "local")
+			})
+
+			It("keeps the colon in the path", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.Index).To(BeZero())
+				Expect(opts.RemotePath).To(Equal("logs:old"))
+			})
+		})
+
+		Context("when the remote path is empty", func() {
+			BeforeEach(func() {
+				args = append(args, "app-1:", "local")
+			})
+
+			It("uses the home directory", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.RemotePath).To(Equal("."))
+			})
+		})
+
+		Context("when a local path contains a colon after a path separator", func() {
+			BeforeEach(func() {
+				args = append(args, "./a:b", "app-1:/tmp")
+			})
+
+			It("treats it as local", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.LocalPath).To(Equal("./a:b"))
+				Expect(opts.Upload).To(BeTrue())
+			})
+		})
+
+		Context("when neither path is on an app instance", func() {
+			BeforeEach(func() {
+				args = append(args, "a", "b")
+			})
+
+			It("returns an error", func() {
+				Expect(parseError).To(MatchError("Exactly one path must be on an app instance, given as APP_NAME[:INDEX]:PATH"))
+			})
+		})
+
+		Context("when both paths are on app instances", func() {
+			BeforeEach(func() {
+				args = append(args, "app-1:a", "app-2:b")
+			})
+
+			It("returns an error", func() {
+				Expect(parseError).To(MatchError("Exactly one path must be on an app instance, given as APP_NAME[:INDEX]:PATH"))
+			})
+		})
+	})
+})
@@ -0,0 +1,280 @@
+package sshCmd
+
+import (
+	"crypto/rand"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
+)
+
+// Proxy serves an SSH client, such as ssh running this CLI as its
+// ProxyCommand, that speaks on in and out. The client is not authenticated and
+// is shown a new host key on every connection, so it must not check host keys
+// itself: its channels and requests are relayed to the app instance over the
+// connection opened by Connect, which has verified the host key of the SSH
+// endpoint and authenticated with the one time code. Ports that the client
+// asks to have forwarded from the app instance, as with ssh -R, are listened
+// on by the connection to the app instance, and the connections made to them
+// are relayed back to the client as forwarded-tcpip channels.
+func (c *secureShell) Proxy(in io.Reader, out io.WriteCloser) error {
+	hostKey, err := newProxyHostKey()
+	if err != nil {
+		return err
+	}
+
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(hostKey)
+
+	serverConn, channels, requests, err := ssh.NewServerConn(&stdioConn{Reader: in, WriteCloser: out}, config)
+	if err != nil {
+		return err
+	}
+	defer serverConn.Close()
+
+	target := c.secureClient.Conn()
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	forwarder := &remoteForwarder{
+		client:    serverConn,
+		target:    c.secureClient,
+		listeners: map[string]net.Listener{},
+	}
+	defer forwarder.closeAll()
+
+	go keepalive(target, time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+	go proxyGlobalRequests(target, requests, forwarder)
+
+	for newChannel := range channels {
+		go proxyChannel(target, newChannel)
+	}
+
+	return nil
+}
+
+func newProxyHostKey() (ssh.Signer, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return ssh.NewSignerFromKey(key)
+}
+
+func proxyGlobalRequests(target ssh.Conn, requests <-chan *ssh.Request, forwarder *remoteForwarder) {
+	for request := range requests {
+		var (
+			ok      bool
+			payload []byte
+		)
+
+		switch request.Type {
+		case "tcpip-forward":
+			ok, payload = forwarder.forward(request.Payload)
+		case "cancel-tcpip-forward":
+			ok = forwarder.cancel(request.Payload)
+		default:
+			var err error
+			ok, payload, err = target.SendRequest(request.Type, request.WantReply, request.Payload)
+			ok = ok && err == nil
+		}
+
+		if request.WantReply {
+			_ = request.Reply(ok, payload)
+		}
+	}
+}
+
+func proxyChannel(target ssh.Conn, newChannel ssh.NewChannel) {
+	targetChannel, targetRequests, err := target.OpenChannel(newChannel.ChannelType(), newChannel.ExtraData())
+	if err != nil {
+		if openErr, ok := err.(*ssh.OpenChannelError); ok {
+			_ = newChannel.Reject(openErr.Reason, openErr.Message)
+		} else {
+			_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+		}
+		return
+	}
+
+	sourceChannel, sourceRequests, err := newChannel.Accept()
+	if err != nil {
+		_ = targetChannel.Close()
+		return
+	}
+
+	go func() {
+		_, _ = io.Copy(targetChannel, sourceChannel)
+		_ = targetChannel.CloseWrite()
+	}()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, sourceChannel, targetChannel)
+	go copyAndDone(wg, sourceChannel.Stderr(), targetChannel.Stderr())
+
+	go func() {
+		proxyChannelRequests(targetChannel, sourceRequests)
+		_ = targetChannel.Close()
+	}()
+
+	// the app instance sends the exit status before closing the channel
+	proxyChannelRequests(sourceChannel, targetRequests)
+	wg.Wait()
+	_ = sourceChannel.Close()
+}
+
+func proxyChannelRequests(channel ssh.Channel, requests <-chan *ssh.Request) {
+	for request := range requests {
+		ok, err := channel.SendRequest(request.Type, request.WantReply, request.Payload)
+		if request.WantReply {
+			_ = request.Reply(ok && err == nil, nil)
+		}
+	}
+}
+
+// tcpipForwardPayload is the payload of tcpip-forward and
+// cancel-tcpip-forward requests (RFC 4254 section 7.1).
+type tcpipForwardPayload struct {
+	BindAddr string
+	BindPort uint32
+}
+
+// forwardedTCPPayload is the extra data of forwarded-tcpip channels (RFC 4254
+// section 7.2).
+type forwardedTCPPayload struct {
+	Addr       string
+	Port       uint32
+	OriginAddr string
+	OriginPort uint32
+}
+
+// remoteForwarder relays the ports that the client asks to have forwarded
+// from the app instance.
+type remoteForwarder struct {
+	client    ssh.Conn
+	target    SecureClient
+	mutex     sync.Mutex
+	listeners map[string]net.Listener
+}
+
+// forward listens on the requested address of the app instance. It returns
+// the port that was bound when the client asked for any port.
+func (f *remoteForwarder) forward(rawPayload []byte) (bool, []byte) {
+	var payload tcpipForwardPayload
+	err := ssh.Unmarshal(rawPayload, &payload)
+	if err != nil {
+		return false, nil
+	}
+
+	listener, err := f.target.Listen("tcp", net.JoinHostPort(payload.BindAddr, strconv.Itoa(int(payload.BindPort))))
+	if err != nil {
+		return false, nil
+	}
+
+	port := payload.BindPort
+	if addr, ok := listener.Addr().(*net.TCPAddr); ok {
+		port = uint32(addr.Port)
+	}
+
+	f.mutex.Lock()
+	f.listeners[net.JoinHostPort(payload.BindAddr, strconv.Itoa(int(port)))] = listener
+	f.mutex.Unlock()
+
+	go f.accept(listener, payload.BindAddr, port)
+
+	if payload.BindPort == 0 {
+		return true, ssh.Marshal(struct{ Port uint32 }{port})
+	}
+	return true, nil
+}
+
+// cancel stops listening on an address that was forwarded.
+func (f *remoteForwarder) cancel(rawPayload []byte) bool {
+	var payload tcpipForwardPayload
+	err := ssh.Unmarshal(rawPayload, &payload)
+	if err != nil {
+		return false
+	}
+
+	key := net.JoinHostPort(payload.BindAddr, strconv.Itoa(int(payload.BindPort)))
+
+	f.mutex.Lock()
+	listener, ok := f.listeners[key]
+	delete(f.listeners, key)
+	f.mutex.Unlock()
+
+	if !ok {
+		return false
+	}
+	return listener.Close() == nil
+}
+
+func (f *remoteForwarder) closeAll() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for key, listener := range f.listeners {
+		_ = listener.Close()
+		delete(f.listeners, key)
+	}
+}
+
+func (f *remoteForwarder) accept(listener net.Listener, bindAddr string, port uint32) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.relay(conn, bindAddr, port)
+	}
+}
+
+// relay opens a forwarded-tcpip channel to the client for a connection made
+// to a forwarded address, and copies between them.
+func (f *remoteForwarder) relay(conn net.Conn, bindAddr string, port uint32) {
+	defer conn.Close()
+
+	payload := forwardedTCPPayload{Addr: bindAddr, Port: port}
+	if origin, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		payload.OriginAddr = origin.IP.String()
+		payload.OriginPort = uint32(origin.Port)
+	}
+
+	channel, requests, err := f.client.OpenChannel("forwarded-tcpip", ssh.Marshal(payload))
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+	go ssh.DiscardRequests(requests)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndClose(wg, conn, channel)
+	go copyAndClose(wg, channel, conn)
+	wg.Wait()
+}
+
+// stdioConn is the connection to a client that speaks on its standard input
+// and output.
+type stdioConn struct {
+	io.Reader
+	io.WriteCloser
+}
+
+func (*stdioConn) LocalAddr() net.Addr                { return stdioAddr{} }
+func (*stdioConn) RemoteAddr() net.Addr               { return stdioAddr{} }
+func (*stdioConn) SetDeadline(_ time.Time) error      { return nil }
+func (*stdioConn) SetReadDeadline(_ time.Time) error  { return nil }
+func (*stdioConn) SetWriteDeadline(_ time.Time) error { return nil }
+
+type stdioAddr struct{}
+
+func (stdioAddr) Network() string { return "stdio" }
+func (stdioAddr) String() string  { return "stdio" }
@@ -0,0 +1,343 @@
+package sshCmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:generate counterfeiter . TransferProgress
+
+// TransferProgress reports the progress of the files copied by Upload and
+// Download, one file at a time.
+type TransferProgress interface {
+	Start(name string, size int64, reader io.Reader) io.Reader
+	Finish()
+}
+
+// Upload copies the local file, or with recursive the local directory, to
+// remotePath on the app instance using the scp protocol. File modes are
+// preserved.
+func (c *secureShell) Upload(localPath string, remotePath string, recursive bool, progress TransferProgress) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() && !recursive {
+		return fmt.Errorf("%q is a directory", localPath)
+	}
+
+	return c.runSCP(scpCommand("-t", recursive, remotePath), func(in io.Writer, out *bufio.Reader) error {
+		err := readSCPAck(out)
+		if err != nil {
+			return err
+		}
+
+		return sendSCP(in, out, localPath, info, progress)
+	})
+}
+
+// Download copies the file, or with recursive the directory, at remotePath on
+// the app instance to localPath using the scp protocol. File modes are
+// preserved.
+func (c *secureShell) Download(remotePath string, localPath string, recursive bool, progress TransferProgress) error {
+	return c.runSCP(scpCommand("-f", recursive, remotePath), func(in io.Writer, out *bufio.Reader) error {
+		return receiveSCP(in, out, localPath, progress)
+	})
+}
+
+func scpCommand(mode string, recursive bool, remotePath string) string {
+	command := "scp " + mode
+	if recursive {
+		command += " -r"
+	}
+	return command + " '" + strings.Replace(remotePath, "'", `'\''`, -1) + "'"
+}
+
+func (c *secureShell) runSCP(command string, transfer func(in io.Writer, out *bufio.Reader) error) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	stderrCopied := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(&stderr, errPipe)
+		close(stderrCopied)
+	}()
+
+	err = session.Start(command)
+	if err != nil {
+		return err
+	}
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	transferErr := transfer(inPipe, bufio.NewReader(outPipe))
+	_ = inPipe.Close()
+
+	waitErr := session.Wait()
+	<-stderrCopied
+
+	if transferErr != nil {
+		return transferErr
+	}
+
+	if waitErr != nil && stderr.Len() > 0 {
+		return errors.New(strings.TrimSpace(stderr.String()))
+	}
+	return waitErr
+}
+
+func sendSCP(in io.Writer, out *bufio.Reader, path string, info os.FileInfo, progress TransferProgress) error {
+	if info.IsDir() {
+		_, err := fmt.Fprintf(in, "D%04o 0 %s\n", info.Mode().Perm(), info.Name())
+		if err != nil {
+			return err
+		}
+
+		err = readSCPAck(out)
+		if err != nil {
+			return err
+		}
+
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			entryPath := filepath.Join(path, entry.Name())
+
+			// follow symlinks as scp does
+			entryInfo, err := os.Stat(entryPath)
+			if err != nil {
+				return err
+			}
+
+			err = sendSCP(in, out, entryPath, entryInfo, progress)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = fmt.Fprint(in, "E\n")
+		if err != nil {
+			return err
+		}
+
+		return readSCPAck(out)
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(in, "C%04o %d %s\n", info.Mode().Perm(), info.Size(), info.Name())
+	if err != nil {
+		return err
+	}
+
+	err = readSCPAck(out)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(in, progress.Start(info.Name(), info.Size(), file), info.Size())
+	progress.Finish()
+	if err != nil {
+		return err
+	}
+
+	_, err = in.Write([]byte{0})
+	if err != nil {
+		return err
+	}
+
+	return readSCPAck(out)
+}
+
+type receivedSCPDirectory struct {
+	path string
+	mode os.FileMode
+}
+
+func receiveSCP(in io.Writer, out *bufio.Reader, localPath string, progress TransferProgress) error {
+	var directories []receivedSCPDirectory
+
+	destination := func(name string) string {
+		if len(directories) > 0 {
+			return filepath.Join(directories[len(directories)-1].path, name)
+		}
+
+		if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+			return filepath.Join(localPath, name)
+		}
+		return localPath
+	}
+
+	for {
+		_, err := in.Write([]byte{0})
+		if err != nil {
+			return err
+		}
+
+		line, err := out.ReadString('\n')
+		if err == io.EOF && line == "" {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return errors.New("scp: unexpected empty message")
+		}
+
+		switch line[0] {
+		case 1, 2:
+			return errors.New(line[1:])
+		case 'T':
+			// modification times are not preserved
+		case 'C', 'D':
+			mode, size, name, err := parseSCPHeader(line)
+			if err != nil {
+				return err
+			}
+			path := destination(name)
+
+			if line[0] == 'D' {
+				err = os.MkdirAll(path, 0700)
+				if err != nil {
+					return err
+				}
+				directories = append(directories, receivedSCPDirectory{path: path, mode: mode})
+				break
+			}
+
+			err = receiveSCPFile(in, out, path, mode, name, size, progress)
+			if err != nil {
+				return err
+			}
+
+			err = readSCPAck(out)
+			if err != nil {
+				return err
+			}
+		case 'E':
+			if len(directories) == 0 {
+				return errors.New("scp: unexpected end of directory")
+			}
+
+			// the mode is set last so that read-only directories can be filled
+			directory := directories[len(directories)-1]
+			directories = directories[:len(directories)-1]
+			err = os.Chmod(directory.path, directory.mode)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("scp: unexpected message %q", line)
+		}
+	}
+}
+
+func receiveSCPFile(in io.Writer, out *bufio.Reader, path string, mode os.FileMode, name string, size int64, progress TransferProgress) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = in.Write([]byte{0})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(file, progress.Start(name, size, out), size)
+	progress.Finish()
+	if err != nil {
+		return err
+	}
+
+	return file.Chmod(mode)
+}
+
+// parseSCPHeader parses a C or D message such as "C0644 1024 name".
+func parseSCPHeader(line string) (os.FileMode, int64, string, error) {
+	parts := strings.SplitN(line[1:], " ", 3)
+	if len(parts) != 3 {
+		return 0, 0, "", fmt.Errorf("scp: unexpected message %q", line)
+	}
+
+	mode, err := strconv.ParseUint(parts[0], 8, 32)
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("scp: invalid file mode %q", parts[0])
+	}
+
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || size < 0 {
+		return 0, 0, "", fmt.Errorf("scp: invalid file size %q", parts[1])
+	}
+
+	name := parts[2]
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return 0, 0, "", fmt.Errorf("scp: invalid file name %q", name)
+	}
+
+	return os.FileMode(mode).Perm(), size, name, nil
+}
+
+// readSCPAck reads the response to a message, which is a zero byte on
+// success or an error message.
+func readSCPAck(out *bufio.Reader) error {
+	response, err := out.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	switch response {
+	case 0:
+		return nil
+	case 1, 2:
+		message, _ := out.ReadString('\n')
+		return errors.New(strings.TrimSpace(message))
+	default:
+		return fmt.Errorf("scp: unexpected response %q", response)
+	}
+}
@@ -0,0 +1,300 @@
+package sshCmd_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_ssh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCP", func() {
+	var (
+		fakeSecureClient  *sshfakes.FakeSecureClient
+		fakeSecureDialer  *sshfakes.FakeSecureDialer
+		fakeSecureSession *sshfakes.FakeSecureSession
+		fakeProgress      *sshfakes.FakeTransferProgress
+
+		secureShell sshCmd.SecureShell
+
+		// remote plays the part of scp on the app instance, reading what the
+		// client sends on in and replying on out
+		remote     func(in *bufio.Reader, out io.Writer)
+		remoteDone chan struct{}
+
+		localDir string
+	)
+
+	BeforeEach(func() {
+		fakeSecureClient = new(sshfakes.FakeSecureClient)
+		fakeSecureDialer = new(sshfakes.FakeSecureDialer)
+		fakeSecureSession = new(sshfakes.FakeSecureSession)
+		fakeProgress = new(sshfakes.FakeTransferProgress)
+		fakeProgress.StartStub = func(_ string, _ int64, reader io.Reader) io.Reader {
+			return reader
+		}
+
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+		fakeSecureClient.ConnReturns(new(fake_ssh.FakeConn))
+
+		stdinReader, stdinWriter := io.Pipe()
+		stdoutReader, stdoutWriter := io.Pipe()
+		fakeSecureSession.StdinPipeReturns(stdinWriter, nil)
+		fakeSecureSession.StdoutPipeReturns(stdoutReader, nil)
+		fakeSecureSession.StderrPipeReturns(strings.NewReader(""), nil)
+
+		remoteDone = make(chan struct{})
+		fakeSecureSession.StartStub = func(string) error {
+			go func() {
+				defer close(remoteDone)
+				defer stdoutWriter.Close()
+				remote(bufio.NewReader(stdinReader), stdoutWriter)
+			}()
+			return nil
+		}
+		fakeSecureSession.WaitStub = func() error {
+			<-remoteDone
+			return nil
+		}
+
+		var err error
+		localDir, err = ioutil.TempDir("", "cf-scp")
+		Expect(err).NotTo(HaveOccurred())
+
+		app := models.Application{}
+		app.State = "STARTED"
+		app.Diego = true
+
+		secureShell = sshCmd.NewSecureShell(
+			fakeSecureDialer,
+			terminal.DefaultHelper(),
+			new(sshfakes.FakeListenerFactory),
+			30*time.Second,
+			app,
+			"",
+			"",
+			"",
+		)
+		Expect(secureShell.Connect(&options.SSHOptions{AppName: "app-1", SkipHostValidation: true})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+	})
+
+	Describe("Upload", func() {
+		var received []string
+
+		BeforeEach(func() {
+			received = nil
+
+			// a sink that acknowledges every message and records the
+			// messages and file contents
+			remote = func(in *bufio.Reader, out io.Writer) {
+				_, _ = out.Write([]byte{0})
+				for {
+					line, err := in.ReadString('\n')
+					if err != nil {
+						return
+					}
+					line = strings.TrimSuffix(line, "\n")
+					received = append(received, line)
+					_, _ = out.Write([]byte{0})
+
+					if line[0] == 'C' {
+						size, _ := strconv.Atoi(strings.Split(line, " ")[1])
+						content := make([]byte, size+1)
+						_, _ = io.ReadFull(in, content)
+						received = append(received, string(content[:size]))
+						_, _ = out.Write([]byte{0})
+					}
+				}
+			}
+		})
+
+		Context("when a file is copied", func() {
+			var localFile string
+
+			BeforeEach(func() {
+				localFile = filepath.Join(localDir, "some-file")
+				Expect(ioutil.WriteFile(localFile, []byte("some-content"), 0640)).To(Succeed())
+			})
+
+			It("sends the file with its mode to scp on the app instance", func() {
+				Expect(secureShell.Upload(localFile, "/home/vcap/it's here", false, fakeProgress)).To(Succeed())
+
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -t '/home/vcap/it'\''s here'`))
+				Expect(received).To(Equal([]string{"C0640 12 some-file", "some-content"}))
+
+				Expect(fakeProgress.StartCallCount()).To(Equal(1))
+				name, size, _ := fakeProgress.StartArgsForCall(0)
+				Expect(name).To(Equal("some-file"))
+				Expect(size).To(BeEquivalentTo(12))
+				Expect(fakeProgress.FinishCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a directory is copied recursively", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(filepath.Join(localDir, "some-dir", "sub-dir"), 0750)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(localDir, "some-dir", "sub-dir", "a"), []byte("a"), 0600)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(localDir, "some-dir", "b"), []byte("bb"), 0755)).To(Succeed())
+			})
+
+			It("sends the directory tree", func() {
+				Expect(secureShell.Upload(filepath.Join(localDir, "some-dir"), "/tmp", true, fakeProgress)).To(Succeed())
+
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -t -r '/tmp'`))
+				Expect(received).To(Equal([]string{
+					"D0750 0 some-dir",
+					"C0755 2 b", "bb",
+					"D0750 0 sub-dir",
+					"C0600 1 a", "a",
+					"E",
+					"E",
+				}))
+			})
+		})
+
+		Context("when a directory is copied without recursion", func() {
+			It("returns an error without starting scp", func() {
+				err := secureShell.Upload(localDir, "/tmp", false, fakeProgress)
+				Expect(err).To(MatchError(fmt.Sprintf("%q is a directory", localDir)))
+				Expect(fakeSecureSession.StartCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when scp on the app instance returns an error", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(localDir, "some-file"), []byte("x"), 0600)).To(Succeed())
+				remote = func(in *bufio.Reader, out io.Writer) {
+					_, _ = out.Write([]byte("\x01scp: /nope: Permission denied\n"))
+					_, _ = io.Copy(ioutil.Discard, in)
+				}
+			})
+
+			It("returns the error", func() {
+				err := secureShell.Upload(filepath.Join(localDir, "some-file"), "/nope", false, fakeProgress)
+				Expect(err).To(MatchError("scp: /nope: Permission denied"))
+			})
+		})
+	})
+
+	Describe("Download", func() {
+		// a source that sends the messages, each after the client's
+		// acknowledgement
+		sendAll := func(messages ...string) func(*bufio.Reader, io.Writer) {
+			return func(in *bufio.Reader, out io.Writer) {
+				for _, message := range messages {
+					ack, err := in.ReadByte()
+					if err != nil || ack != 0 {
+						return
+					}
+					_, _ = io.WriteString(out, message)
+				}
+				_, _ = in.ReadByte()
+			}
+		}
+
+		Context("when a file is copied", func() {
+			BeforeEach(func() {
+				remote = sendAll("C0640 5 remote-file\n", "hello\x00")
+			})
+
+			It("writes the file with its mode to the local path", func() {
+				localFile := filepath.Join(localDir, "local-file")
+				Expect(secureShell.Download("/home/vcap/remote-file", localFile, false, fakeProgress)).To(Succeed())
+
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -f '/home/vcap/remote-file'`))
+				Expect(ioutil.ReadFile(localFile)).To(Equal([]byte("hello")))
+				info, err := os.Stat(localFile)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0640)))
+
+				name, size, _ := fakeProgress.StartArgsForCall(0)
+				Expect(name).To(Equal("remote-file"))
+				Expect(size).To(BeEquivalentTo(5))
+				Expect(fakeProgress.FinishCallCount()).To(Equal(1))
+			})
+
+			It("writes the file into the local path when it is a directory", func() {
+				Expect(secureShell.Download("/home/vcap/remote-file", localDir, false, fakeProgress)).To(Succeed())
+				Expect(ioutil.ReadFile(filepath.Join(localDir, "remote-file"))).To(Equal([]byte("hello")))
+			})
+		})
+
+		Context("when a directory is copied recursively", func() {
+			BeforeEach(func() {
+				remote = sendAll(
+					"D0550 0 remote-dir\n",
+					"T1500000000 0 1500000000 0\n",
+					"C0600 2 a\n", "aa\x00",
+					"E\n",
+				)
+			})
+
+			It("recreates the directory tree with its modes", func() {
+				destination := filepath.Join(localDir, "local-dir")
+				Expect(secureShell.Download("/tmp/remote-dir", destination, true, fakeProgress)).To(Succeed())
+
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -f -r '/tmp/remote-dir'`))
+				Expect(ioutil.ReadFile(filepath.Join(destination, "a"))).To(Equal([]byte("aa")))
+
+				info, err := os.Stat(destination)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0550)))
+				Expect(os.Chmod(destination, 0700)).To(Succeed())
+			})
+		})
+
+		Context("when scp on the app instance sends a file name that leaves the destination", func() {
+			BeforeEach(func() {
+				remote = sendAll("C0600 2 ../escaped\n", "aa\x00")
+			})
+
+			It("returns an error", func() {
+				err := secureShell.Download("/tmp/a", filepath.Join(localDir, "sub"), false, fakeProgress)
+				Expect(err).To(MatchError(`scp: invalid file name "../escaped"`))
+				_, statErr := os.Stat(filepath.Join(localDir, "escaped"))
+				Expect(os.IsNotExist(statErr)).To(BeTrue())
+			})
+		})
+
+		Context("when scp on the app instance sends an empty message", func() {
+			BeforeEach(func() {
+				remote = sendAll("\n")
+			})
+
+			It("returns a protocol error", func() {
+				err := secureShell.Download("/tmp/a", localDir, false, fakeProgress)
+				Expect(err).To(MatchError("scp: unexpected empty message"))
+			})
+		})
+
+		Context("when scp on the app instance returns an error", func() {
+			BeforeEach(func() {
+				remote = sendAll("\x01scp: /nope: No such file or directory\n")
+			})
+
+			It("returns the error", func() {
+				err := secureShell.Download("/nope", localDir, false, fakeProgress)
+				Expect(err).To(MatchError("scp: /nope: No such file or directory"))
+			})
+		})
+	})
+})
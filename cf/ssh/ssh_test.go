@@ -7,11 +7,15 @@
 package sshCmd_test
 
 import (
+	"bytes"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net"
 	"os"
+	"strings"
 	"syscall"
 	"time"
 
@@ -30,6 +34,7 @@ import (
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/docker/docker/pkg/term"
 	"github.com/kr/pty"
+	"golang.org/x/crypto/ed25519"
 	"golang.org/x/crypto/ssh"
 
 	. "github.com/onsi/ginkgo"
@@ -853,6 +858,65 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RunCommand", func() {
+		var (
+			stdout, stderr *bytes.Buffer
+			runError       error
+		)
+
+		BeforeEach(func() {
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("some output\n"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("some error\n"), nil)
+
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+		})
+
+		JustBeforeEach(func() {
+			Expect(secureShell.Connect(&options.SSHOptions{
+				AppName: "app-name",
+				Command: []string{"cat", "/proc/meminfo"},
+			})).To(Succeed())
+			runError = secureShell.RunCommand(stdout, stderr)
+		})
+
+		It("runs the command without a terminal and copies its output", func() {
+			Expect(runError).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("cat /proc/meminfo"))
+
+			Expect(stdout.String()).To(Equal("some output\n"))
+			Expect(stderr.String()).To(Equal("some error\n"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when the session cannot be allocated", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("woops"))
+			})
+
+			It("returns an error", func() {
+				Expect(runError).To(MatchError("SSH session allocation failed: woops"))
+			})
+		})
+
+		Context("when the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 1"))
+			})
+
+			It("returns the error from the session", func() {
+				Expect(runError).To(MatchError("exit status 1"))
+			})
+		})
+	})
+
 	Describe("LocalPortForward", func() {
 		var (
 			opts              *options.SSHOptions
@@ -1165,6 +1229,467 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			opts               *options.SSHOptions
+			remoteForwardError error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go func(listener net.Listener) {
+				for {
+					conn, err := listener.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						_, _ = io.Copy(conn, conn)
+						_ = conn.Close()
+					}()
+				}
+			}(echoListener)
+
+			// stands in for the listener on the app instance
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				RemoteForwardSpecs: []options.ForwardSpec{{
+					ListenAddress:  "localhost:9999",
+					ConnectAddress: echoListener.Addr().String(),
+				}},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			Expect(secureShell.Connect(opts)).To(Succeed())
+			remoteForwardError = secureShell.RemotePortForward()
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("listens on the app instance", func() {
+			Expect(remoteForwardError).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9999"))
+		})
+
+		It("copies data between the remote connections and the local connect address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			msg := "Hello from the app instance\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		Context("when listening on the app instance fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied"))
+			})
+
+			It("returns the error", func() {
+				Expect(remoteForwardError).To(MatchError("tcpip-forward request denied"))
+			})
+		})
+
+		Context("when the secure shell is closed", func() {
+			var fakeRemoteListener *fake_net.FakeListener
+
+			BeforeEach(func() {
+				fakeRemoteListener = &fake_net.FakeListener{}
+				fakeRemoteListener.AcceptReturns(nil, errors.New("not accepting connections"))
+				fakeSecureClient.ListenReturns(fakeRemoteListener, nil)
+			})
+
+			It("closes the remote listener", func() {
+				Eventually(fakeRemoteListener.AcceptCallCount).Should(Equal(1))
+
+				originalCloseCount := fakeRemoteListener.CloseCallCount()
+				Expect(secureShell.Close()).To(Succeed())
+				Expect(fakeRemoteListener.CloseCallCount()).To(Equal(originalCloseCount + 1))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			opts                *options.SSHOptions
+			dynamicForwardError error
+
+			echoListener  net.Listener
+			localListener net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go func(listener net.Listener) {
+				for {
+					conn, err := listener.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						_, _ = io.Copy(conn, conn)
+						_ = conn.Close()
+					}()
+				}
+			}(echoListener)
+
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(localListener, nil)
+
+			// every address is reached through the echo server
+			fakeSecureClient.DialStub = func(network string, _ string) (net.Conn, error) {
+				return net.Dial(network, echoListener.Addr().String())
+			}
+
+			opts = &options.SSHOptions{
+				AppName:             "app-1",
+				DynamicForwardSpecs: []string{"localhost:1080"},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			Expect(secureShell.Connect(opts)).To(Succeed())
+			dynamicForwardError = secureShell.DynamicPortForward()
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		// socksConnect negotiates no authentication and sends a CONNECT
+		// request for the address, returning the reply code
+		socksConnect := func(conn net.Conn, address []byte) byte {
+			_, err := conn.Write([]byte{5, 1, 0})
+			Expect(err).NotTo(HaveOccurred())
+
+			method := make([]byte, 2)
+			_, err = io.ReadFull(conn, method)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(method).To(Equal([]byte{5, 0}))
+
+			_, err = conn.Write(append([]byte{5, 1, 0}, address...))
+			Expect(err).NotTo(HaveOccurred())
+
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply[0]).To(Equal(byte(5)))
+			return reply[1]
+		}
+
+		It("listens locally", func() {
+			Expect(dynamicForwardError).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("dials the requested domain from the app instance and copies data", func() {
+			conn, err := net.Dial("tcp", localListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			domain := "some.internal.host"
+			address := append([]byte{3, byte(len(domain))}, domain...)
+			Expect(socksConnect(conn, append(address, 0x1f, 0x90))).To(BeZero())
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("some.internal.host:8080"))
+
+			msg := "Hello through SOCKS\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		It("dials requested IPv4 and IPv6 addresses", func() {
+			conn, err := net.Dial("tcp", localListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(socksConnect(conn, []byte{1, 10, 0, 0, 1, 0, 80})).To(BeZero())
+			conn.Close()
+
+			conn, err = net.Dial("tcp", localListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(socksConnect(conn, []byte{4, 0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 80})).To(BeZero())
+			conn.Close()
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(2))
+			_, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(addr).To(Equal("10.0.0.1:80"))
+			_, addr = fakeSecureClient.DialArgsForCall(1)
+			Expect(addr).To(Equal("[2001:db8::1]:80"))
+		})
+
+		Context("when dialing from the app instance fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("connection refused"))
+			})
+
+			It("replies that the host is unreachable", func() {
+				conn, err := net.Dial("tcp", localListener.Addr().String())
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				Expect(socksConnect(conn, []byte{1, 10, 0, 0, 1, 0, 80})).To(Equal(byte(4)))
+			})
+		})
+
+		Context("when the client only offers authentication methods that require credentials", func() {
+			It("rejects the methods and closes the connection", func() {
+				conn, err := net.Dial("tcp", localListener.Addr().String())
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				_, err = conn.Write([]byte{5, 1, 2})
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err := ioutil.ReadAll(conn)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response).To(Equal([]byte{5, 0xff}))
+				Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, errors.New("address in use"))
+			})
+
+			It("returns the error", func() {
+				Expect(dynamicForwardError).To(MatchError("address in use"))
+			})
+		})
+	})
+
+	Describe("Proxy", func() {
+		var (
+			proxyErrCh     chan error
+			client         *ssh.Client
+			forwardStarted chan struct{}
+		)
+
+		newTestSigner := func() ssh.Signer {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signer, err := ssh.NewSignerFromKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			return signer
+		}
+
+		connectedPair := func() (net.Conn, net.Conn) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			defer listener.Close()
+
+			dialed, err := net.Dial("tcp", listener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			accepted, err := listener.Accept()
+			Expect(err).NotTo(HaveOccurred())
+
+			return accepted, dialed
+		}
+
+		handleSession := func(newChannel ssh.NewChannel) {
+			channel, requests, err := newChannel.Accept()
+			Expect(err).NotTo(HaveOccurred())
+
+			for request := range requests {
+				if request.Type != "exec" {
+					request.Reply(false, nil)
+					continue
+				}
+				request.Reply(true, nil)
+
+				command := string(request.Payload[4:])
+				fmt.Fprintf(channel, "ran %s\n", command)
+				fmt.Fprintf(channel.Stderr(), "warning from %s\n", command)
+				channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{3}))
+				channel.Close()
+			}
+		}
+
+		// handleGlobalRequests accepts port forwarding requests, and once
+		// forwardStarted is closed connects to each forwarded port, sending
+		// "hello from <port>"
+		handleGlobalRequests := func(serverConn ssh.Conn, requests <-chan *ssh.Request, forwardStarted <-chan struct{}) {
+			for request := range requests {
+				if request.Type != "tcpip-forward" {
+					request.Reply(false, nil)
+					continue
+				}
+
+				var payload struct {
+					BindAddr string
+					BindPort uint32
+				}
+				Expect(ssh.Unmarshal(request.Payload, &payload)).To(Succeed())
+				request.Reply(true, nil)
+
+				go func() {
+					defer GinkgoRecover()
+
+					extraData := ssh.Marshal(struct {
+						Addr       string
+						Port       uint32
+						OriginAddr string
+						OriginPort uint32
+					}{payload.BindAddr, payload.BindPort, "10.0.0.1", 40000})
+
+					// the channel is rejected until the client has seen the reply
+					<-forwardStarted
+					channel, channelRequests, err := serverConn.OpenChannel("forwarded-tcpip", extraData)
+					Expect(err).NotTo(HaveOccurred())
+					go ssh.DiscardRequests(channelRequests)
+
+					fmt.Fprintf(channel, "hello from %d", payload.BindPort)
+					channel.Close()
+				}()
+			}
+		}
+
+		BeforeEach(func() {
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+			forwardStarted = make(chan struct{})
+			started := forwardStarted
+
+			upstreamServerSide, upstreamClientSide := connectedPair()
+
+			serverConfig := &ssh.ServerConfig{NoClientAuth: true}
+			serverConfig.AddHostKey(newTestSigner())
+
+			go func() {
+				defer GinkgoRecover()
+
+				serverConn, channels, requests, err := ssh.NewServerConn(upstreamServerSide, serverConfig)
+				Expect(err).NotTo(HaveOccurred())
+				go handleGlobalRequests(serverConn, requests, started)
+
+				for newChannel := range channels {
+					if newChannel.ChannelType() != "session" {
+						newChannel.Reject(ssh.Prohibited, "port forwarding is disabled")
+						continue
+					}
+					go handleSession(newChannel)
+				}
+			}()
+
+			upstreamConn, upstreamChannels, upstreamRequests, err := ssh.NewClientConn(upstreamClientSide, "upstream", &ssh.ClientConfig{
+				HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			upstreamClient := ssh.NewClient(upstreamConn, upstreamChannels, upstreamRequests)
+			fakeSecureClient.ConnReturns(upstreamClient.Conn)
+			fakeSecureClient.ListenStub = upstreamClient.Listen
+		})
+
+		JustBeforeEach(func() {
+			Expect(secureShell.Connect(&options.SSHOptions{AppName: "app-1"})).To(Succeed())
+
+			proxySide, nativeSide := connectedPair()
+
+			proxyErrCh = make(chan error, 1)
+			go func() {
+				proxyErrCh <- secureShell.Proxy(proxySide, proxySide)
+			}()
+
+			nativeConn, channels, requests, err := ssh.NewClientConn(nativeSide, "proxy", &ssh.ClientConfig{
+				HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			client = ssh.NewClient(nativeConn, channels, requests)
+		})
+
+		It("relays sessions and their exit status to the app instance", func() {
+			session, err := client.NewSession()
+			Expect(err).NotTo(HaveOccurred())
+
+			stdout := new(bytes.Buffer)
+			stderr := new(bytes.Buffer)
+			session.Stdout = stdout
+			session.Stderr = stderr
+
+			err = session.Run("uptime")
+			Expect(err).To(BeAssignableToTypeOf(&ssh.ExitError{}))
+			Expect(err.(*ssh.ExitError).ExitStatus()).To(Equal(3))
+
+			Expect(stdout.String()).To(Equal("ran uptime\n"))
+			Expect(stderr.String()).To(Equal("warning from uptime\n"))
+		})
+
+		It("rejects channels that the app instance rejects", func() {
+			_, err := client.Dial("tcp", "127.0.0.1:8080")
+			Expect(err).To(BeAssignableToTypeOf(&ssh.OpenChannelError{}))
+			Expect(err.(*ssh.OpenChannelError).Reason).To(Equal(ssh.Prohibited))
+		})
+
+		It("relays the ports that the client forwards from the app instance", func() {
+			listener, err := client.Listen("tcp", "127.0.0.1:9000")
+			Expect(err).NotTo(HaveOccurred())
+			defer listener.Close()
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, address := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(address).To(Equal("127.0.0.1:9000"))
+			close(forwardStarted)
+
+			conn, err := listener.Accept()
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			Expect(conn.RemoteAddr().String()).To(Equal("10.0.0.1:40000"))
+			Expect(ioutil.ReadAll(conn)).To(Equal([]byte("hello from 9000")))
+		})
+
+		It("returns when the client disconnects", func() {
+			Expect(client.Close()).To(Succeed())
+			Eventually(proxyErrCh).Should(Receive(BeNil()))
+		})
+	})
+
 	Describe("Wait", func() {
 		var opts *options.SSHOptions
 		var waitErr error
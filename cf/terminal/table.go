@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"strings"
+
+	newUI "code.cloudfoundry.org/cli/util/ui"
 )
 
 // PrintableTable is an implementation of the Table interface. It
@@ -19,6 +21,8 @@ type Table struct {
 	rows          [][]string
 	colSpacing    string
 	transformer   []Transformer
+	view          *newUI.TableView
+	viewColumns   []string
 }
 
 // Transformer is the type of functions used to modify the content of
@@ -62,6 +66,43 @@ func (t *Table) SetTransformer(columnIndex int, tr Transformer) {
 	t.transformer[columnIndex] = tr
 }
 
+// SetView selects, sorts and filters the columns and rows of the specified
+// table when it is printed. The view names columns by the untranslated
+// columns, which are given in the same order as the headers. Columns keep
+// their transformers.
+func (t *Table) SetView(view newUI.TableView, columns []string) {
+	t.view = &view
+	t.viewColumns = columns
+}
+
+// applyView replaces the headers and rows of the specified table with the
+// result of applying its view to them.
+func (t *Table) applyView() error {
+	table, err := t.view.ApplyWithColumns(t.viewColumns, append([][]string{t.headers}, t.rows...))
+	if err != nil {
+		return err
+	}
+
+	transformer := make([]Transformer, len(table[0]))
+	for i, header := range table[0] {
+		transformer[i] = nop
+		for j := range t.headers {
+			if t.headers[j] == header {
+				transformer[i] = t.transformer[j]
+				break
+			}
+		}
+	}
+
+	t.headers = table[0]
+	t.rows = table[1:]
+	t.columnWidth = make([]int, len(t.headers))
+	t.transformer = transformer
+	t.view = nil
+	t.viewColumns = nil
+	return nil
+}
+
 // Add extends the table by another row.
 func (t *Table) Add(row ...string) {
 	t.rows = append(t.rows, row)
@@ -72,6 +113,13 @@ func (t *Table) Add(row ...string) {
 // exported Print() is just a wrapper around this which redirects the
 // result into CF datastructures.
 func (t *Table) PrintTo(result io.Writer) error {
+	if t.view != nil {
+		err := t.applyView()
+		if err != nil {
+			return err
+		}
+	}
+
 	t.rowHeight = make([]int, len(t.rows)+1)
 
 	rowIndex := 0
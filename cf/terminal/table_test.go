@@ -6,6 +6,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/cf/terminal"
 	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	newUI "code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -145,6 +146,41 @@ var _ = Describe("Table", func() {
 		)))
 	})
 
+	Describe("SetView", func() {
+		BeforeEach(func() {
+			table.Add("cloak", "and", "dagger")
+			table.Add("bread", "or", "butter")
+			table.SetTransformer(2, func(s string) string {
+				return "<<" + s + ">>"
+			})
+		})
+
+		It("prints the selected columns of the matching rows in order", func() {
+			table.SetView(newUI.TableView{
+				Columns: []string{"head", "me"},
+				SortBy:  "me",
+			}, []string{"me", "of", "head"})
+			table.PrintTo(outputs)
+			s := strings.Split(outputs.String(), "\n")
+
+			Expect(s).To(ContainSubstrings(
+				[]string{"atama!", "watashi"},
+				[]string{"<<butter>>", "bread"},
+				[]string{"<<dagger>>", "cloak"},
+			))
+			Expect(outputs.String()).NotTo(ContainSubstring("and"))
+		})
+
+		It("returns an error when the view names an unknown column", func() {
+			table.SetView(newUI.TableView{SortBy: "watashi"}, []string{"me", "of", "head"})
+			err := table.PrintTo(outputs)
+			Expect(err).To(MatchError(newUI.TableColumnNotFoundError{
+				Column:  "watashi",
+				Columns: []string{"me", "of", "head"},
+			}))
+		})
+	})
+
 	It("prints no more columns than headers", func() {
 		table.Add("something", "and", "nothing", "ignored")
 		table.PrintTo(outputs)
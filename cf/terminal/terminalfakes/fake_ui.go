@@ -50,6 +50,21 @@ type FakeUI struct {
 	askForPasswordReturns struct {
 		result1 string
 	}
+	AskForSelectionStub        func(prompt string, options []string) (answer string)
+	askForSelectionMutex       sync.RWMutex
+	askForSelectionArgsForCall []struct {
+		prompt  string
+		options []string
+	}
+	askForSelectionReturns struct {
+		result1 string
+	}
+	IsInteractiveStub        func() bool
+	isInteractiveMutex       sync.RWMutex
+	isInteractiveArgsForCall []struct{}
+	isInteractiveReturns     struct {
+		result1 bool
+	}
 	ConfirmStub        func(message string) bool
 	confirmMutex       sync.RWMutex
 	confirmArgsForCall []struct {
@@ -290,6 +305,70 @@ func (fake *FakeUI) AskForPasswordReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeUI) AskForSelection(prompt string, options []string) (answer string) {
+	var optionsCopy []string
+	if options != nil {
+		optionsCopy = make([]string, len(options))
+		copy(optionsCopy, options)
+	}
+	fake.askForSelectionMutex.Lock()
+	fake.askForSelectionArgsForCall = append(fake.askForSelectionArgsForCall, struct {
+		prompt  string
+		options []string
+	}{prompt, optionsCopy})
+	fake.recordInvocation("AskForSelection", []interface{}{prompt, optionsCopy})
+	fake.askForSelectionMutex.Unlock()
+	if fake.AskForSelectionStub != nil {
+		return fake.AskForSelectionStub(prompt, options)
+	} else {
+		return fake.askForSelectionReturns.result1
+	}
+}
+
+func (fake *FakeUI) AskForSelectionCallCount() int {
+	fake.askForSelectionMutex.RLock()
+	defer fake.askForSelectionMutex.RUnlock()
+	return len(fake.askForSelectionArgsForCall)
+}
+
+func (fake *FakeUI) AskForSelectionArgsForCall(i int) (string, []string) {
+	fake.askForSelectionMutex.RLock()
+	defer fake.askForSelectionMutex.RUnlock()
+	return fake.askForSelectionArgsForCall[i].prompt, fake.askForSelectionArgsForCall[i].options
+}
+
+func (fake *FakeUI) AskForSelectionReturns(result1 string) {
+	fake.AskForSelectionStub = nil
+	fake.askForSelectionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUI) IsInteractive() bool {
+	fake.isInteractiveMutex.Lock()
+	fake.isInteractiveArgsForCall = append(fake.isInteractiveArgsForCall, struct{}{})
+	fake.recordInvocation("IsInteractive", []interface{}{})
+	fake.isInteractiveMutex.Unlock()
+	if fake.IsInteractiveStub != nil {
+		return fake.IsInteractiveStub()
+	} else {
+		return fake.isInteractiveReturns.result1
+	}
+}
+
+func (fake *FakeUI) IsInteractiveCallCount() int {
+	fake.isInteractiveMutex.RLock()
+	defer fake.isInteractiveMutex.RUnlock()
+	return len(fake.isInteractiveArgsForCall)
+}
+
+func (fake *FakeUI) IsInteractiveReturns(result1 bool) {
+	fake.IsInteractiveStub = nil
+	fake.isInteractiveReturns = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeUI) Confirm(message string) bool {
 	fake.confirmMutex.Lock()
 	fake.confirmArgsForCall = append(fake.confirmArgsForCall, struct {
@@ -305,6 +384,10 @@ func (fake *FakeUI) Confirm(message string) bool {
 }
 
 func (fake *FakeUI) ConfirmCallCount() int {
+	fake.askForSelectionMutex.RLock()
+	defer fake.askForSelectionMutex.RUnlock()
+	fake.isInteractiveMutex.RLock()
+	defer fake.isInteractiveMutex.RUnlock()
 	fake.confirmMutex.RLock()
 	defer fake.confirmMutex.RUnlock()
 	return len(fake.confirmArgsForCall)
@@ -11,9 +11,15 @@ import (
 
 	"bufio"
 
+	"errors"
+	"os"
+
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	newUI "code.cloudfoundry.org/cli/util/ui"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 type ColoringFunction func(value string, row int, col int) string
@@ -32,6 +38,8 @@ type UI interface {
 	Warn(message string, args ...interface{})
 	Ask(prompt string) (answer string)
 	AskForPassword(prompt string) (answer string)
+	AskForSelection(prompt string, options []string) (answer string)
+	IsInteractive() bool
 	Confirm(message string) bool
 	ConfirmDelete(modelType, modelName string) bool
 	ConfirmDeleteWithAssociations(modelType, modelName string) bool
@@ -115,6 +123,30 @@ func (ui *terminalUI) Ask(prompt string) string {
 	return ""
 }
 
+// AskForSelection lets the user pick one of the options by typing to filter
+// them. It should only be used when IsInteractive is true; it returns "" when
+// the user skips the prompt.
+func (ui *terminalUI) AskForSelection(prompt string, options []string) string {
+	answer, err := newUI.SelectionPrompt{
+		In:        ui.stdin,
+		Out:       ui.stdout,
+		Prompt:    prompt,
+		Hint:      T("type to filter, up/down to move, page up/page down to page, enter to select"),
+		NoMatches: T("No matches"),
+	}.Select(options)
+	if err != nil {
+		return ""
+	}
+	return answer
+}
+
+// IsInteractive returns true when both the input and the output of the UI are
+// terminals.
+func (ui *terminalUI) IsInteractive() bool {
+	in, ok := ui.stdin.(*os.File)
+	return ok && terminal.IsTerminal(int(in.Fd())) && isTerminal()
+}
+
 func (ui *terminalUI) ConfirmDeleteWithAssociations(modelType, modelName string) bool {
 	return ui.confirmDelete(T("Really delete the {{.ModelType}} {{.ModelName}} and everything associated with it?",
 		map[string]interface{}{
@@ -266,6 +298,10 @@ func (u *UITable) Add(row ...string) {
 	u.Table.Add(row...)
 }
 
+func (u *UITable) SetView(view newUI.TableView, columns []string) {
+	u.Table.SetView(view, columns)
+}
+
 // Print formats the table and then prints it to the UI specified at
 // the time of the construction. Afterwards the table is cleared,
 // becoming ready for another round of rows and printing.
@@ -275,6 +311,9 @@ func (u *UITable) Print() error {
 
 	err := t.PrintTo(result)
 	if err != nil {
+		if translatableErr, ok := err.(translatableerror.TranslatableError); ok {
+			return errors.New(translatableErr.Translate(T))
+		}
 		return err
 	}
 
@@ -0,0 +1,58 @@
+package uihelpers
+
+import (
+	"errors"
+	"reflect"
+
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// TableViewUsage is appended to the usage of list commands that accept the
+// flags added by AddTableViewFlags.
+const TableViewUsage = " [--columns COLUMN,...] [--sort-by COLUMN[:desc]] [--filter COLUMN=GLOB]..."
+
+// AddTableViewFlags adds the flags of ui.TableViewFlags, which select, sort
+// and filter the columns and rows of a list command's table.
+func AddTableViewFlags(fs map[string]flags.FlagSet) {
+	flagsType := reflect.TypeOf(ui.TableViewFlags{})
+	for i := 0; i < flagsType.NumField(); i++ {
+		field := flagsType.Field(i)
+		name := field.Tag.Get("long")
+		usage := T(field.Tag.Get("description"))
+
+		if field.Type.Kind() == reflect.Slice {
+			fs[name] = &flags.StringSliceFlag{Name: name, Usage: usage}
+		} else {
+			fs[name] = &flags.StringFlag{Name: name, Usage: usage}
+		}
+	}
+}
+
+// TableView returns the view requested with the flags added by
+// AddTableViewFlags for a table with the given untranslated columns.
+func TableView(fc flags.FlagContext, columns []string) (ui.TableView, error) {
+	var viewFlags ui.TableViewFlags
+	flagsValue := reflect.ValueOf(&viewFlags).Elem()
+	for i := 0; i < flagsValue.NumField(); i++ {
+		name := flagsValue.Type().Field(i).Tag.Get("long")
+
+		if flagsValue.Field(i).Kind() == reflect.Slice {
+			flagsValue.Field(i).Set(reflect.ValueOf(fc.StringSlice(name)))
+		} else {
+			flagsValue.Field(i).SetString(fc.String(name))
+		}
+	}
+
+	view, err := viewFlags.TableView(columns)
+	if err != nil {
+		if translatableErr, ok := shared.HandleError(err).(translatableerror.TranslatableError); ok {
+			return ui.TableView{}, errors.New(translatableErr.Translate(T))
+		}
+		return ui.TableView{}, err
+	}
+	return view, nil
+}
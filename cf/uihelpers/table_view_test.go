@@ -0,0 +1,43 @@
+package uihelpers_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/i18n"
+	. "code.cloudfoundry.org/cli/cf/uihelpers"
+	"code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TableView", func() {
+	var fc flags.FlagContext
+
+	BeforeEach(func() {
+		i18n.T = i18n.Init(configuration.NewRepositoryWithDefaults())
+
+		fs := map[string]flags.FlagSet{}
+		AddTableViewFlags(fs)
+		fc = flags.NewFlagContext(fs)
+	})
+
+	It("returns the view requested with the flags", func() {
+		Expect(fc.Parse("--columns", "name", "--sort-by", "memory:desc", "--filter", "name=web-*")).To(Succeed())
+
+		view, err := TableView(fc, []string{"name", "memory"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(view).To(Equal(ui.TableView{
+			Columns:        []string{"name"},
+			SortBy:         "memory",
+			SortDescending: true,
+			Filters:        []ui.TableFilter{{Column: "name", Pattern: "web-*"}},
+		}))
+	})
+
+	It("returns a translated error when a flag is invalid", func() {
+		Expect(fc.Parse("--sort-by", "name:up")).To(Succeed())
+
+		_, err := TableView(fc, []string{"name"})
+		Expect(err).To(MatchError("Incorrect Usage: 'name:up' is not a valid sort order, expected COLUMN[:asc|:desc]."))
+	})
+})
@@ -30,6 +30,12 @@ type FakeConfig struct {
 		name string
 		url  string
 	}
+	AddPluginTrustedKeyStub        func(name string, publicKey string)
+	addPluginTrustedKeyMutex       sync.RWMutex
+	addPluginTrustedKeyArgsForCall []struct {
+		name      string
+		publicKey string
+	}
 	APIVersionStub        func() string
 	aPIVersionMutex       sync.RWMutex
 	aPIVersionArgsForCall []struct{}
@@ -66,6 +72,19 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	CompletionCacheStub        func(key string) ([]string, bool)
+	completionCacheMutex       sync.RWMutex
+	completionCacheArgsForCall []struct {
+		key string
+	}
+	completionCacheReturns struct {
+		result1 []string
+		result2 bool
+	}
+	completionCacheReturnsOnCall map[int]struct {
+		result1 []string
+		result2 bool
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct{}
@@ -77,6 +96,17 @@ type FakeConfig struct {
 		result1 configv3.User
 		result2 error
 	}
+	DeleteTargetProfileStub        func(name string) error
+	deleteTargetProfileMutex       sync.RWMutex
+	deleteTargetProfileArgsForCall []struct {
+		name string
+	}
+	deleteTargetProfileReturns struct {
+		result1 error
+	}
+	deleteTargetProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct{}
@@ -166,6 +196,15 @@ type FakeConfig struct {
 	minCLIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	OutputFormatStub        func() configv3.OutputFormat
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct{}
+	outputFormatReturns     struct {
+		result1 configv3.OutputFormat
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 configv3.OutputFormat
+	}
 	OverallPollingTimeoutStub        func() time.Duration
 	overallPollingTimeoutMutex       sync.RWMutex
 	overallPollingTimeoutArgsForCall []struct{}
@@ -193,6 +232,15 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginTrustedKeysStub        func() []configv3.PluginTrustedKey
+	pluginTrustedKeysMutex       sync.RWMutex
+	pluginTrustedKeysArgsForCall []struct{}
+	pluginTrustedKeysReturns     struct {
+		result1 []configv3.PluginTrustedKey
+	}
+	pluginTrustedKeysReturnsOnCall map[int]struct {
+		result1 []configv3.PluginTrustedKey
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct{}
@@ -225,11 +273,40 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemovePluginTrustedKeyStub        func(name string)
+	removePluginTrustedKeyMutex       sync.RWMutex
+	removePluginTrustedKeyArgsForCall []struct {
+		name string
+	}
+	SaveTargetProfileStub        func(name string, force bool) error
+	saveTargetProfileMutex       sync.RWMutex
+	saveTargetProfileArgsForCall []struct {
+		name  string
+		force bool
+	}
+	saveTargetProfileReturns struct {
+		result1 error
+	}
+	saveTargetProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
 		token string
 	}
+	SetCompletionCacheStub        func(key string, names []string) error
+	setCompletionCacheMutex       sync.RWMutex
+	setCompletionCacheArgsForCall []struct {
+		key   string
+		names []string
+	}
+	setCompletionCacheReturns struct {
+		result1 error
+	}
+	setCompletionCacheReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetOrganizationInformationStub        func(guid string, name string)
 	setOrganizationInformationMutex       sync.RWMutex
 	setOrganizationInformationArgsForCall []struct {
@@ -266,11 +343,22 @@ type FakeConfig struct {
 		refreshToken   string
 		sshOAuthClient string
 	}
+	SetUAAClientCredentialsStub        func(client string, clientSecret string)
+	setUAAClientCredentialsMutex       sync.RWMutex
+	setUAAClientCredentialsArgsForCall []struct {
+		client       string
+		clientSecret string
+	}
 	SetUAAEndpointStub        func(uaaEndpoint string)
 	setUAAEndpointMutex       sync.RWMutex
 	setUAAEndpointArgsForCall []struct {
 		uaaEndpoint string
 	}
+	SetUAAGrantTypeStub        func(uaaGrantType string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		uaaGrantType string
+	}
 	SkipSSLValidationStub        func() bool
 	skipSSLValidationMutex       sync.RWMutex
 	skipSSLValidationArgsForCall []struct{}
@@ -289,6 +377,17 @@ type FakeConfig struct {
 	sSHOAuthClientReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SwitchTargetProfileStub        func(name string) error
+	switchTargetProfileMutex       sync.RWMutex
+	switchTargetProfileArgsForCall []struct {
+		name string
+	}
+	switchTargetProfileReturns struct {
+		result1 error
+	}
+	switchTargetProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StagingTimeoutStub        func() time.Duration
 	stagingTimeoutMutex       sync.RWMutex
 	stagingTimeoutArgsForCall []struct{}
@@ -334,6 +433,53 @@ type FakeConfig struct {
 	targetedSpaceReturnsOnCall map[int]struct {
 		result1 configv3.Space
 	}
+	TargetProfileNameStub        func() string
+	targetProfileNameMutex       sync.RWMutex
+	targetProfileNameArgsForCall []struct{}
+	targetProfileNameReturns     struct {
+		result1 string
+	}
+	targetProfileNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	TargetProfilesStub        func() ([]configv3.TargetProfile, error)
+	targetProfilesMutex       sync.RWMutex
+	targetProfilesArgsForCall []struct{}
+	targetProfilesReturns     struct {
+		result1 []configv3.TargetProfile
+		result2 error
+	}
+	targetProfilesReturnsOnCall map[int]struct {
+		result1 []configv3.TargetProfile
+		result2 error
+	}
+	TokenRefreshSkewStub        func() time.Duration
+	tokenRefreshSkewMutex       sync.RWMutex
+	tokenRefreshSkewArgsForCall []struct{}
+	tokenRefreshSkewReturns     struct {
+		result1 time.Duration
+	}
+	tokenRefreshSkewReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	TraceFormatStub        func() configv3.TraceFormat
+	traceFormatMutex       sync.RWMutex
+	traceFormatArgsForCall []struct{}
+	traceFormatReturns     struct {
+		result1 configv3.TraceFormat
+	}
+	traceFormatReturnsOnCall map[int]struct {
+		result1 configv3.TraceFormat
+	}
+	UAAGrantTypeStub        func() string
+	uAAGrantTypeMutex       sync.RWMutex
+	uAAGrantTypeArgsForCall []struct{}
+	uAAGrantTypeReturns     struct {
+		result1 string
+	}
+	uAAGrantTypeReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAAOAuthClientStub        func() string
 	uAAOAuthClientMutex       sync.RWMutex
 	uAAOAuthClientArgsForCall []struct{}
@@ -471,6 +617,31 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return fake.addPluginRepositoryArgsForCall[i].name, fake.addPluginRepositoryArgsForCall[i].url
 }
 
+func (fake *FakeConfig) AddPluginTrustedKey(name string, publicKey string) {
+	fake.addPluginTrustedKeyMutex.Lock()
+	fake.addPluginTrustedKeyArgsForCall = append(fake.addPluginTrustedKeyArgsForCall, struct {
+		name      string
+		publicKey string
+	}{name, publicKey})
+	fake.recordInvocation("AddPluginTrustedKey", []interface{}{name, publicKey})
+	fake.addPluginTrustedKeyMutex.Unlock()
+	if fake.AddPluginTrustedKeyStub != nil {
+		fake.AddPluginTrustedKeyStub(name, publicKey)
+	}
+}
+
+func (fake *FakeConfig) AddPluginTrustedKeyCallCount() int {
+	fake.addPluginTrustedKeyMutex.RLock()
+	defer fake.addPluginTrustedKeyMutex.RUnlock()
+	return len(fake.addPluginTrustedKeyArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginTrustedKeyArgsForCall(i int) (string, string) {
+	fake.addPluginTrustedKeyMutex.RLock()
+	defer fake.addPluginTrustedKeyMutex.RUnlock()
+	return fake.addPluginTrustedKeyArgsForCall[i].name, fake.addPluginTrustedKeyArgsForCall[i].publicKey
+}
+
 func (fake *FakeConfig) APIVersion() string {
 	fake.aPIVersionMutex.Lock()
 	ret, specificReturn := fake.aPIVersionReturnsOnCall[len(fake.aPIVersionArgsForCall)]
@@ -631,6 +802,57 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) CompletionCache(key string) ([]string, bool) {
+	fake.completionCacheMutex.Lock()
+	ret, specificReturn := fake.completionCacheReturnsOnCall[len(fake.completionCacheArgsForCall)]
+	fake.completionCacheArgsForCall = append(fake.completionCacheArgsForCall, struct {
+		key string
+	}{key})
+	fake.recordInvocation("CompletionCache", []interface{}{key})
+	fake.completionCacheMutex.Unlock()
+	if fake.CompletionCacheStub != nil {
+		return fake.CompletionCacheStub(key)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.completionCacheReturns.result1, fake.completionCacheReturns.result2
+}
+
+func (fake *FakeConfig) CompletionCacheCallCount() int {
+	fake.completionCacheMutex.RLock()
+	defer fake.completionCacheMutex.RUnlock()
+	return len(fake.completionCacheArgsForCall)
+}
+
+func (fake *FakeConfig) CompletionCacheArgsForCall(i int) string {
+	fake.completionCacheMutex.RLock()
+	defer fake.completionCacheMutex.RUnlock()
+	return fake.completionCacheArgsForCall[i].key
+}
+
+func (fake *FakeConfig) CompletionCacheReturns(result1 []string, result2 bool) {
+	fake.CompletionCacheStub = nil
+	fake.completionCacheReturns = struct {
+		result1 []string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) CompletionCacheReturnsOnCall(i int, result1 []string, result2 bool) {
+	fake.CompletionCacheStub = nil
+	if fake.completionCacheReturnsOnCall == nil {
+		fake.completionCacheReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 bool
+		})
+	}
+	fake.completionCacheReturnsOnCall[i] = struct {
+		result1 []string
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -674,6 +896,54 @@ func (fake *FakeConfig) CurrentUserReturnsOnCall(i int, result1 configv3.User, r
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) DeleteTargetProfile(name string) error {
+	fake.deleteTargetProfileMutex.Lock()
+	ret, specificReturn := fake.deleteTargetProfileReturnsOnCall[len(fake.deleteTargetProfileArgsForCall)]
+	fake.deleteTargetProfileArgsForCall = append(fake.deleteTargetProfileArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("DeleteTargetProfile", []interface{}{name})
+	fake.deleteTargetProfileMutex.Unlock()
+	if fake.DeleteTargetProfileStub != nil {
+		return fake.DeleteTargetProfileStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteTargetProfileReturns.result1
+}
+
+func (fake *FakeConfig) DeleteTargetProfileCallCount() int {
+	fake.deleteTargetProfileMutex.RLock()
+	defer fake.deleteTargetProfileMutex.RUnlock()
+	return len(fake.deleteTargetProfileArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteTargetProfileArgsForCall(i int) string {
+	fake.deleteTargetProfileMutex.RLock()
+	defer fake.deleteTargetProfileMutex.RUnlock()
+	return fake.deleteTargetProfileArgsForCall[i].name
+}
+
+func (fake *FakeConfig) DeleteTargetProfileReturns(result1 error) {
+	fake.DeleteTargetProfileStub = nil
+	fake.deleteTargetProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) DeleteTargetProfileReturnsOnCall(i int, result1 error) {
+	fake.DeleteTargetProfileStub = nil
+	if fake.deleteTargetProfileReturnsOnCall == nil {
+		fake.deleteTargetProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTargetProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -1056,6 +1326,46 @@ func (fake *FakeConfig) MinCLIVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() configv3.OutputFormat {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct{}{})
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.outputFormatReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 configv3.OutputFormat) {
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 configv3.OutputFormat) {
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 configv3.OutputFormat
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
 func (fake *FakeConfig) OverallPollingTimeout() time.Duration {
 	fake.overallPollingTimeoutMutex.Lock()
 	ret, specificReturn := fake.overallPollingTimeoutReturnsOnCall[len(fake.overallPollingTimeoutArgsForCall)]
@@ -1176,6 +1486,46 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginTrustedKeys() []configv3.PluginTrustedKey {
+	fake.pluginTrustedKeysMutex.Lock()
+	ret, specificReturn := fake.pluginTrustedKeysReturnsOnCall[len(fake.pluginTrustedKeysArgsForCall)]
+	fake.pluginTrustedKeysArgsForCall = append(fake.pluginTrustedKeysArgsForCall, struct{}{})
+	fake.recordInvocation("PluginTrustedKeys", []interface{}{})
+	fake.pluginTrustedKeysMutex.Unlock()
+	if fake.PluginTrustedKeysStub != nil {
+		return fake.PluginTrustedKeysStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pluginTrustedKeysReturns.result1
+}
+
+func (fake *FakeConfig) PluginTrustedKeysCallCount() int {
+	fake.pluginTrustedKeysMutex.RLock()
+	defer fake.pluginTrustedKeysMutex.RUnlock()
+	return len(fake.pluginTrustedKeysArgsForCall)
+}
+
+func (fake *FakeConfig) PluginTrustedKeysReturns(result1 []configv3.PluginTrustedKey) {
+	fake.PluginTrustedKeysStub = nil
+	fake.pluginTrustedKeysReturns = struct {
+		result1 []configv3.PluginTrustedKey
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginTrustedKeysReturnsOnCall(i int, result1 []configv3.PluginTrustedKey) {
+	fake.PluginTrustedKeysStub = nil
+	if fake.pluginTrustedKeysReturnsOnCall == nil {
+		fake.pluginTrustedKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.PluginTrustedKey
+		})
+	}
+	fake.pluginTrustedKeysReturnsOnCall[i] = struct {
+		result1 []configv3.PluginTrustedKey
+	}{result1}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -1320,6 +1670,79 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RemovePluginTrustedKey(name string) {
+	fake.removePluginTrustedKeyMutex.Lock()
+	fake.removePluginTrustedKeyArgsForCall = append(fake.removePluginTrustedKeyArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemovePluginTrustedKey", []interface{}{name})
+	fake.removePluginTrustedKeyMutex.Unlock()
+	if fake.RemovePluginTrustedKeyStub != nil {
+		fake.RemovePluginTrustedKeyStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemovePluginTrustedKeyCallCount() int {
+	fake.removePluginTrustedKeyMutex.RLock()
+	defer fake.removePluginTrustedKeyMutex.RUnlock()
+	return len(fake.removePluginTrustedKeyArgsForCall)
+}
+
+func (fake *FakeConfig) RemovePluginTrustedKeyArgsForCall(i int) string {
+	fake.removePluginTrustedKeyMutex.RLock()
+	defer fake.removePluginTrustedKeyMutex.RUnlock()
+	return fake.removePluginTrustedKeyArgsForCall[i].name
+}
+
+func (fake *FakeConfig) SaveTargetProfile(name string, force bool) error {
+	fake.saveTargetProfileMutex.Lock()
+	ret, specificReturn := fake.saveTargetProfileReturnsOnCall[len(fake.saveTargetProfileArgsForCall)]
+	fake.saveTargetProfileArgsForCall = append(fake.saveTargetProfileArgsForCall, struct {
+		name  string
+		force bool
+	}{name, force})
+	fake.recordInvocation("SaveTargetProfile", []interface{}{name, force})
+	fake.saveTargetProfileMutex.Unlock()
+	if fake.SaveTargetProfileStub != nil {
+		return fake.SaveTargetProfileStub(name, force)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.saveTargetProfileReturns.result1
+}
+
+func (fake *FakeConfig) SaveTargetProfileCallCount() int {
+	fake.saveTargetProfileMutex.RLock()
+	defer fake.saveTargetProfileMutex.RUnlock()
+	return len(fake.saveTargetProfileArgsForCall)
+}
+
+func (fake *FakeConfig) SaveTargetProfileArgsForCall(i int) (string, bool) {
+	fake.saveTargetProfileMutex.RLock()
+	defer fake.saveTargetProfileMutex.RUnlock()
+	return fake.saveTargetProfileArgsForCall[i].name, fake.saveTargetProfileArgsForCall[i].force
+}
+
+func (fake *FakeConfig) SaveTargetProfileReturns(result1 error) {
+	fake.SaveTargetProfileStub = nil
+	fake.saveTargetProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SaveTargetProfileReturnsOnCall(i int, result1 error) {
+	fake.SaveTargetProfileStub = nil
+	if fake.saveTargetProfileReturnsOnCall == nil {
+		fake.saveTargetProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTargetProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1344,6 +1767,60 @@ func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
 	return fake.setAccessTokenArgsForCall[i].token
 }
 
+func (fake *FakeConfig) SetCompletionCache(key string, names []string) error {
+	var namesCopy []string
+	if names != nil {
+		namesCopy = make([]string, len(names))
+		copy(namesCopy, names)
+	}
+	fake.setCompletionCacheMutex.Lock()
+	ret, specificReturn := fake.setCompletionCacheReturnsOnCall[len(fake.setCompletionCacheArgsForCall)]
+	fake.setCompletionCacheArgsForCall = append(fake.setCompletionCacheArgsForCall, struct {
+		key   string
+		names []string
+	}{key, namesCopy})
+	fake.recordInvocation("SetCompletionCache", []interface{}{key, namesCopy})
+	fake.setCompletionCacheMutex.Unlock()
+	if fake.SetCompletionCacheStub != nil {
+		return fake.SetCompletionCacheStub(key, names)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setCompletionCacheReturns.result1
+}
+
+func (fake *FakeConfig) SetCompletionCacheCallCount() int {
+	fake.setCompletionCacheMutex.RLock()
+	defer fake.setCompletionCacheMutex.RUnlock()
+	return len(fake.setCompletionCacheArgsForCall)
+}
+
+func (fake *FakeConfig) SetCompletionCacheArgsForCall(i int) (string, []string) {
+	fake.setCompletionCacheMutex.RLock()
+	defer fake.setCompletionCacheMutex.RUnlock()
+	return fake.setCompletionCacheArgsForCall[i].key, fake.setCompletionCacheArgsForCall[i].names
+}
+
+func (fake *FakeConfig) SetCompletionCacheReturns(result1 error) {
+	fake.SetCompletionCacheStub = nil
+	fake.setCompletionCacheReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SetCompletionCacheReturnsOnCall(i int, result1 error) {
+	fake.SetCompletionCacheStub = nil
+	if fake.setCompletionCacheReturnsOnCall == nil {
+		fake.setCompletionCacheReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCompletionCacheReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) SetOrganizationInformation(guid string, name string) {
 	fake.setOrganizationInformationMutex.Lock()
 	fake.setOrganizationInformationArgsForCall = append(fake.setOrganizationInformationArgsForCall, struct {
@@ -1475,6 +1952,31 @@ func (fake *FakeConfig) SetTokenInformationArgsForCall(i int) (string, string, s
 	return fake.setTokenInformationArgsForCall[i].accessToken, fake.setTokenInformationArgsForCall[i].refreshToken, fake.setTokenInformationArgsForCall[i].sshOAuthClient
 }
 
+func (fake *FakeConfig) SetUAAClientCredentials(client string, clientSecret string) {
+	fake.setUAAClientCredentialsMutex.Lock()
+	fake.setUAAClientCredentialsArgsForCall = append(fake.setUAAClientCredentialsArgsForCall, struct {
+		client       string
+		clientSecret string
+	}{client, clientSecret})
+	fake.recordInvocation("SetUAAClientCredentials", []interface{}{client, clientSecret})
+	fake.setUAAClientCredentialsMutex.Unlock()
+	if fake.SetUAAClientCredentialsStub != nil {
+		fake.SetUAAClientCredentialsStub(client, clientSecret)
+	}
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsCallCount() int {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return len(fake.setUAAClientCredentialsArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsArgsForCall(i int) (string, string) {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return fake.setUAAClientCredentialsArgsForCall[i].client, fake.setUAAClientCredentialsArgsForCall[i].clientSecret
+}
+
 func (fake *FakeConfig) SetUAAEndpoint(uaaEndpoint string) {
 	fake.setUAAEndpointMutex.Lock()
 	fake.setUAAEndpointArgsForCall = append(fake.setUAAEndpointArgsForCall, struct {
@@ -1499,6 +2001,30 @@ func (fake *FakeConfig) SetUAAEndpointArgsForCall(i int) string {
 	return fake.setUAAEndpointArgsForCall[i].uaaEndpoint
 }
 
+func (fake *FakeConfig) SetUAAGrantType(uaaGrantType string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		uaaGrantType string
+	}{uaaGrantType})
+	fake.recordInvocation("SetUAAGrantType", []interface{}{uaaGrantType})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(uaaGrantType)
+	}
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].uaaGrantType
+}
+
 func (fake *FakeConfig) SkipSSLValidation() bool {
 	fake.skipSSLValidationMutex.Lock()
 	ret, specificReturn := fake.skipSSLValidationReturnsOnCall[len(fake.skipSSLValidationArgsForCall)]
@@ -1579,6 +2105,54 @@ func (fake *FakeConfig) SSHOAuthClientReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) SwitchTargetProfile(name string) error {
+	fake.switchTargetProfileMutex.Lock()
+	ret, specificReturn := fake.switchTargetProfileReturnsOnCall[len(fake.switchTargetProfileArgsForCall)]
+	fake.switchTargetProfileArgsForCall = append(fake.switchTargetProfileArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("SwitchTargetProfile", []interface{}{name})
+	fake.switchTargetProfileMutex.Unlock()
+	if fake.SwitchTargetProfileStub != nil {
+		return fake.SwitchTargetProfileStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.switchTargetProfileReturns.result1
+}
+
+func (fake *FakeConfig) SwitchTargetProfileCallCount() int {
+	fake.switchTargetProfileMutex.RLock()
+	defer fake.switchTargetProfileMutex.RUnlock()
+	return len(fake.switchTargetProfileArgsForCall)
+}
+
+func (fake *FakeConfig) SwitchTargetProfileArgsForCall(i int) string {
+	fake.switchTargetProfileMutex.RLock()
+	defer fake.switchTargetProfileMutex.RUnlock()
+	return fake.switchTargetProfileArgsForCall[i].name
+}
+
+func (fake *FakeConfig) SwitchTargetProfileReturns(result1 error) {
+	fake.SwitchTargetProfileStub = nil
+	fake.switchTargetProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SwitchTargetProfileReturnsOnCall(i int, result1 error) {
+	fake.SwitchTargetProfileStub = nil
+	if fake.switchTargetProfileReturnsOnCall == nil {
+		fake.switchTargetProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.switchTargetProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) StagingTimeout() time.Duration {
 	fake.stagingTimeoutMutex.Lock()
 	ret, specificReturn := fake.stagingTimeoutReturnsOnCall[len(fake.stagingTimeoutArgsForCall)]
@@ -1779,6 +2353,209 @@ func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space
 	}{result1}
 }
 
+func (fake *FakeConfig) TargetProfileName() string {
+	fake.targetProfileNameMutex.Lock()
+	ret, specificReturn := fake.targetProfileNameReturnsOnCall[len(fake.targetProfileNameArgsForCall)]
+	fake.targetProfileNameArgsForCall = append(fake.targetProfileNameArgsForCall, struct{}{})
+	fake.recordInvocation("TargetProfileName", []interface{}{})
+	fake.targetProfileNameMutex.Unlock()
+	if fake.TargetProfileNameStub != nil {
+		return fake.TargetProfileNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.targetProfileNameReturns.result1
+}
+
+func (fake *FakeConfig) TargetProfileNameCallCount() int {
+	fake.targetProfileNameMutex.RLock()
+	defer fake.targetProfileNameMutex.RUnlock()
+	return len(fake.targetProfileNameArgsForCall)
+}
+
+func (fake *FakeConfig) TargetProfileNameReturns(result1 string) {
+	fake.TargetProfileNameStub = nil
+	fake.targetProfileNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetProfileNameReturnsOnCall(i int, result1 string) {
+	fake.TargetProfileNameStub = nil
+	if fake.targetProfileNameReturnsOnCall == nil {
+		fake.targetProfileNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.targetProfileNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetProfiles() ([]configv3.TargetProfile, error) {
+	fake.targetProfilesMutex.Lock()
+	ret, specificReturn := fake.targetProfilesReturnsOnCall[len(fake.targetProfilesArgsForCall)]
+	fake.targetProfilesArgsForCall = append(fake.targetProfilesArgsForCall, struct{}{})
+	fake.recordInvocation("TargetProfiles", []interface{}{})
+	fake.targetProfilesMutex.Unlock()
+	if fake.TargetProfilesStub != nil {
+		return fake.TargetProfilesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.targetProfilesReturns.result1, fake.targetProfilesReturns.result2
+}
+
+func (fake *FakeConfig) TargetProfilesCallCount() int {
+	fake.targetProfilesMutex.RLock()
+	defer fake.targetProfilesMutex.RUnlock()
+	return len(fake.targetProfilesArgsForCall)
+}
+
+func (fake *FakeConfig) TargetProfilesReturns(result1 []configv3.TargetProfile, result2 error) {
+	fake.TargetProfilesStub = nil
+	fake.targetProfilesReturns = struct {
+		result1 []configv3.TargetProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) TargetProfilesReturnsOnCall(i int, result1 []configv3.TargetProfile, result2 error) {
+	fake.TargetProfilesStub = nil
+	if fake.targetProfilesReturnsOnCall == nil {
+		fake.targetProfilesReturnsOnCall = make(map[int]struct {
+			result1 []configv3.TargetProfile
+			result2 error
+		})
+	}
+	fake.targetProfilesReturnsOnCall[i] = struct {
+		result1 []configv3.TargetProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) TokenRefreshSkew() time.Duration {
+	fake.tokenRefreshSkewMutex.Lock()
+	ret, specificReturn := fake.tokenRefreshSkewReturnsOnCall[len(fake.tokenRefreshSkewArgsForCall)]
+	fake.tokenRefreshSkewArgsForCall = append(fake.tokenRefreshSkewArgsForCall, struct{}{})
+	fake.recordInvocation("TokenRefreshSkew", []interface{}{})
+	fake.tokenRefreshSkewMutex.Unlock()
+	if fake.TokenRefreshSkewStub != nil {
+		return fake.TokenRefreshSkewStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.tokenRefreshSkewReturns.result1
+}
+
+func (fake *FakeConfig) TokenRefreshSkewCallCount() int {
+	fake.tokenRefreshSkewMutex.RLock()
+	defer fake.tokenRefreshSkewMutex.RUnlock()
+	return len(fake.tokenRefreshSkewArgsForCall)
+}
+
+func (fake *FakeConfig) TokenRefreshSkewReturns(result1 time.Duration) {
+	fake.TokenRefreshSkewStub = nil
+	fake.tokenRefreshSkewReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) TokenRefreshSkewReturnsOnCall(i int, result1 time.Duration) {
+	fake.TokenRefreshSkewStub = nil
+	if fake.tokenRefreshSkewReturnsOnCall == nil {
+		fake.tokenRefreshSkewReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.tokenRefreshSkewReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceFormat() configv3.TraceFormat {
+	fake.traceFormatMutex.Lock()
+	ret, specificReturn := fake.traceFormatReturnsOnCall[len(fake.traceFormatArgsForCall)]
+	fake.traceFormatArgsForCall = append(fake.traceFormatArgsForCall, struct{}{})
+	fake.recordInvocation("TraceFormat", []interface{}{})
+	fake.traceFormatMutex.Unlock()
+	if fake.TraceFormatStub != nil {
+		return fake.TraceFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.traceFormatReturns.result1
+}
+
+func (fake *FakeConfig) TraceFormatCallCount() int {
+	fake.traceFormatMutex.RLock()
+	defer fake.traceFormatMutex.RUnlock()
+	return len(fake.traceFormatArgsForCall)
+}
+
+func (fake *FakeConfig) TraceFormatReturns(result1 configv3.TraceFormat) {
+	fake.TraceFormatStub = nil
+	fake.traceFormatReturns = struct {
+		result1 configv3.TraceFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceFormatReturnsOnCall(i int, result1 configv3.TraceFormat) {
+	fake.TraceFormatStub = nil
+	if fake.traceFormatReturnsOnCall == nil {
+		fake.traceFormatReturnsOnCall = make(map[int]struct {
+			result1 configv3.TraceFormat
+		})
+	}
+	fake.traceFormatReturnsOnCall[i] = struct {
+		result1 configv3.TraceFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantType() string {
+	fake.uAAGrantTypeMutex.Lock()
+	ret, specificReturn := fake.uAAGrantTypeReturnsOnCall[len(fake.uAAGrantTypeArgsForCall)]
+	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uAAGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uAAGrantTypeReturns.result1
+}
+
+func (fake *FakeConfig) UAAGrantTypeCallCount() int {
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
+	return len(fake.uAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uAAGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturnsOnCall(i int, result1 string) {
+	fake.UAAGrantTypeStub = nil
+	if fake.uAAGrantTypeReturnsOnCall == nil {
+		fake.uAAGrantTypeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uAAGrantTypeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAOAuthClient() string {
 	fake.uAAOAuthClientMutex.Lock()
 	ret, specificReturn := fake.uAAOAuthClientReturnsOnCall[len(fake.uAAOAuthClientArgsForCall)]
@@ -1983,6 +2760,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addPluginTrustedKeyMutex.RLock()
+	defer fake.addPluginTrustedKeyMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
@@ -1991,8 +2770,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryVersionMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.completionCacheMutex.RLock()
+	defer fake.completionCacheMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
+	fake.deleteTargetProfileMutex.RLock()
+	defer fake.deleteTargetProfileMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
@@ -2011,12 +2794,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.localeMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
+	fake.pluginTrustedKeysMutex.RLock()
+	defer fake.pluginTrustedKeysMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
@@ -2025,8 +2812,14 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removePluginTrustedKeyMutex.RLock()
+	defer fake.removePluginTrustedKeyMutex.RUnlock()
+	fake.saveTargetProfileMutex.RLock()
+	defer fake.saveTargetProfileMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
+	fake.setCompletionCacheMutex.RLock()
+	defer fake.setCompletionCacheMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
@@ -2037,12 +2830,18 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setTargetInformationMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
 	defer fake.setTokenInformationMutex.RUnlock()
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
 	fake.setUAAEndpointMutex.RLock()
 	defer fake.setUAAEndpointMutex.RUnlock()
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
+	fake.switchTargetProfileMutex.RLock()
+	defer fake.switchTargetProfileMutex.RUnlock()
 	fake.stagingTimeoutMutex.RLock()
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
@@ -2053,6 +2852,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.targetProfileNameMutex.RLock()
+	defer fake.targetProfileNameMutex.RUnlock()
+	fake.targetProfilesMutex.RLock()
+	defer fake.targetProfilesMutex.RUnlock()
+	fake.tokenRefreshSkewMutex.RLock()
+	defer fake.tokenRefreshSkewMutex.RUnlock()
+	fake.traceFormatMutex.RLock()
+	defer fake.traceFormatMutex.RUnlock()
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.uAAOAuthClientMutex.RLock()
 	defer fake.uAAOAuthClientMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
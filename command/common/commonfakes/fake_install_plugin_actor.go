@@ -155,6 +155,20 @@ type FakeInstallPluginActor struct {
 	validateFileChecksumReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ValidateFileSignatureStub        func(path string, signatureLocation string) (string, error)
+	validateFileSignatureMutex       sync.RWMutex
+	validateFileSignatureArgsForCall []struct {
+		path              string
+		signatureLocation string
+	}
+	validateFileSignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	validateFileSignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -721,6 +735,58 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) ValidateFileSignature(path string, signatureLocation string) (string, error) {
+	fake.validateFileSignatureMutex.Lock()
+	ret, specificReturn := fake.validateFileSignatureReturnsOnCall[len(fake.validateFileSignatureArgsForCall)]
+	fake.validateFileSignatureArgsForCall = append(fake.validateFileSignatureArgsForCall, struct {
+		path              string
+		signatureLocation string
+	}{path, signatureLocation})
+	fake.recordInvocation("ValidateFileSignature", []interface{}{path, signatureLocation})
+	fake.validateFileSignatureMutex.Unlock()
+	if fake.ValidateFileSignatureStub != nil {
+		return fake.ValidateFileSignatureStub(path, signatureLocation)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.validateFileSignatureReturns.result1, fake.validateFileSignatureReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureCallCount() int {
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
+	return len(fake.validateFileSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureArgsForCall(i int) (string, string) {
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
+	return fake.validateFileSignatureArgsForCall[i].path, fake.validateFileSignatureArgsForCall[i].signatureLocation
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureReturns(result1 string, result2 error) {
+	fake.ValidateFileSignatureStub = nil
+	fake.validateFileSignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.ValidateFileSignatureStub = nil
+	if fake.validateFileSignatureReturnsOnCall == nil {
+		fake.validateFileSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.validateFileSignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -746,6 +812,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.uninstallPluginMutex.RUnlock()
 	fake.validateFileChecksumMutex.RLock()
 	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,205 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . CompleteActor
+
+// CompleteActor looks up the resource names suggested by the __complete
+// command
+type CompleteActor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+}
+
+// CompleteCommand is the hidden endpoint called by the scripts generated by
+// the completion command. It prints the names of the targeted resources that
+// can complete the last of the given words.
+type CompleteCommand struct {
+	usage interface{} `usage:"CF_NAME __complete -- COMMAND [ARGS...]"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor CompletionActor
+	Actor       CompleteActor
+}
+
+func (cmd *CompleteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+	return nil
+}
+
+// DoesNotWriteConfig keeps completion from rewriting the config on every TAB.
+func (CompleteCommand) DoesNotWriteConfig() {}
+
+func (cmd CompleteCommand) Execute(args []string) error {
+	if len(args) < 2 {
+		return nil
+	}
+
+	resource := cmd.resourceFor(args[0], args[1:])
+	if resource == sharedaction.NoCompletionResource {
+		return nil
+	}
+
+	prefix := args[len(args)-1]
+	for _, name := range cmd.resourceNames(resource) {
+		if strings.HasPrefix(name, prefix) {
+			fmt.Fprintln(cmd.UI.Writer(), name)
+		}
+	}
+
+	return nil
+}
+
+// resourceFor returns the resource named by the last of the words following
+// commandName.
+func (cmd CompleteCommand) resourceFor(commandName string, words []string) sharedaction.CompletionResource {
+	var completion *sharedaction.CommandCompletion
+	completions := cmd.SharedActor.CommandCompletions(Commands)
+	for i := range completions {
+		if completions[i].Name == commandName || (completions[i].Alias != "" && completions[i].Alias == commandName) {
+			completion = &completions[i]
+			break
+		}
+	}
+	if completion == nil {
+		return sharedaction.NoCompletionResource
+	}
+
+	last := len(words) - 1
+	positional := 0
+	for i := 0; i < last; i++ {
+		word := words[i]
+		if !strings.HasPrefix(word, "-") || word == "-" {
+			positional++
+			continue
+		}
+
+		completionFlag, found := findCompletionFlag(completion.Flags, word)
+		if !found || !completionFlag.TakesValue || strings.Contains(word, "=") {
+			continue
+		}
+
+		if i+1 == last {
+			return completionFlag.Resource
+		}
+		i++
+	}
+
+	if strings.HasPrefix(words[last], "-") || positional >= len(completion.Arguments) {
+		return sharedaction.NoCompletionResource
+	}
+	return completion.Arguments[positional]
+}
+
+func findCompletionFlag(completionFlags []sharedaction.CompletionFlag, word string) (sharedaction.CompletionFlag, bool) {
+	name := strings.SplitN(word, "=", 2)[0]
+	for _, completionFlag := range completionFlags {
+		if (completionFlag.Short != "" && name == "-"+completionFlag.Short) ||
+			(completionFlag.Long != "" && name == "--"+completionFlag.Long) {
+			return completionFlag, true
+		}
+	}
+	return sharedaction.CompletionFlag{}, false
+}
+
+// resourceNames returns the names of the resource in the current target,
+// reusing names looked up within the last CompletionCacheDuration.
+func (cmd CompleteCommand) resourceNames(resource sharedaction.CompletionResource) []string {
+	var scopeGUID string
+	switch resource {
+	case sharedaction.OrgCompletionResource:
+	case sharedaction.SpaceCompletionResource:
+		scopeGUID = cmd.Config.TargetedOrganization().GUID
+	default:
+		scopeGUID = cmd.Config.TargetedSpace().GUID
+	}
+	if resource != sharedaction.OrgCompletionResource && scopeGUID == "" {
+		return nil
+	}
+
+	cacheKey := strings.Join([]string{cmd.Config.Target(), string(resource), scopeGUID}, "|")
+	if names, found := cmd.Config.CompletionCache(cacheKey); found {
+		return names
+	}
+
+	names, err := cmd.fetchResourceNames(resource, scopeGUID)
+	if err != nil {
+		return nil
+	}
+
+	// a cache that cannot be written only costs another lookup next time
+	_ = cmd.Config.SetCompletionCache(cacheKey, names)
+	return names
+}
+
+func (cmd CompleteCommand) fetchResourceNames(resource sharedaction.CompletionResource, scopeGUID string) ([]string, error) {
+	actor, err := cmd.actor()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	switch resource {
+	case sharedaction.OrgCompletionResource:
+		orgs, _, err := actor.GetOrganizations()
+		if err != nil {
+			return nil, err
+		}
+		for _, org := range orgs {
+			names = append(names, org.Name)
+		}
+	case sharedaction.SpaceCompletionResource:
+		spaces, _, err := actor.GetOrganizationSpaces(scopeGUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, space := range spaces {
+			names = append(names, space.Name)
+		}
+	case sharedaction.AppCompletionResource:
+		apps, _, err := actor.GetApplicationsBySpace(scopeGUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+	case sharedaction.ServiceCompletionResource:
+		instances, _, err := actor.GetServiceInstancesBySpace(scopeGUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range instances {
+			names = append(names, instance.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// actor returns the actor that looks up the names, connecting to the target
+// only the first time names are not found in the completion cache.
+func (cmd *CompleteCommand) actor() (CompleteActor, error) {
+	if cmd.Actor == nil {
+		ccClient, uaaClient, err := shared.NewClients(cmd.Config, cmd.UI, true)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Actor = v2action.NewActor(ccClient, uaaClient, cmd.Config)
+	}
+	return cmd.Actor, nil
+}
@@ -0,0 +1,223 @@
+package common_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("__complete Command", func() {
+	var (
+		cmd             CompleteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commonfakes.FakeCompletionActor
+		fakeActor       *commonfakes.FakeCompleteActor
+		args            []string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commonfakes.FakeCompletionActor)
+		fakeActor = new(commonfakes.FakeCompleteActor)
+
+		cmd = CompleteCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetReturns("https://api.some-domain.com")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+
+		fakeSharedActor.CommandCompletionsReturns([]sharedaction.CommandCompletion{
+			{
+				Name:  "target",
+				Alias: "t",
+				Flags: []sharedaction.CompletionFlag{
+					{Short: "o", TakesValue: true, Resource: sharedaction.OrgCompletionResource},
+					{Short: "s", TakesValue: true, Resource: sharedaction.SpaceCompletionResource},
+				},
+			},
+			{
+				Name: "bind-service",
+				Flags: []sharedaction.CompletionFlag{
+					{Short: "c", TakesValue: true},
+					{Long: "force"},
+				},
+				Arguments: []sharedaction.CompletionResource{
+					sharedaction.AppCompletionResource,
+					sharedaction.ServiceCompletionResource,
+				},
+			},
+		})
+
+		fakeActor.GetOrganizationsReturns([]v2action.Organization{{Name: "org-1"}, {Name: "org-2"}, {Name: "other-org"}}, nil, nil)
+		fakeActor.GetOrganizationSpacesReturns([]v2action.Space{{Name: "space-1"}}, nil, nil)
+		fakeActor.GetApplicationsBySpaceReturns([]v2action.Application{{Name: "app-1"}, {Name: "app-2"}}, nil, nil)
+		fakeActor.GetServiceInstancesBySpaceReturns([]v2action.ServiceInstance{{Name: "service-1"}}, nil, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(args)
+	})
+
+	Context("when completing a flag's value", func() {
+		BeforeEach(func() {
+			args = []string{"t", "-o", "org"}
+		})
+
+		It("displays the matching names and caches the full list", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("org-1\norg-2\n"))
+			Expect(testUI.Out).ToNot(Say("other-org"))
+
+			Expect(fakeConfig.SetCompletionCacheCallCount()).To(Equal(1))
+			key, names := fakeConfig.SetCompletionCacheArgsForCall(0)
+			Expect(key).To(Equal("https://api.some-domain.com|orgs|"))
+			Expect(names).To(Equal([]string{"org-1", "org-2", "other-org"}))
+		})
+
+		Context("when the names are cached", func() {
+			BeforeEach(func() {
+				fakeConfig.CompletionCacheReturns([]string{"org-cached"}, true)
+			})
+
+			It("displays the cached names without looking them up", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("org-cached"))
+				Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetCompletionCacheCallCount()).To(Equal(0))
+			})
+
+			Context("when no actor has been created", func() {
+				BeforeEach(func() {
+					cmd.Actor = nil
+				})
+
+				It("displays the cached names without connecting to the target", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("org-cached"))
+					Expect(fakeConfig.SkipSSLValidationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when no API is targeted", func() {
+			BeforeEach(func() {
+				cmd.Actor = nil
+				fakeConfig.TargetReturns("")
+			})
+
+			It("displays nothing and does not return an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+			})
+		})
+
+		Context("when looking up the names fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsReturns(nil, nil, errors.New("some-error"))
+			})
+
+			It("displays nothing and does not return an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+				Expect(fakeConfig.SetCompletionCacheCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when completing a space in the targeted org", func() {
+		BeforeEach(func() {
+			args = []string{"target", "-o", "org-1", "-s", ""}
+		})
+
+		It("displays the spaces of the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("space-1"))
+			Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+	})
+
+	Context("when completing positional arguments", func() {
+		Context("when completing the first argument", func() {
+			BeforeEach(func() {
+				args = []string{"bind-service", "--force", "-c", "{}", "app"}
+			})
+
+			It("displays the apps in the targeted space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("app-1\napp-2\n"))
+				Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when completing the second argument", func() {
+			BeforeEach(func() {
+				args = []string{"bind-service", "app-1", ""}
+			})
+
+			It("displays the service instances in the targeted space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("service-1"))
+			})
+		})
+
+		Context("when no space is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedSpaceReturns(configv3.Space{})
+				args = []string{"bind-service", ""}
+			})
+
+			It("displays nothing", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+				Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the command takes no more arguments", func() {
+			BeforeEach(func() {
+				args = []string{"bind-service", "app-1", "service-1", ""}
+			})
+
+			It("displays nothing", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+			})
+		})
+	})
+
+	It("does not write the config", func() {
+		var extendedCmd command.ExtendedCommander = &cmd
+		_, ok := extendedCmd.(command.ConfigReadOnlyCommander)
+		Expect(ok).To(BeTrue())
+	})
+
+	Context("when the command is unknown", func() {
+		BeforeEach(func() {
+			args = []string{"does-not-exist", ""}
+		})
+
+		It("displays nothing", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+		})
+	})
+})
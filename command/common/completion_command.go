@@ -0,0 +1,273 @@
+package common
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+//go:generate counterfeiter . CompletionActor
+
+// CompletionActor handles the business logic of the completion command
+type CompletionActor interface {
+	// CommandCompletions returns the completion details of all visible
+	// commands
+	CommandCompletions(interface{}) []sharedaction.CommandCompletion
+}
+
+type CompletionCommand struct {
+	RequiredArgs    flag.CompletionShell `positional-args:"yes"`
+	usage           interface{}          `usage:"CF_NAME completion SHELL\n\nEXAMPLES:\n   source <(CF_NAME completion bash)\n   source <(CF_NAME completion zsh)\n   CF_NAME completion fish | source"`
+	relatedCommands interface{}          `related_commands:"help, plugins"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  CompletionActor
+}
+
+type completionScript struct {
+	BinaryName   string
+	FunctionName string
+	Commands     []completionScriptCommand
+}
+
+type completionScriptCommand struct {
+	Names       []string
+	Description string
+	Flags       []completionScriptFlag
+}
+
+type completionScriptFlag struct {
+	Short       string
+	Long        string
+	Description string
+}
+
+// Names returns the forms of the flag as they are typed on the command line.
+func (f completionScriptFlag) Names() []string {
+	var names []string
+	if f.Short != "" {
+		names = append(names, "-"+f.Short)
+	}
+	if f.Long != "" {
+		names = append(names, "--"+f.Long)
+	}
+	return names
+}
+
+var nonWordCharacters = regexp.MustCompile(`\W`)
+
+func (cmd *CompletionCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = sharedaction.NewActor()
+	return nil
+}
+
+func (cmd CompletionCommand) Execute(args []string) error {
+	var scriptTemplate string
+	switch cmd.RequiredArgs.Shell.Shell {
+	case "zsh":
+		scriptTemplate = zshCompletionTemplate
+	case "fish":
+		scriptTemplate = fishCompletionTemplate
+	default:
+		scriptTemplate = bashCompletionTemplate
+	}
+
+	script, err := template.New("completion").Funcs(template.FuncMap{
+		"join":      strings.Join,
+		"flagNames": completionFlagNames,
+		"zshItem":   zshCompletionItem,
+		"fishQuote": fishQuote,
+	}).Parse(scriptTemplate)
+	if err != nil {
+		return err
+	}
+
+	binaryName := cmd.Config.BinaryName()
+	return script.Execute(cmd.UI.Writer(), completionScript{
+		BinaryName:   binaryName,
+		FunctionName: "_" + nonWordCharacters.ReplaceAllString(binaryName, "_"),
+		Commands:     cmd.completionScriptCommands(),
+	})
+}
+
+func (cmd CompletionCommand) completionScriptCommands() []completionScriptCommand {
+	var commands []completionScriptCommand
+
+	for _, completion := range cmd.Actor.CommandCompletions(Commands) {
+		scriptCommand := completionScriptCommand{
+			Names:       []string{completion.Name},
+			Description: completion.Description,
+		}
+		if completion.Alias != "" {
+			scriptCommand.Names = append(scriptCommand.Names, completion.Alias)
+		}
+		for _, completionFlag := range completion.Flags {
+			scriptCommand.Flags = append(scriptCommand.Flags, completionScriptFlag{
+				Short:       completionFlag.Short,
+				Long:        completionFlag.Long,
+				Description: completionFlag.Description,
+			})
+		}
+		commands = append(commands, scriptCommand)
+	}
+
+	for _, plugin := range cmd.Config.Plugins() {
+		for _, pluginCommand := range plugin.Commands {
+			scriptCommand := completionScriptCommand{
+				Names:       []string{pluginCommand.Name},
+				Description: pluginCommand.HelpText,
+			}
+			if pluginCommand.Alias != "" {
+				scriptCommand.Names = append(scriptCommand.Names, pluginCommand.Alias)
+			}
+
+			var options []string
+			for option := range pluginCommand.UsageDetails.Options {
+				options = append(options, option)
+			}
+			sort.Strings(options)
+
+			for _, option := range options {
+				name := strings.TrimLeft(option, "-")
+				scriptFlag := completionScriptFlag{Description: pluginCommand.UsageDetails.Options[option]}
+				if len(name) == 1 {
+					scriptFlag.Short = name
+				} else {
+					scriptFlag.Long = name
+				}
+				scriptCommand.Flags = append(scriptCommand.Flags, scriptFlag)
+			}
+			commands = append(commands, scriptCommand)
+		}
+	}
+
+	return commands
+}
+
+func completionFlagNames(flags []completionScriptFlag) string {
+	var names []string
+	for _, f := range flags {
+		names = append(names, f.Names()...)
+	}
+	return strings.Join(names, " ")
+}
+
+// zshCompletionItem formats a name and description as a single quoted
+// _describe item.
+func zshCompletionItem(name string, description string) string {
+	item := strings.Replace(name, ":", `\:`, -1) + ":" + description
+	return "'" + strings.Replace(item, "'", `'\''`, -1) + "'"
+}
+
+func fishQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	return "'" + strings.Replace(s, "'", `\'`, -1) + "'"
+}
+
+const bashCompletionTemplate = `# bash completion for {{.BinaryName}}
+#
+# Load it in the current shell with:
+#   source <({{.BinaryName}} completion bash)
+
+{{.FunctionName}}() {
+    local cur="${COMP_WORDS[COMP_CWORD]}"
+
+    if [ "$COMP_CWORD" -eq 1 ]; then
+        COMPREPLY=($(compgen -W "{{range .Commands}}{{join .Names " "}} {{end}}" -- "$cur"))
+        return
+    fi
+
+    if [[ "$cur" == -* ]]; then
+        local flags="--help"
+        case "${COMP_WORDS[1]}" in
+{{- range .Commands}}{{if .Flags}}
+        {{join .Names "|"}}) flags="$flags {{flagNames .Flags}}" ;;
+{{- end}}{{end}}
+        esac
+        COMPREPLY=($(compgen -W "$flags" -- "$cur"))
+        return
+    fi
+
+    local IFS=$'\n'
+    COMPREPLY=($(compgen -W "$({{.BinaryName}} __complete -- "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null)" -- "$cur"))
+}
+
+complete -o default -F {{.FunctionName}} {{.BinaryName}}
+`
+
+const zshCompletionTemplate = `# zsh completion for {{.BinaryName}}
+#
+# Load it in the current shell with:
+#   source <({{.BinaryName}} completion zsh)
+
+{{.FunctionName}}() {
+    local -a commands flags names
+
+    if (( CURRENT == 2 )); then
+        commands=(
+{{- range .Commands}}{{$description := .Description}}{{range .Names}}
+            {{zshItem . $description}}
+{{- end}}{{end}}
+        )
+        _describe -t commands '{{.BinaryName}} command' commands
+        return
+    fi
+
+    if [[ $words[CURRENT] == -* ]]; then
+        flags=('--help:Show help')
+        case $words[2] in
+{{- range .Commands}}{{if .Flags}}
+            ({{join .Names "|"}}) flags+=({{range .Flags}}{{$description := .Description}}{{range .Names}} {{zshItem . $description}}{{end}}{{end}} ) ;;
+{{- end}}{{end}}
+        esac
+        _describe -t flags 'flag' flags
+        return
+    fi
+
+    names=(${(f)"$({{.BinaryName}} __complete -- "${(@)words[2,CURRENT]}" 2>/dev/null)"})
+    if (( ${#names} )); then
+        compadd -a names
+    else
+        _files
+    fi
+}
+
+compdef {{.FunctionName}} {{.BinaryName}}
+`
+
+const fishCompletionTemplate = `# fish completion for {{.BinaryName}}
+#
+# Load it in the current shell with:
+#   {{.BinaryName}} completion fish | source
+
+function {{.FunctionName}}_needs_command
+    test (count (commandline -opc)) -eq 1
+end
+
+function {{.FunctionName}}_using_command
+    set -l words (commandline -opc)
+    test (count $words) -gt 1; and contains -- $words[2] $argv
+end
+
+function {{.FunctionName}}_resources
+    set -l words (commandline -opc)
+    {{.BinaryName}} __complete -- $words[2..-1] (commandline -ct) 2>/dev/null
+end
+
+complete -c {{.BinaryName}} -n {{.FunctionName}}_needs_command -f
+{{- range .Commands}}{{$description := .Description}}{{range .Names}}
+complete -c {{$.BinaryName}} -n {{$.FunctionName}}_needs_command -a {{.}} -d {{fishQuote $description}}
+{{- end}}{{end}}
+{{- range .Commands}}{{$names := join .Names " "}}{{range .Flags}}
+complete -c {{$.BinaryName}} -n '{{$.FunctionName}}_using_command {{$names}}'{{if .Short}} -s {{.Short}}{{end}}{{if .Long}} -l {{.Long}}{{end}} -d {{fishQuote .Description}}
+{{- end}}{{end}}
+complete -c {{.BinaryName}} -n 'not {{.FunctionName}}_needs_command' -a '({{.FunctionName}}_resources)'
+`
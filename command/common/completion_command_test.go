@@ -0,0 +1,127 @@
+package common_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("completion Command", func() {
+	var (
+		cmd        CompletionCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *commonfakes.FakeCompletionActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor = new(commonfakes.FakeCompletionActor)
+
+		cmd = CompletionCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		fakeActor.CommandCompletionsReturns([]sharedaction.CommandCompletion{
+			{
+				Name:        "target",
+				Alias:       "t",
+				Description: "Set or view the targeted org or space",
+				Flags: []sharedaction.CompletionFlag{
+					{Short: "o", Description: "Organization", TakesValue: true, Resource: sharedaction.OrgCompletionResource},
+				},
+			},
+			{
+				Name:        "version",
+				Description: "Print the version",
+			},
+		})
+
+		fakeConfig.PluginsReturns([]configv3.Plugin{
+			{
+				Name: "Diego-Enabler",
+				Commands: []configv3.PluginCommand{
+					{
+						Name:     "enable-diego",
+						HelpText: "enable Diego support for an app",
+						UsageDetails: configv3.PluginUsageDetails{
+							Options: map[string]string{
+								"--first": "foobar",
+								"s":       "it's short",
+							},
+						},
+					},
+				},
+			},
+		})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("builds the commands from the command list", func() {
+		Expect(fakeActor.CommandCompletionsCallCount()).To(Equal(1))
+		Expect(fakeActor.CommandCompletionsArgsForCall(0)).To(Equal(Commands))
+	})
+
+	Context("when the shell is bash", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Shell.Shell = "bash"
+		})
+
+		It("displays a bash completion script", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`_faceman\(\) {`))
+			Expect(testUI.Out).To(Say(`compgen -W "target t version enable-diego "`))
+			Expect(testUI.Out).To(Say(`target\|t\) flags="\$flags -o" ;;`))
+			Expect(testUI.Out).To(Say(`enable-diego\) flags="\$flags --first -s" ;;`))
+			Expect(testUI.Out).To(Say(`faceman __complete -- `))
+			Expect(testUI.Out).To(Say(`complete -o default -F _faceman faceman`))
+		})
+	})
+
+	Context("when the shell is zsh", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Shell.Shell = "zsh"
+		})
+
+		It("displays a zsh completion script", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`'target:Set or view the targeted org or space'`))
+			Expect(testUI.Out).To(Say(`'t:Set or view the targeted org or space'`))
+			Expect(testUI.Out).To(Say(`'enable-diego:enable Diego support for an app'`))
+			Expect(testUI.Out).To(Say(`\(target\|t\) flags\+=\( '-o:Organization' \) ;;`))
+			Expect(testUI.Out).To(Say(`\(enable-diego\) flags\+=\( '--first:foobar' '-s:it'\\''s short' \) ;;`))
+			Expect(testUI.Out).To(Say(`compdef _faceman faceman`))
+		})
+	})
+
+	Context("when the shell is fish", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Shell.Shell = "fish"
+		})
+
+		It("displays a fish completion script", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`complete -c faceman -n _faceman_needs_command -a target -d 'Set or view the targeted org or space'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n _faceman_needs_command -a t -d 'Set or view the targeted org or space'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n '_faceman_using_command target t' -s o -d 'Organization'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n '_faceman_using_command enable-diego' -l first -d 'foobar'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n '_faceman_using_command enable-diego' -s s -d 'it\\'s short'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n 'not _faceman_needs_command' -a '\(_faceman_resources\)'`))
+		})
+	})
+})
@@ -3,12 +3,14 @@ package common
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common/internal"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
@@ -130,8 +132,13 @@ func (cmd HelpCommand) displayHelpFooter() {
 
 	cmd.UI.DisplayNewline()
 
+	cmd.UI.DisplayHeader("EXIT STATUSES:")
+	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, cmd.exitStatusesTableData(), 3)
+
+	cmd.UI.DisplayNewline()
+
 	cmd.UI.DisplayHeader("GLOBAL OPTIONS:")
-	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, cmd.globalOptionsTableData(), 17)
 }
 
 func (cmd HelpCommand) displayCommonCommands() {
@@ -199,7 +206,7 @@ func (cmd HelpCommand) displayCommonCommands() {
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayHeader("Global options:")
-	cmd.UI.DisplayNonWrappingTable(commonCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayNonWrappingTable(commonCommandsIndent, cmd.globalOptionsTableData(), 17)
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayText("Use 'cf help -a' to see all commands.")
@@ -297,18 +304,31 @@ func (cmd HelpCommand) displayCommand() error {
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
+		{"CF_CREDENTIAL_HELPER=name", cmd.UI.TranslateText("Store access and refresh tokens with this credential helper executable instead of the config file")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_PROFILE=name", cmd.UI.TranslateText("Use the saved target with this name instead of the current target")},
+		{"CF_TOKEN_REFRESH_SKEW=60", cmd.UI.TranslateText("Refresh the access token this many seconds before it expires")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_TRACE_FORMAT=json", cmd.UI.TranslateText("Write API request diagnostics as one JSON object per line")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP proxying for API requests")},
 	}
 }
 
+func (cmd HelpCommand) exitStatusesTableData() [][]string {
+	var data [][]string
+	for _, exitStatus := range translatableerror.ExitStatuses {
+		data = append(data, []string{strconv.Itoa(int(exitStatus.ExitStatus)), cmd.UI.TranslateText(exitStatus.Description)})
+	}
+	return data
+}
+
 func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
+		{"--output json|yaml", cmd.UI.TranslateText("Display the output of supported commands as JSON or YAML")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 	}
 }
@@ -412,6 +412,7 @@ var _ = Describe("help Command", func() {
 
 			Expect(testUI.Out).To(Say("Global options:"))
 			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
+			Expect(testUI.Out).To(Say(`  --output json\|yaml                 Display the output of supported commands as JSON or YAML`))
 			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
 
 			Expect(testUI.Out).To(Say("Use 'cf help -a' to see all commands\\."))
@@ -577,6 +578,7 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("ADVANCED:"))
 				Expect(testUI.Out).To(Say("   curl\\s+Executes a request to the targeted API endpoint"))
 				Expect(testUI.Out).To(Say("   ssh-code\\s+Get a one time password for ssh clients"))
+				Expect(testUI.Out).To(Say("   completion\\s+Generate a shell completion script"))
 
 				Expect(testUI.Out).To(Say("ADD/REMOVE PLUGIN REPOSITORY:"))
 				Expect(testUI.Out).To(Say("   add-plugin-repo\\s+Add a new plugin repository"))
@@ -591,15 +593,29 @@ var _ = Describe("help Command", func() {
 
 				Expect(testUI.Out).To(Say("ENVIRONMENT VARIABLES:"))
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
+				Expect(testUI.Out).To(Say("   CF_CREDENTIAL_HELPER=name          Store access and refresh tokens with this credential helper executable instead of the config file"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_PROFILE=name                    Use the saved target with this name instead of the current target"))
+				Expect(testUI.Out).To(Say("   CF_TOKEN_REFRESH_SKEW=60           Refresh the access token this many seconds before it expires"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
+				Expect(testUI.Out).To(Say("   CF_TRACE_FORMAT=json               Write API request diagnostics as one JSON object per line"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable HTTP proxying for API requests"))
 
+				Expect(testUI.Out).To(Say("EXIT STATUSES:"))
+				Expect(testUI.Out).To(Say("   1   Command failed or was used incorrectly"))
+				Expect(testUI.Out).To(Say("   2   Not logged in, or the credentials were rejected"))
+				Expect(testUI.Out).To(Say("   3   No API, org or space targeted"))
+				Expect(testUI.Out).To(Say("   4   App, org, space, service or other resource not found"))
+				Expect(testUI.Out).To(Say("   5   App failed to stage, upload or start, or a task or job failed"))
+				Expect(testUI.Out).To(Say("   6   API unreachable or its SSL certificate is invalid"))
+				Expect(testUI.Out).To(Say("   7   Staging, starting or a job timed out"))
+
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
+				Expect(testUI.Out).To(Say(`   --output json\|yaml                 Display the output of supported commands as JSON or YAML`))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
 			})
 
@@ -31,6 +31,7 @@ type InstallPluginActor interface {
 	IsPluginInstalled(pluginName string) bool
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
 	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signatureLocation string) (string, error)
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -55,8 +56,10 @@ type InstallPluginCommand struct {
 	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
 	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
-	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
+	AllowUnsigned        bool                   `long:"allow-unsigned" description:"Install the plugin even if it is not signed with a trusted key"`
+	Offline              bool                   `long:"offline" description:"Install the plugin from the plugin cache without connecting to the repositories"`
+	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--allow-unsigned] [--offline]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f] [--allow-unsigned] [--offline]\n\n   The plugin must be signed with a key added with 'CF_NAME add-plugin-key'. Its base64 encoded\n   ed25519 signature is read from the file next to it with a .sig extension, or from the signature_url\n   of the plugin binary in the repository.\n\n   Repository indexes and plugin binaries are cached. When a repository cannot be reached, the cached\n   copy is used. With --offline, plugins are only installed from the cache.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo\n   CF_NAME install-plugin -r My-Repo plugin-echo --offline"`
+	relatedCommands      interface{}            `related_commands:"add-plugin-key, add-plugin-repo, list-plugin-repos, plugins"`
 	UI                   command.UI
 	Config               command.Config
 	Actor                InstallPluginActor
@@ -66,7 +69,7 @@ type InstallPluginCommand struct {
 func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
-	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation, cmd.Offline))
 
 	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
 
@@ -217,7 +220,7 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 				return "", 0, translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
 
 			case pluginaction.FetchingPluginInfoFromRepositoryError:
-				return "", 0, cmd.handleFetchingPluginInfoFromRepositoriesError(pluginErr)
+				return "", 0, handleFetchingPluginInfoFromRepositoriesError(pluginErr)
 
 			default:
 				return "", 0, err
@@ -229,8 +232,11 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 
 // These are specific errors that we output to the user in the context of
 // installing from any repository.
-func (InstallPluginCommand) handleFetchingPluginInfoFromRepositoriesError(fetchErr pluginaction.FetchingPluginInfoFromRepositoryError) error {
+func handleFetchingPluginInfoFromRepositoriesError(fetchErr pluginaction.FetchingPluginInfoFromRepositoryError) error {
 	switch clientErr := fetchErr.Err.(type) {
+	case pluginerror.NotCachedError:
+		return translatableerror.PluginNotCachedError{URL: clientErr.URL}
+
 	case pluginerror.RawHTTPStatusError:
 		return translatableerror.FetchingPluginInfoFromRepositoriesError{
 			Message:        clientErr.Status,
@@ -262,6 +268,11 @@ func (cmd InstallPluginCommand) getPluginFromLocalFile(pluginLocation string) (s
 		return "", 0, err
 	}
 
+	err = cmd.validateSignature(pluginLocation, pluginaction.SignatureLocation(pluginLocation))
+	if err != nil {
+		return "", 0, err
+	}
+
 	return pluginLocation, PluginFromLocalFile, err
 }
 
@@ -282,6 +293,11 @@ func (cmd InstallPluginCommand) getPluginFromURL(pluginLocation string, tempPlug
 		return "", 0, err
 	}
 
+	err = cmd.validateSignature(tempPath, pluginaction.SignatureLocation(pluginLocation))
+	if err != nil {
+		return "", 0, err
+	}
+
 	return tempPath, PluginFromURL, err
 }
 
@@ -343,9 +359,61 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 		return "", 0, InvalidChecksumError{}
 	}
 
+	signatureLocation := pluginInfo.SignatureURL
+	if signatureLocation == "" {
+		signatureLocation = pluginaction.SignatureLocation(pluginInfo.URL)
+	}
+	err = cmd.validateSignature(tempPath, signatureLocation)
+	if err != nil {
+		return "", 0, err
+	}
+
 	return tempPath, PluginFromRepository, err
 }
 
+// validateSignature refuses plugins that are not signed with a trusted key,
+// unless --allow-unsigned is passed.
+func (cmd InstallPluginCommand) validateSignature(path string, signatureLocation string) error {
+	return validatePluginSignature(cmd.UI, cmd.Config, cmd.Actor, cmd.AllowUnsigned, path, signatureLocation)
+}
+
+type pluginSignatureValidator interface {
+	ValidateFileSignature(path string, signatureLocation string) (string, error)
+}
+
+func validatePluginSignature(ui command.UI, config command.Config, actor pluginSignatureValidator, allowUnsigned bool, path string, signatureLocation string) error {
+	keyName, err := actor.ValidateFileSignature(path, signatureLocation)
+	switch e := err.(type) {
+	case nil:
+		ui.DisplayText("Plugin signature verified with trusted key {{.KeyName}}.", map[string]interface{}{
+			"KeyName": keyName,
+		})
+		return nil
+	case pluginaction.PluginSignatureNotFoundError:
+		if allowUnsigned {
+			ui.DisplayWarning("Plugin is not signed. Installing it anyway because --allow-unsigned was passed.")
+			return nil
+		}
+		return translatableerror.PluginNotSignedError{BinaryName: config.BinaryName()}
+	case pluginaction.PluginSignatureInvalidError:
+		if allowUnsigned {
+			ui.DisplayWarning("Plugin signature could not be verified with any trusted key. Installing it anyway because --allow-unsigned was passed.")
+			return nil
+		}
+		return translatableerror.PluginSignatureInvalidError{BinaryName: config.BinaryName()}
+	case pluginaction.PluginSignatureUnavailableError:
+		if allowUnsigned {
+			ui.DisplayWarning("Plugin signature could not be retrieved: {{.Error}}. Installing it anyway because --allow-unsigned was passed.", map[string]interface{}{
+				"Error": e.Err.Error(),
+			})
+			return nil
+		}
+		return e.Err
+	default:
+		return err
+	}
+}
+
 func (cmd InstallPluginCommand) installPluginPrompt(template string, templateValues ...map[string]interface{}) error {
 	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
 	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
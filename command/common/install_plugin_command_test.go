@@ -389,6 +389,139 @@ var _ = Describe("install-plugin command", func() {
 		})
 	})
 
+	Describe("verifying the plugin signature", func() {
+		var plugin configv3.Plugin
+
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNameOrLocation = "some-path"
+			cmd.Force = true
+			fakeActor.FileExistsReturns(true)
+			fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+
+			plugin = configv3.Plugin{
+				Name:    "some-plugin",
+				Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+			}
+			fakeActor.GetAndValidatePluginReturns(plugin, nil)
+		})
+
+		Context("when the plugin is signed with a trusted key", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileSignatureReturns("some-key", nil)
+			})
+
+			It("verifies the signature next to the plugin and installs the plugin", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(1))
+				path, signatureLocation := fakeActor.ValidateFileSignatureArgsForCall(0)
+				Expect(path).To(Equal("some-path"))
+				Expect(signatureLocation).To(Equal("some-path.sig"))
+
+				Expect(testUI.Out).To(Say("Plugin signature verified with trusted key some-key\\."))
+				Expect(testUI.Out).To(Say("Plugin some-plugin 1\\.2\\.3 successfully installed\\."))
+			})
+		})
+
+		Context("when the plugin is not signed", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileSignatureReturns("", pluginaction.PluginSignatureNotFoundError{Location: "some-path.sig"})
+			})
+
+			It("returns a PluginNotSignedError without installing the plugin", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginNotSignedError{BinaryName: "faceman"}))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+
+			Context("when --allow-unsigned is passed", func() {
+				BeforeEach(func() {
+					cmd.AllowUnsigned = true
+				})
+
+				It("displays a warning and installs the plugin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Plugin is not signed\\. Installing it anyway because --allow-unsigned was passed\\."))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the signature cannot be verified with any trusted key", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileSignatureReturns("", pluginaction.PluginSignatureInvalidError{Location: "some-path.sig"})
+			})
+
+			It("returns a PluginSignatureInvalidError without installing the plugin", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginSignatureInvalidError{BinaryName: "faceman"}))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+
+			Context("when --allow-unsigned is passed", func() {
+				BeforeEach(func() {
+					cmd.AllowUnsigned = true
+				})
+
+				It("displays a warning and installs the plugin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Plugin signature could not be verified with any trusted key\\. Installing it anyway because --allow-unsigned was passed\\."))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the signature cannot be retrieved", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileSignatureReturns("", pluginaction.PluginSignatureUnavailableError{Location: "some-path.sig", Err: errors.New("permission denied")})
+			})
+
+			It("returns the error without installing the plugin", func() {
+				Expect(executeErr).To(MatchError("permission denied"))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+
+			Context("when --allow-unsigned is passed", func() {
+				BeforeEach(func() {
+					cmd.AllowUnsigned = true
+				})
+
+				It("displays a warning and installs the plugin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Plugin signature could not be retrieved: permission denied\\. Installing it anyway because --allow-unsigned was passed\\."))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when verifying the signature fails for another reason", func() {
+			BeforeEach(func() {
+				cmd.AllowUnsigned = true
+				fakeActor.ValidateFileSignatureReturns("", errors.New("some-error"))
+			})
+
+			It("returns the error even when --allow-unsigned is passed", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the plugin is downloaded from a URL", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.PluginNameOrLocation = "https://example.com/some-plugin"
+				fakeActor.FileExistsReturns(false)
+				fakeActor.DownloadExecutableBinaryFromURLReturns("downloaded-path", nil)
+			})
+
+			It("verifies the downloaded plugin with the signature next to the URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(1))
+				path, signatureLocation := fakeActor.ValidateFileSignatureArgsForCall(0)
+				Expect(path).To(Equal("downloaded-path"))
+				Expect(signatureLocation).To(Equal("https://example.com/some-plugin.sig"))
+			})
+		})
+	})
+
 	Describe("installing from an unsupported URL scheme", func() {
 		BeforeEach(func() {
 			cmd.OptionalArgs.PluginNameOrLocation = "ftp://some-url"
@@ -1079,4 +1079,50 @@ var _ = Describe("install-plugin command", func() {
 			})
 		})
 	})
+
+	Describe("verifying the signature of a plugin from a repository", func() {
+		var pluginInfo pluginaction.PluginInfo
+
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNameOrLocation = "some-plugin"
+			cmd.Force = true
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{{Name: "some-repo", URL: "https://some-repo.com"}})
+
+			pluginInfo = pluginaction.PluginInfo{Name: "some-plugin", Version: "1.2.3", URL: "https://some-repo.com/some-plugin", Checksum: "some-checksum"}
+			fakeActor.DownloadExecutableBinaryFromURLReturns("downloaded-path", nil)
+			fakeActor.ValidateFileChecksumReturns(true)
+			fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+			fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "some-plugin"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(1))
+		})
+
+		Context("when the repository lists the signature URL of the binary", func() {
+			BeforeEach(func() {
+				pluginInfo.SignatureURL = "https://signatures.some-repo.com/some-plugin.sig"
+				fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginInfo, []string{"some-repo"}, nil)
+			})
+
+			It("verifies the binary with the signature at that URL", func() {
+				path, signatureLocation := fakeActor.ValidateFileSignatureArgsForCall(0)
+				Expect(path).To(Equal("downloaded-path"))
+				Expect(signatureLocation).To(Equal("https://signatures.some-repo.com/some-plugin.sig"))
+			})
+		})
+
+		Context("when the repository does not list a signature URL", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginInfo, []string{"some-repo"}, nil)
+			})
+
+			It("verifies the binary with the signature next to it", func() {
+				path, signatureLocation := fakeActor.ValidateFileSignatureArgsForCall(0)
+				Expect(path).To(Equal("downloaded-path"))
+				Expect(signatureLocation).To(Equal("https://some-repo.com/some-plugin.sig"))
+			})
+		})
+	})
 })
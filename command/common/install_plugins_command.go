@@ -0,0 +1,274 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . InstallPluginsActor
+
+type InstallPluginsActor interface {
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	ReadPluginLockfile(path string) ([]pluginaction.LockedPlugin, error)
+	ReadPluginSet(path string) ([]pluginaction.PluginSetEntry, error)
+	ResolvePluginSet(entries []pluginaction.PluginSetEntry, locked []pluginaction.LockedPlugin, platform string, update bool) ([]pluginaction.LockedPlugin, error)
+	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signatureLocation string) (string, error)
+	WritePluginLockfile(path string, plugins []pluginaction.LockedPlugin) error
+}
+
+// pluginDownload is a verified plugin binary that install-plugins installs in
+// place of installedPlugin, if it is not nil.
+type pluginDownload struct {
+	name            string
+	installedPlugin *configv3.Plugin
+	executablePath  string
+}
+
+// uninstalledPlugin is a plugin that install-plugins uninstalled, with the
+// copy of its binary it can be restored from.
+type uninstalledPlugin struct {
+	plugin     configv3.Plugin
+	backupPath string
+}
+
+type InstallPluginsCommand struct {
+	PluginSetFile     flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to the plugin set file"`
+	Update            bool                        `long:"update" description:"Install the newest versions allowed by the plugin set instead of the versions in the lockfile"`
+	SkipSSLValidation bool                        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	AllowUnsigned     bool                        `long:"allow-unsigned" description:"Install plugins even if they are not signed with a trusted key"`
+	usage             interface{}                 `usage:"CF_NAME install-plugins -f PLUGIN_SET_FILE [--update] [--allow-unsigned]\n\n   Installs, updates and uninstalls plugins until the installed plugins are exactly the ones in the\n   plugin set file. The resolved versions and checksums are written to a lockfile next to it with a\n   .lock extension, and are installed again on later runs unless --update is passed.\n\n   The plugin set file lists plugins by name, with an optional repository and semantic version range:\n\n   plugins:\n   - name: plugin-echo\n     repository: CF-Community\n     version: \">=1.0.0 <2.0.0\"\n\nEXAMPLES:\n   CF_NAME install-plugins -f plugins.yml\n   CF_NAME install-plugins -f plugins.yml --update"`
+	relatedCommands   interface{}                 `related_commands:"install-plugin, plugins, update-plugins"`
+	UI                command.UI
+	Config            command.Config
+	Actor             InstallPluginsActor
+	ProgressBar       plugin.ProxyReader
+}
+
+func (cmd *InstallPluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation, false))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd InstallPluginsCommand) Execute([]string) error {
+	pluginSetPath := string(cmd.PluginSetFile)
+
+	entries, err := cmd.Actor.ReadPluginSet(pluginSetPath)
+	if err != nil {
+		return cmd.handleResolvePluginSetError(err)
+	}
+
+	lockfilePath := pluginaction.PluginLockfilePath(pluginSetPath)
+	locked, err := cmd.Actor.ReadPluginLockfile(lockfilePath)
+	if err != nil {
+		return cmd.handleResolvePluginSetError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Resolving plugins in {{.Path}}...", map[string]interface{}{
+		"Path": pluginSetPath,
+	})
+
+	currentPlatform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	resolved, err := cmd.Actor.ResolvePluginSet(entries, locked, currentPlatform, cmd.Update)
+	if err != nil {
+		return cmd.handleResolvePluginSetError(err)
+	}
+
+	err = os.MkdirAll(cmd.Config.PluginHome(), 0700)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	defer os.RemoveAll(tempPluginDir)
+
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	replacer := pluginReplacer{
+		UI:            cmd.UI,
+		Config:        cmd.Config,
+		Actor:         cmd.Actor,
+		ProgressBar:   cmd.ProgressBar,
+		AllowUnsigned: cmd.AllowUnsigned,
+	}
+
+	// Every plugin binary is downloaded and verified before any installed
+	// plugin is touched, so that a failed download leaves the installed
+	// plugins as they were.
+	var downloads []pluginDownload
+	inPluginSet := map[string]bool{}
+	for _, lockedPlugin := range resolved {
+		inPluginSet[lockedPlugin.Name] = true
+
+		var pluginToReplace *configv3.Plugin
+		installedPlugin, isInstalled := cmd.Config.GetPlugin(lockedPlugin.Name)
+		if isInstalled {
+			if installedPlugin.Version.String() == lockedPlugin.Version {
+				cmd.UI.DisplayNewline()
+				cmd.UI.DisplayText("Plugin {{.PluginName}} {{.PluginVersion}} is already installed.", map[string]interface{}{
+					"PluginName":    installedPlugin.Name,
+					"PluginVersion": installedPlugin.Version.String(),
+				})
+				continue
+			}
+			pluginToReplace = &installedPlugin
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithFlavor("Downloading plugin {{.PluginName}} {{.PluginVersion}}...", map[string]interface{}{
+			"PluginName":    lockedPlugin.Name,
+			"PluginVersion": lockedPlugin.Version,
+		})
+
+		binary := lockedPlugin.Binaries[currentPlatform]
+		pluginInfo := pluginaction.PluginInfo{
+			Name:         lockedPlugin.Name,
+			Version:      lockedPlugin.Version,
+			URL:          binary.URL,
+			Checksum:     binary.Checksum,
+			SignatureURL: binary.SignatureURL,
+		}
+		executablePath, err := replacer.download(pluginInfo, lockedPlugin.Repository, tempPluginDir)
+		if err != nil {
+			return handleReplacePluginError(cmd.Config.BinaryName(), lockedPlugin.Name, err)
+		}
+
+		downloads = append(downloads, pluginDownload{
+			name:            lockedPlugin.Name,
+			installedPlugin: pluginToReplace,
+			executablePath:  executablePath,
+		})
+	}
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	// Plugins that are not in the set are uninstalled before the others are
+	// installed, so that their commands cannot conflict with the commands of
+	// the plugins being installed. They are restored if an install fails.
+	var uninstalled []uninstalledPlugin
+	for _, installedPlugin := range cmd.Config.Plugins() {
+		if inPluginSet[installedPlugin.Name] {
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithFlavor("Uninstalling plugin {{.PluginName}} {{.PluginVersion}}, which is not in {{.Path}}...", map[string]interface{}{
+			"PluginName":    installedPlugin.Name,
+			"PluginVersion": installedPlugin.Version.String(),
+			"Path":          pluginSetPath,
+		})
+
+		backupPath, err := replacer.uninstall(installedPlugin, rpcService, tempPluginDir)
+		if err != nil {
+			cmd.restoreUninstalled(replacer, uninstalled)
+			return handleReplacePluginError(cmd.Config.BinaryName(), installedPlugin.Name, err)
+		}
+		cmd.UI.DisplayOK()
+
+		uninstalled = append(uninstalled, uninstalledPlugin{plugin: installedPlugin, backupPath: backupPath})
+	}
+
+	// If an install fails, the plugins installed before it are reverted as
+	// well, so that the installed plugins are left as they were.
+	var installed []replacedPlugin
+	for _, download := range downloads {
+		cmd.UI.DisplayNewline()
+
+		replaced, err := replacer.install(download.installedPlugin, download.executablePath, rpcService, tempPluginDir)
+		if err != nil {
+			cmd.revertInstalled(replacer, installed, rpcService)
+			cmd.restoreUninstalled(replacer, uninstalled)
+			return handleReplacePluginError(cmd.Config.BinaryName(), download.name, err)
+		}
+
+		installed = append(installed, replaced)
+	}
+
+	err = cmd.Actor.WritePluginLockfile(lockfilePath, resolved)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Installed plugins match {{.Path}}. Versions are locked in {{.LockfilePath}}.", map[string]interface{}{
+		"Path":         pluginSetPath,
+		"LockfilePath": lockfilePath,
+	})
+
+	return nil
+}
+
+// revertInstalled reverts the plugins installed from the plugin set, most
+// recent first.
+func (cmd InstallPluginsCommand) revertInstalled(replacer pluginReplacer, installed []replacedPlugin, rpcService *shared.RPCService) {
+	for i := len(installed) - 1; i >= 0; i-- {
+		replacer.revert(installed[i], rpcService)
+	}
+}
+
+// restoreUninstalled reinstalls the plugins that were uninstalled because
+// they are not in the plugin set.
+func (cmd InstallPluginsCommand) restoreUninstalled(replacer pluginReplacer, uninstalled []uninstalledPlugin) {
+	for _, removed := range uninstalled {
+		replacer.restore(removed.plugin, removed.backupPath)
+	}
+}
+
+func (cmd InstallPluginsCommand) handleResolvePluginSetError(err error) error {
+	switch e := err.(type) {
+	case pluginaction.PluginSetInvalidError:
+		return translatableerror.PluginSetInvalidError{Path: e.Path, Message: e.Message}
+	case pluginaction.PluginVersionConstraintInvalidError:
+		return translatableerror.PluginVersionConstraintInvalidError{
+			PluginName: e.PluginName,
+			Constraint: e.Constraint,
+		}
+	case pluginaction.PluginVersionConstraintNotSatisfiedError:
+		return translatableerror.PluginVersionConstraintNotSatisfiedError{
+			PluginName: e.PluginName,
+			Constraint: e.Constraint,
+			Version:    e.Version,
+		}
+	case pluginaction.LockedPluginVersionUnavailableError:
+		return translatableerror.LockedPluginVersionUnavailableError{
+			BinaryName:    cmd.Config.BinaryName(),
+			PluginSetPath: string(cmd.PluginSetFile),
+			PluginName:    e.PluginName,
+			LockedVersion: e.LockedVersion,
+			Version:       e.Version,
+		}
+	case pluginaction.PluginNotFoundInAnyRepositoryError:
+		return translatableerror.PluginNotFoundInAnyRepositoryError{
+			BinaryName: cmd.Config.BinaryName(),
+			PluginName: e.PluginName,
+		}
+	case pluginaction.FetchingPluginInfoFromRepositoryError:
+		return handleFetchingPluginInfoFromRepositoriesError(e)
+	default:
+		return shared.HandleError(err)
+	}
+}
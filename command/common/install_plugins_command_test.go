@@ -0,0 +1,328 @@
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("install-plugins command", func() {
+	var (
+		cmd        InstallPluginsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *commonfakes.FakeInstallPluginsActor
+		executeErr error
+		pluginHome string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeInstallPluginsActor)
+
+		cmd = InstallPluginsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+		cmd.PluginSetFile = "some-dir/plugins.yml"
+
+		tmpDirectorySeed := strconv.Itoa(int(rand.Int63()))
+		pluginHome = fmt.Sprintf("some-pluginhome-%s", tmpDirectorySeed)
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor.GetPlatformStringReturns("some-platform")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the plugin set is invalid", func() {
+		BeforeEach(func() {
+			fakeActor.ReadPluginSetReturns(nil, pluginaction.PluginSetInvalidError{Path: "some-dir/plugins.yml", Message: "some-message"})
+		})
+
+		It("returns a PluginSetInvalidError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginSetInvalidError{Path: "some-dir/plugins.yml", Message: "some-message"}))
+			Expect(fakeActor.ResolvePluginSetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a locked version is no longer available", func() {
+		BeforeEach(func() {
+			fakeActor.ResolvePluginSetReturns(nil, pluginaction.LockedPluginVersionUnavailableError{
+				PluginName:    "plugin-1",
+				LockedVersion: "1.0.0",
+				Version:       "2.0.0",
+			})
+		})
+
+		It("returns a LockedPluginVersionUnavailableError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.LockedPluginVersionUnavailableError{
+				BinaryName:    "faceman",
+				PluginSetPath: "some-dir/plugins.yml",
+				PluginName:    "plugin-1",
+				LockedVersion: "1.0.0",
+				Version:       "2.0.0",
+			}))
+		})
+	})
+
+	Context("when the newest version does not satisfy a constraint", func() {
+		BeforeEach(func() {
+			fakeActor.ResolvePluginSetReturns(nil, pluginaction.PluginVersionConstraintNotSatisfiedError{
+				PluginName: "plugin-1",
+				Constraint: "<2.0.0",
+				Version:    "2.0.0",
+			})
+		})
+
+		It("returns a PluginVersionConstraintNotSatisfiedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginVersionConstraintNotSatisfiedError{
+				PluginName: "plugin-1",
+				Constraint: "<2.0.0",
+				Version:    "2.0.0",
+			}))
+		})
+	})
+
+	Context("when the plugin set resolves", func() {
+		var (
+			entries []pluginaction.PluginSetEntry
+			locked  []pluginaction.LockedPlugin
+		)
+
+		BeforeEach(func() {
+			entries = []pluginaction.PluginSetEntry{
+				{Name: "current-plugin"},
+				{Name: "outdated-plugin"},
+				{Name: "new-plugin"},
+			}
+			fakeActor.ReadPluginSetReturns(entries, nil)
+
+			locked = []pluginaction.LockedPlugin{{Name: "current-plugin", Version: "1.0.0"}}
+			fakeActor.ReadPluginLockfileReturns(locked, nil)
+
+			fakeActor.ResolvePluginSetReturns([]pluginaction.LockedPlugin{
+				{
+					Name: "current-plugin", Version: "1.0.0", Repository: "repo-1",
+					Binaries: map[string]pluginaction.LockedPluginBinary{
+						"some-platform": {URL: "https://example.com/current-plugin", Checksum: "checksum-1"},
+					},
+				},
+				{
+					Name: "outdated-plugin", Version: "2.0.0", Repository: "repo-1",
+					Binaries: map[string]pluginaction.LockedPluginBinary{
+						"other-platform": {URL: "https://example.com/outdated-plugin-other-platform", Checksum: "other-checksum"},
+						"some-platform":  {URL: "https://example.com/outdated-plugin", Checksum: "checksum-2"},
+					},
+				},
+				{
+					Name: "new-plugin", Version: "3.0.0", Repository: "repo-2",
+					Binaries: map[string]pluginaction.LockedPluginBinary{
+						"some-platform": {URL: "https://example.com/new-plugin", Checksum: "checksum-3", SignatureURL: "https://example.com/new-plugin.signature"},
+					},
+				},
+			}, nil)
+
+			installed := map[string]configv3.Plugin{
+				"current-plugin":  {Name: "current-plugin", Version: configv3.PluginVersion{Major: 1}, Location: "current-plugin-location"},
+				"outdated-plugin": {Name: "outdated-plugin", Version: configv3.PluginVersion{Major: 1}, Location: "outdated-plugin-location"},
+				"unwanted-plugin": {Name: "unwanted-plugin", Version: configv3.PluginVersion{Major: 1}, Location: "unwanted-plugin-location"},
+			}
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				installed["current-plugin"],
+				installed["outdated-plugin"],
+				installed["unwanted-plugin"],
+			})
+			fakeConfig.GetPluginStub = func(name string) (configv3.Plugin, bool) {
+				installedPlugin, ok := installed[name]
+				return installedPlugin, ok
+			}
+
+			fakeActor.DownloadExecutableBinaryFromURLStub = func(url string, _ string, _ plugin.ProxyReader) (string, error) {
+				return url + "-downloaded", nil
+			}
+			fakeActor.ValidateFileChecksumReturns(true)
+			fakeActor.CreateExecutableCopyStub = func(path string, _ string) (string, error) {
+				return path + "-copy", nil
+			}
+			fakeActor.GetAndValidatePluginStub = func(_ pluginaction.PluginMetadata, _ pluginaction.CommandList, path string) (configv3.Plugin, error) {
+				switch path {
+				case "https://example.com/outdated-plugin-downloaded-copy":
+					return configv3.Plugin{Name: "outdated-plugin", Version: configv3.PluginVersion{Major: 2}}, nil
+				default:
+					return configv3.Plugin{Name: "new-plugin", Version: configv3.PluginVersion{Major: 3}}, nil
+				}
+			}
+		})
+
+		It("resolves the plugin set with the lockfile next to it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ReadPluginSetArgsForCall(0)).To(Equal("some-dir/plugins.yml"))
+			Expect(fakeActor.ReadPluginLockfileArgsForCall(0)).To(Equal("some-dir/plugins.lock"))
+
+			Expect(fakeActor.ResolvePluginSetCallCount()).To(Equal(1))
+			entriesArg, lockedArg, platformArg, updateArg := fakeActor.ResolvePluginSetArgsForCall(0)
+			Expect(entriesArg).To(Equal(entries))
+			Expect(lockedArg).To(Equal(locked))
+			Expect(platformArg).To(Equal("some-platform"))
+			Expect(updateArg).To(BeFalse())
+		})
+
+		It("downloads and verifies every plugin before uninstalling the plugins that are not in the set", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Downloading plugin outdated-plugin 2.0.0..."))
+			Expect(testUI.Out).To(Say("Downloading plugin new-plugin 3.0.0..."))
+			Expect(testUI.Out).To(Say("Uninstalling plugin unwanted-plugin 1.0.0, which is not in some-dir/plugins.yml..."))
+			Expect(testUI.Out).To(Say("Uninstalling plugin outdated-plugin 1.0.0..."))
+
+			var uninstalled []string
+			for i := 0; i < fakeActor.UninstallPluginCallCount(); i++ {
+				_, name := fakeActor.UninstallPluginArgsForCall(i)
+				uninstalled = append(uninstalled, name)
+			}
+			Expect(uninstalled).To(Equal([]string{"unwanted-plugin", "outdated-plugin"}))
+		})
+
+		It("leaves plugins that are already at the resolved version alone", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Plugin current-plugin 1.0.0 is already installed."))
+
+			for i := 0; i < fakeActor.DownloadExecutableBinaryFromURLCallCount(); i++ {
+				url, _, _ := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(i)
+				Expect(url).ToNot(Equal("https://example.com/current-plugin"))
+			}
+		})
+
+		It("updates outdated plugins and installs missing plugins with the locked checksums", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Starting download of plugin binary from repository repo-2..."))
+			Expect(testUI.Out).To(Say("Plugin outdated-plugin successfully updated from 1.0.0 to 2.0.0."))
+			Expect(testUI.Out).To(Say("Plugin new-plugin 3.0.0 successfully installed."))
+
+			url, _, _ := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+			Expect(url).To(Equal("https://example.com/outdated-plugin"))
+
+			Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(2))
+			path, checksum := fakeActor.ValidateFileChecksumArgsForCall(1)
+			Expect(path).To(Equal("https://example.com/new-plugin-downloaded"))
+			Expect(checksum).To(Equal("checksum-3"))
+
+			_, signatureLocation := fakeActor.ValidateFileSignatureArgsForCall(1)
+			Expect(signatureLocation).To(Equal("https://example.com/new-plugin.signature"))
+
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+			installPath, _ := fakeActor.InstallPluginFromPathArgsForCall(1)
+			Expect(installPath).To(Equal("https://example.com/new-plugin-downloaded-copy"))
+		})
+
+		It("writes the lockfile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.WritePluginLockfileCallCount()).To(Equal(1))
+			path, plugins := fakeActor.WritePluginLockfileArgsForCall(0)
+			Expect(path).To(Equal("some-dir/plugins.lock"))
+			Expect(plugins).To(HaveLen(3))
+
+			Expect(testUI.Out).To(Say("Installed plugins match some-dir/plugins.yml. Versions are locked in some-dir/plugins.lock."))
+		})
+
+		Context("when --update is passed", func() {
+			BeforeEach(func() {
+				cmd.Update = true
+			})
+
+			It("resolves the newest versions", func() {
+				_, _, _, updateArg := fakeActor.ResolvePluginSetArgsForCall(0)
+				Expect(updateArg).To(BeTrue())
+			})
+		})
+
+		Context("when verifying a plugin fails", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileChecksumStub = func(path string, _ string) bool {
+					return path != "https://example.com/new-plugin-downloaded"
+				}
+			})
+
+			It("returns the error without uninstalling or installing any plugin", func() {
+				Expect(executeErr).To(MatchError(InvalidChecksumError{}))
+				Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+				Expect(fakeActor.WritePluginLockfileCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when installing a plugin fails", func() {
+			BeforeEach(func() {
+				fakeActor.InstallPluginFromPathStub = func(path string, _ configv3.Plugin) error {
+					if path == "https://example.com/new-plugin-downloaded-copy" {
+						return errors.New("some-error")
+					}
+					return nil
+				}
+			})
+
+			It("reverts the plugins installed before it, restores the plugins that are not in the set and does not write the lockfile", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("Uninstalling plugin outdated-plugin..."))
+				Expect(testUI.Err).To(Say("Restoring plugin outdated-plugin 1.0.0..."))
+				Expect(testUI.Err).To(Say("Restoring plugin unwanted-plugin 1.0.0..."))
+
+				var uninstalled []string
+				for i := 0; i < fakeActor.UninstallPluginCallCount(); i++ {
+					_, name := fakeActor.UninstallPluginArgsForCall(i)
+					uninstalled = append(uninstalled, name)
+				}
+				Expect(uninstalled).To(Equal([]string{"unwanted-plugin", "outdated-plugin", "outdated-plugin"}))
+
+				var restored []string
+				for i := fakeActor.InstallPluginFromPathCallCount() - 2; i < fakeActor.InstallPluginFromPathCallCount(); i++ {
+					restorePath, _ := fakeActor.InstallPluginFromPathArgsForCall(i)
+					restored = append(restored, restorePath)
+				}
+				Expect(restored).To(Equal([]string{"outdated-plugin-location-copy", "unwanted-plugin-location-copy"}))
+
+				Expect(fakeActor.WritePluginLockfileCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when uninstalling a plugin that is not in the set fails", func() {
+			BeforeEach(func() {
+				fakeActor.UninstallPluginReturns(errors.New("some-error"))
+			})
+
+			It("restores the plugin and returns the error without installing the others", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("Restoring plugin unwanted-plugin 1.0.0..."))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				restorePath, _ := fakeActor.InstallPluginFromPathArgsForCall(0)
+				Expect(restorePath).To(Equal("unwanted-plugin-location-copy"))
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"save-target", "targets", "delete-target"},
 		},
 	},
 	{
@@ -19,7 +20,7 @@ var HelpCategoryList = []HelpCategory{
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "scp"},
 		},
 	},
 	{
@@ -129,19 +130,22 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
-			{"curl", "config", "oauth-token", "ssh-code"},
+			{"curl", "config", "oauth-token", "ssh-code", "ssh-config", "ssh-proxy", "completion"},
 		},
 	},
 	{
 		CategoryName: "ADD/REMOVE PLUGIN REPOSITORY:",
 		CommandList: [][]string{
 			{"add-plugin-repo", "remove-plugin-repo", "list-plugin-repos", "repo-plugins"},
+			{"create-plugin-repo-index", "serve-plugin-repo"},
 		},
 	},
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
 			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"update-plugin", "update-plugins", "install-plugins"},
+			{"plugin-keys", "add-plugin-key", "remove-plugin-key"},
 		},
 	},
 }
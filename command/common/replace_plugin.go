@@ -0,0 +1,218 @@
+package common
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type pluginReplaceActor interface {
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signatureLocation string) (string, error)
+}
+
+// pluginReplacer installs a plugin binary from a repository in place of the
+// installed version of the plugin, if there is one. The installed binary is
+// copied aside first so it can be restored if the new one cannot be
+// installed.
+type pluginReplacer struct {
+	UI            command.UI
+	Config        command.Config
+	Actor         pluginReplaceActor
+	ProgressBar   plugin.ProxyReader
+	AllowUnsigned bool
+}
+
+// replacedPlugin is a plugin installed by pluginReplacer, with the version it
+// replaced and the copy of that version's binary, if there was one.
+type replacedPlugin struct {
+	name       string
+	previous   *configv3.Plugin
+	backupPath string
+}
+
+func (r pluginReplacer) replace(installedPlugin *configv3.Plugin, pluginInfo pluginaction.PluginInfo, repositoryName string, rpcService *shared.RPCService, tempPluginDir string) error {
+	executablePath, err := r.download(pluginInfo, repositoryName, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.install(installedPlugin, executablePath, rpcService, tempPluginDir)
+	return err
+}
+
+// download downloads the plugin binary from the repository and verifies its
+// checksum and signature. It returns the path of an executable copy of the
+// binary in tempPluginDir.
+func (r pluginReplacer) download(pluginInfo pluginaction.PluginInfo, repositoryName string, tempPluginDir string) (string, error) {
+	r.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": repositoryName,
+	})
+
+	tempPath, err := r.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, r.ProgressBar)
+	if err != nil {
+		return "", err
+	}
+
+	if !r.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
+		return "", InvalidChecksumError{}
+	}
+
+	signatureLocation := pluginInfo.SignatureURL
+	if signatureLocation == "" {
+		signatureLocation = pluginaction.SignatureLocation(pluginInfo.URL)
+	}
+	err = validatePluginSignature(r.UI, r.Config, r.Actor, r.AllowUnsigned, tempPath, signatureLocation)
+	if err != nil {
+		return "", err
+	}
+
+	return r.Actor.CreateExecutableCopy(tempPath, tempPluginDir)
+}
+
+// install installs the downloaded plugin binary at executablePath in place of
+// the installed version of the plugin, if there is one, and restores the
+// installed version if the new one cannot be installed. The returned
+// replacedPlugin can be passed to revert to undo the installation.
+func (r pluginReplacer) install(installedPlugin *configv3.Plugin, executablePath string, rpcService *shared.RPCService, tempPluginDir string) (replacedPlugin, error) {
+	var (
+		backupPath string
+		err        error
+	)
+	if installedPlugin != nil {
+		r.UI.DisplayTextWithFlavor("Uninstalling plugin {{.PluginName}} {{.PluginVersion}}...", map[string]interface{}{
+			"PluginName":    installedPlugin.Name,
+			"PluginVersion": installedPlugin.Version.String(),
+		})
+
+		backupPath, err = r.uninstall(*installedPlugin, rpcService, tempPluginDir)
+		if err != nil {
+			return replacedPlugin{}, err
+		}
+	}
+
+	newPlugin, err := r.Actor.GetAndValidatePlugin(rpcService, Commands, executablePath)
+	if err != nil {
+		return replacedPlugin{}, r.rollback(installedPlugin, backupPath, err)
+	}
+
+	r.UI.DisplayTextWithFlavor("Installing plugin {{.PluginName}} {{.PluginVersion}}...", map[string]interface{}{
+		"PluginName":    newPlugin.Name,
+		"PluginVersion": newPlugin.Version.String(),
+	})
+
+	err = r.Actor.InstallPluginFromPath(executablePath, newPlugin)
+	if err != nil {
+		return replacedPlugin{}, r.rollback(installedPlugin, backupPath, err)
+	}
+
+	r.UI.DisplayOK()
+	if installedPlugin == nil {
+		r.UI.DisplayText("Plugin {{.Name}} {{.Version}} successfully installed.", map[string]interface{}{
+			"Name":    newPlugin.Name,
+			"Version": newPlugin.Version.String(),
+		})
+	} else {
+		r.UI.DisplayText("Plugin {{.PluginName}} successfully updated from {{.OldVersion}} to {{.NewVersion}}.", map[string]interface{}{
+			"PluginName": newPlugin.Name,
+			"OldVersion": installedPlugin.Version.String(),
+			"NewVersion": newPlugin.Version.String(),
+		})
+	}
+
+	return replacedPlugin{
+		name:       newPlugin.Name,
+		previous:   installedPlugin,
+		backupPath: backupPath,
+	}, nil
+}
+
+// revert uninstalls a plugin installed by install and restores the version it
+// replaced, if there was one.
+func (r pluginReplacer) revert(replaced replacedPlugin, rpcService *shared.RPCService) {
+	r.UI.DisplayWarning("Uninstalling plugin {{.PluginName}}...", map[string]interface{}{
+		"PluginName": replaced.name,
+	})
+
+	err := r.Actor.UninstallPlugin(rpcService, replaced.name)
+	if err != nil {
+		r.UI.DisplayWarning("Plugin {{.PluginName}} could not be uninstalled: {{.Error}}", map[string]interface{}{
+			"PluginName": replaced.name,
+			"Error":      err.Error(),
+		})
+		return
+	}
+
+	if replaced.previous != nil {
+		r.restore(*replaced.previous, replaced.backupPath)
+	}
+}
+
+// uninstall copies the binary of the installed plugin aside and uninstalls
+// the plugin. It returns the path of the copy, from which rollback reinstalls
+// the plugin. If uninstalling fails, the plugin is restored right away.
+func (r pluginReplacer) uninstall(installedPlugin configv3.Plugin, rpcService *shared.RPCService, tempPluginDir string) (string, error) {
+	backupPath, err := r.Actor.CreateExecutableCopy(installedPlugin.Location, tempPluginDir)
+	if err != nil {
+		return "", err
+	}
+
+	err = r.Actor.UninstallPlugin(rpcService, installedPlugin.Name)
+	if err != nil {
+		return "", r.rollback(&installedPlugin, backupPath, err)
+	}
+
+	return backupPath, nil
+}
+
+// rollback reinstalls the previous version of the plugin from its backup, if
+// there was one, and returns the error that caused the replacement to fail.
+func (r pluginReplacer) rollback(installedPlugin *configv3.Plugin, backupPath string, replaceErr error) error {
+	if installedPlugin != nil {
+		r.restore(*installedPlugin, backupPath)
+	}
+
+	return replaceErr
+}
+
+// restore reinstalls the plugin from the copy of its binary at backupPath.
+func (r pluginReplacer) restore(installedPlugin configv3.Plugin, backupPath string) {
+	r.UI.DisplayWarning("Restoring plugin {{.PluginName}} {{.PluginVersion}}...", map[string]interface{}{
+		"PluginName":    installedPlugin.Name,
+		"PluginVersion": installedPlugin.Version.String(),
+	})
+
+	err := r.Actor.InstallPluginFromPath(backupPath, installedPlugin)
+	if err != nil {
+		r.UI.DisplayWarning("Plugin {{.PluginName}} could not be restored: {{.Error}}", map[string]interface{}{
+			"PluginName": installedPlugin.Name,
+			"Error":      err.Error(),
+		})
+	}
+}
+
+func handleReplacePluginError(binaryName string, pluginName string, err error) error {
+	switch e := err.(type) {
+	case pluginaction.PluginNotFoundInAnyRepositoryError:
+		return translatableerror.PluginNotFoundInAnyRepositoryError{
+			BinaryName: binaryName,
+			PluginName: pluginName,
+		}
+	case pluginaction.FetchingPluginInfoFromRepositoryError:
+		return handleFetchingPluginInfoFromRepositoriesError(e)
+	case pluginaction.PluginBinaryRemoveFailedError:
+		return translatableerror.PluginBinaryRemoveFailedError{Err: e.Err}
+	case pluginaction.PluginExecuteError:
+		return translatableerror.PluginBinaryUninstallError{Err: e.Err}
+	default:
+		return shared.HandleError(err)
+	}
+}
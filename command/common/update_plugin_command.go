@@ -0,0 +1,132 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . UpdatePluginActor
+
+type UpdatePluginActor interface {
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signatureLocation string) (string, error)
+}
+
+type UpdatePluginCommand struct {
+	RequiredArgs      flag.PluginName `positional-args:"yes"`
+	SkipSSLValidation bool            `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	AllowUnsigned     bool            `long:"allow-unsigned" description:"Install the new version even if it is not signed with a trusted key"`
+	usage             interface{}     `usage:"CF_NAME update-plugin PLUGIN_NAME [--allow-unsigned]\n\n   Installs the newest version of the plugin available for this platform in the registered repositories.\n   The installed version is restored if the new version cannot be installed.\n\nEXAMPLES:\n   CF_NAME update-plugin plugin-echo"`
+	relatedCommands   interface{}     `related_commands:"install-plugin, plugins, update-plugins"`
+	UI                command.UI
+	Config            command.Config
+	Actor             UpdatePluginActor
+	ProgressBar       plugin.ProxyReader
+}
+
+func (cmd *UpdatePluginCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation, false))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd UpdatePluginCommand) Execute([]string) error {
+	installedPlugin, exist := cmd.Config.GetPluginCaseInsensitive(cmd.RequiredArgs.PluginName)
+	if !exist {
+		return translatableerror.PluginNotFoundError{PluginName: cmd.RequiredArgs.PluginName}
+	}
+
+	if len(cmd.Config.PluginRepositories()) == 0 {
+		return translatableerror.NoPluginRepositoriesError{}
+	}
+
+	err := os.MkdirAll(cmd.Config.PluginHome(), 0700)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	defer os.RemoveAll(tempPluginDir)
+
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.updatePlugin(installedPlugin, rpcService, tempPluginDir)
+	if err != nil {
+		return handleReplacePluginError(cmd.Config.BinaryName(), installedPlugin.Name, err)
+	}
+
+	return nil
+}
+
+// updatePlugin replaces the installed plugin with the newest version for this
+// platform found in the registered repositories.
+func (cmd UpdatePluginCommand) updatePlugin(installedPlugin configv3.Plugin, rpcService *shared.RPCService, tempPluginDir string) error {
+	repos := cmd.Config.PluginRepositories()
+	repoNames := make([]string, len(repos))
+	for i := range repos {
+		repoNames[i] = repos[i].Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepositoryName}} for a newer version of plugin {{.PluginName}}...", map[string]interface{}{
+		"RepositoryName": strings.Join(repoNames, ", "),
+		"PluginName":     installedPlugin.Name,
+	})
+
+	currentPlatform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	pluginInfo, repoList, err := cmd.Actor.GetPluginInfoFromRepositoriesForPlatform(installedPlugin.Name, repos, currentPlatform)
+	if err != nil {
+		return err
+	}
+
+	if !pluginaction.LessThan(installedPlugin.Version.String(), pluginInfo.Version) {
+		cmd.UI.DisplayText("Plugin {{.PluginName}} {{.PluginVersion}} is already up to date.", map[string]interface{}{
+			"PluginName":    installedPlugin.Name,
+			"PluginVersion": installedPlugin.Version.String(),
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("Plugin {{.PluginName}} {{.PluginVersion}} found in: {{.RepositoryName}}", map[string]interface{}{
+		"PluginName":     installedPlugin.Name,
+		"PluginVersion":  pluginInfo.Version,
+		"RepositoryName": strings.Join(repoList, ", "),
+	})
+
+	replacer := pluginReplacer{
+		UI:            cmd.UI,
+		Config:        cmd.Config,
+		Actor:         cmd.Actor,
+		ProgressBar:   cmd.ProgressBar,
+		AllowUnsigned: cmd.AllowUnsigned,
+	}
+	return replacer.replace(&installedPlugin, pluginInfo, repoList[0], rpcService, tempPluginDir)
+}
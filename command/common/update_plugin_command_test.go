@@ -0,0 +1,310 @@
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-plugin command", func() {
+	var (
+		cmd             UpdatePluginCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeUpdatePluginActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+		installedPlugin configv3.Plugin
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeUpdatePluginActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		cmd = UpdatePluginCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+		cmd.RequiredArgs.PluginName = "Some-Plugin"
+
+		tmpDirectorySeed := strconv.Itoa(int(rand.Int63()))
+		pluginHome = fmt.Sprintf("some-pluginhome-%s", tmpDirectorySeed)
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		installedPlugin = configv3.Plugin{
+			Name:     "some-plugin",
+			Location: "some-plugin-location",
+			Version:  configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+		}
+		fakeConfig.GetPluginCaseInsensitiveReturns(installedPlugin, true)
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+			{Name: "repo-1", URL: "https://repo-1.example.com"},
+			{Name: "repo-2", URL: "https://repo-2.example.com"},
+		})
+		fakeActor.GetPlatformStringReturns("some-platform")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the plugin is not installed", func() {
+		BeforeEach(func() {
+			fakeConfig.GetPluginCaseInsensitiveReturns(configv3.Plugin{}, false)
+		})
+
+		It("returns a PluginNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginNotFoundError{PluginName: "Some-Plugin"}))
+			Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when no plugin repositories are registered", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns(nil)
+		})
+
+		It("returns a NoPluginRepositoriesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+		})
+	})
+
+	Context("when the plugin is not in any repository", func() {
+		BeforeEach(func() {
+			fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{}, nil, pluginaction.PluginNotFoundInAnyRepositoryError{PluginName: "some-plugin"})
+		})
+
+		It("returns a PluginNotFoundInAnyRepositoryError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginNotFoundInAnyRepositoryError{
+				BinaryName: "faceman",
+				PluginName: "some-plugin",
+			}))
+
+			Expect(testUI.Out).To(Say("Searching repo-1, repo-2 for a newer version of plugin some-plugin..."))
+
+			Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(1))
+			pluginName, repos, platform := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+			Expect(pluginName).To(Equal("some-plugin"))
+			Expect(repos).To(HaveLen(2))
+			Expect(platform).To(Equal("some-platform"))
+		})
+	})
+
+	Context("when the installed version is the newest", func() {
+		BeforeEach(func() {
+			fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{
+				Name:    "some-plugin",
+				Version: "1.2.3",
+			}, []string{"repo-1"}, nil)
+		})
+
+		It("displays that the plugin is up to date and does not download anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Plugin some-plugin 1.2.3 is already up to date."))
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a newer version is available", func() {
+		BeforeEach(func() {
+			fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{
+				Name:     "some-plugin",
+				Version:  "1.3.0",
+				URL:      "https://example.com/some-plugin",
+				Checksum: "some-checksum",
+			}, []string{"repo-2"}, nil)
+			fakeActor.DownloadExecutableBinaryFromURLReturns("downloaded-path", nil)
+			fakeActor.ValidateFileChecksumReturns(true)
+			fakeActor.ValidateFileSignatureReturns("some-key", nil)
+			fakeActor.CreateExecutableCopyStub = func(path string, _ string) (string, error) {
+				return path + "-copy", nil
+			}
+			fakeActor.GetAndValidatePluginReturns(configv3.Plugin{
+				Name:    "some-plugin",
+				Version: configv3.PluginVersion{Major: 1, Minor: 3},
+			}, nil)
+		})
+
+		It("replaces the installed plugin with the new version", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Plugin some-plugin 1.3.0 found in: repo-2"))
+			Expect(testUI.Out).To(Say("Starting download of plugin binary from repository repo-2..."))
+			Expect(testUI.Out).To(Say("Plugin signature verified with trusted key some-key."))
+			Expect(testUI.Out).To(Say("Uninstalling plugin some-plugin 1.2.3..."))
+			Expect(testUI.Out).To(Say("Installing plugin some-plugin 1.3.0..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Plugin some-plugin successfully updated from 1.2.3 to 1.3.0."))
+
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(1))
+			url, tempDir, proxyReader := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+			Expect(url).To(Equal("https://example.com/some-plugin"))
+			Expect(tempDir).To(ContainSubstring(pluginHome))
+			Expect(proxyReader).To(Equal(fakeProgressBar))
+
+			path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+			Expect(path).To(Equal("downloaded-path"))
+			Expect(checksum).To(Equal("some-checksum"))
+
+			_, signatureLocation := fakeActor.ValidateFileSignatureArgsForCall(0)
+			Expect(signatureLocation).To(Equal("https://example.com/some-plugin.sig"))
+
+			Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(2))
+			newPath, _ := fakeActor.CreateExecutableCopyArgsForCall(0)
+			Expect(newPath).To(Equal("downloaded-path"))
+			backupPath, _ := fakeActor.CreateExecutableCopyArgsForCall(1)
+			Expect(backupPath).To(Equal("some-plugin-location"))
+
+			Expect(fakeActor.UninstallPluginCallCount()).To(Equal(1))
+			_, uninstalledName := fakeActor.UninstallPluginArgsForCall(0)
+			Expect(uninstalledName).To(Equal("some-plugin"))
+
+			_, commandList, validatedPath := fakeActor.GetAndValidatePluginArgsForCall(0)
+			Expect(commandList).To(Equal(Commands))
+			Expect(validatedPath).To(Equal("downloaded-path-copy"))
+
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			installPath, installedConfig := fakeActor.InstallPluginFromPathArgsForCall(0)
+			Expect(installPath).To(Equal("downloaded-path-copy"))
+			Expect(installedConfig.Version).To(Equal(configv3.PluginVersion{Major: 1, Minor: 3}))
+		})
+
+		Context("when the checksum does not match", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileChecksumReturns(false)
+			})
+
+			It("returns an InvalidChecksumError and leaves the installed plugin alone", func() {
+				Expect(executeErr).To(MatchError(InvalidChecksumError{}))
+				Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the new version is not signed", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileSignatureReturns("", pluginaction.PluginSignatureNotFoundError{Location: "some-location"})
+			})
+
+			It("returns a PluginNotSignedError and leaves the installed plugin alone", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginNotSignedError{BinaryName: "faceman"}))
+				Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+			})
+
+			Context("when --allow-unsigned is passed", func() {
+				BeforeEach(func() {
+					cmd.AllowUnsigned = true
+				})
+
+				It("warns and updates the plugin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Plugin is not signed."))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the new version fails validation", func() {
+			BeforeEach(func() {
+				fakeActor.GetAndValidatePluginReturns(configv3.Plugin{}, pluginaction.PluginInvalidError{})
+			})
+
+			It("restores the previous version and returns the error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginInvalidError{}))
+
+				Expect(testUI.Err).To(Say("Restoring plugin some-plugin 1.2.3..."))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				restorePath, restoredPlugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+				Expect(restorePath).To(Equal("some-plugin-location-copy"))
+				Expect(restoredPlugin).To(Equal(installedPlugin))
+			})
+
+			Context("when the previous version cannot be restored", func() {
+				BeforeEach(func() {
+					fakeActor.InstallPluginFromPathReturns(errors.New("disk full"))
+				})
+
+				It("warns and returns the validation error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.PluginInvalidError{}))
+					Expect(testUI.Err).To(Say("Plugin some-plugin could not be restored: disk full"))
+				})
+			})
+		})
+
+		Context("when installing the new version fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("install failed")
+				fakeActor.InstallPluginFromPathStub = func(path string, plugin configv3.Plugin) error {
+					if plugin.Version.String() == "1.3.0" {
+						return expectedErr
+					}
+					return nil
+				}
+			})
+
+			It("restores the previous version and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+				restorePath, restoredPlugin := fakeActor.InstallPluginFromPathArgsForCall(1)
+				Expect(restorePath).To(Equal("some-plugin-location-copy"))
+				Expect(restoredPlugin).To(Equal(installedPlugin))
+			})
+		})
+
+		Context("when the uninstall hook of the old version fails", func() {
+			BeforeEach(func() {
+				fakeActor.UninstallPluginReturns(pluginaction.PluginExecuteError{Err: errors.New("hook failed")})
+			})
+
+			It("restores the previous version and returns a PluginBinaryUninstallError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginBinaryUninstallError{Err: errors.New("hook failed")}))
+
+				Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				restorePath, _ := fakeActor.InstallPluginFromPathArgsForCall(0)
+				Expect(restorePath).To(Equal("some-plugin-location-copy"))
+			})
+		})
+	})
+
+	Context("when the repository version is not semantic", func() {
+		BeforeEach(func() {
+			fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{
+				Name:    "some-plugin",
+				Version: "not-a-version",
+			}, []string{"repo-1"}, nil)
+		})
+
+		It("does not update the plugin", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,127 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type UpdatePluginsCommand struct {
+	All               bool        `long:"all" description:"Update all outdated plugins instead of only listing them"`
+	SkipSSLValidation bool        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	AllowUnsigned     bool        `long:"allow-unsigned" description:"Install new versions even if they are not signed with a trusted key"`
+	usage             interface{} `usage:"CF_NAME update-plugins [--all] [--allow-unsigned]\n\n   Without --all, lists the installed plugins that have a newer version in the registered repositories.\n\nEXAMPLES:\n   CF_NAME update-plugins\n   CF_NAME update-plugins --all"`
+	relatedCommands   interface{} `related_commands:"install-plugin, plugins, update-plugin"`
+	UI                command.UI
+	Config            command.Config
+	Actor             UpdatePluginActor
+	ProgressBar       plugin.ProxyReader
+}
+
+func (cmd *UpdatePluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation, false))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) Execute([]string) error {
+	repos := cmd.Config.PluginRepositories()
+	if len(repos) == 0 {
+		return translatableerror.NoPluginRepositoriesError{}
+	}
+	repoNames := make([]string, len(repos))
+	for i := range repos {
+		repoNames[i] = repos[i].Name
+	}
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepoNames}} for newer versions of installed plugins...",
+		map[string]interface{}{
+			"RepoNames": strings.Join(repoNames, ", "),
+		})
+
+	outdatedPlugins, err := cmd.Actor.GetOutdatedPlugins()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(outdatedPlugins) == 0 {
+		cmd.UI.DisplayText("All plugins are up to date.")
+		return nil
+	}
+
+	if !cmd.All {
+		return cmd.displayOutdatedPlugins(outdatedPlugins)
+	}
+
+	err = os.MkdirAll(cmd.Config.PluginHome(), 0700)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	defer os.RemoveAll(tempPluginDir)
+
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	updater := UpdatePluginCommand{
+		AllowUnsigned: cmd.AllowUnsigned,
+		UI:            cmd.UI,
+		Config:        cmd.Config,
+		Actor:         cmd.Actor,
+		ProgressBar:   cmd.ProgressBar,
+	}
+
+	for _, outdatedPlugin := range outdatedPlugins {
+		installedPlugin, exist := cmd.Config.GetPlugin(outdatedPlugin.Name)
+		if !exist {
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		err = updater.updatePlugin(installedPlugin, rpcService, tempPluginDir)
+		if _, ok := err.(pluginaction.NoCompatibleBinaryError); ok {
+			cmd.UI.DisplayWarning("Plugin {{.PluginName}} has no binary available for your platform. Skipping it.", map[string]interface{}{
+				"PluginName": installedPlugin.Name,
+			})
+			continue
+		} else if err != nil {
+			return handleReplacePluginError(cmd.Config.BinaryName(), installedPlugin.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) displayOutdatedPlugins(outdatedPlugins []pluginaction.OutdatedPlugin) error {
+	table := [][]string{{"plugin", "version", "latest version"}}
+
+	for _, plugin := range outdatedPlugins {
+		table = append(table, []string{plugin.Name, plugin.CurrentVersion, plugin.LatestVersion})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Use '{{.BinaryName}} update-plugins --all' to update all of them, or '{{.BinaryName}} update-plugin PLUGIN_NAME' to update one.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+	})
+
+	return nil
+}
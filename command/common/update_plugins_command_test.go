@@ -0,0 +1,187 @@
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-plugins command", func() {
+	var (
+		cmd        UpdatePluginsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *commonfakes.FakeUpdatePluginActor
+		executeErr error
+		pluginHome string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeUpdatePluginActor)
+
+		cmd = UpdatePluginsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		tmpDirectorySeed := strconv.Itoa(int(rand.Int63()))
+		pluginHome = fmt.Sprintf("some-pluginhome-%s", tmpDirectorySeed)
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+			{Name: "repo-1", URL: "https://repo-1.example.com"},
+		})
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no plugin repositories are registered", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns(nil)
+		})
+
+		It("returns a NoPluginRepositoriesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+			Expect(fakeActor.GetOutdatedPluginsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the outdated plugins fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOutdatedPluginsReturns(nil, pluginaction.GettingPluginRepositoryError{Name: "repo-1", Message: "404"})
+		})
+
+		It("returns a GettingPluginRepositoryError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.GettingPluginRepositoryError{Name: "repo-1", Message: "404"}))
+		})
+	})
+
+	Context("when all plugins are up to date", func() {
+		It("displays that all plugins are up to date", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Searching repo-1 for newer versions of installed plugins..."))
+			Expect(testUI.Out).To(Say("All plugins are up to date."))
+		})
+	})
+
+	Context("when some plugins are outdated", func() {
+		BeforeEach(func() {
+			fakeActor.GetOutdatedPluginsReturns([]pluginaction.OutdatedPlugin{
+				{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
+				{Name: "plugin-2", CurrentVersion: "1.0.0", LatestVersion: "1.1.0"},
+			}, nil)
+		})
+
+		Context("when --all is not passed", func() {
+			It("lists the outdated plugins without updating them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`plugin\s+version\s+latest version`))
+				Expect(testUI.Out).To(Say(`plugin-1\s+1\.0\.0\s+2\.0\.0`))
+				Expect(testUI.Out).To(Say(`plugin-2\s+1\.0\.0\s+1\.1\.0`))
+				Expect(testUI.Out).To(Say("Use 'faceman update-plugins --all' to update all of them, or 'faceman update-plugin PLUGIN_NAME' to update one."))
+
+				Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --all is passed", func() {
+			BeforeEach(func() {
+				cmd.All = true
+
+				fakeConfig.GetPluginStub = func(name string) (configv3.Plugin, bool) {
+					return configv3.Plugin{
+						Name:     name,
+						Location: name + "-location",
+						Version:  configv3.PluginVersion{Major: 1},
+					}, true
+				}
+				fakeActor.GetPluginInfoFromRepositoriesForPlatformStub = func(name string, _ []configv3.PluginRepository, _ string) (pluginaction.PluginInfo, []string, error) {
+					return pluginaction.PluginInfo{
+						Name:    name,
+						Version: "2.0.0",
+						URL:     "https://example.com/" + name,
+					}, []string{"repo-1"}, nil
+				}
+				fakeActor.ValidateFileChecksumReturns(true)
+				fakeActor.GetAndValidatePluginStub = func(_ pluginaction.PluginMetadata, _ pluginaction.CommandList, path string) (configv3.Plugin, error) {
+					return configv3.Plugin{
+						Name:    path,
+						Version: configv3.PluginVersion{Major: 2},
+					}, nil
+				}
+			})
+
+			It("updates every outdated plugin", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(2))
+				name, _, _ := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+				Expect(name).To(Equal("plugin-1"))
+				name, _, _ = fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(1)
+				Expect(name).To(Equal("plugin-2"))
+
+				Expect(fakeActor.UninstallPluginCallCount()).To(Equal(2))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+				Expect(testUI.Out).To(Say("successfully updated from 1.0.0 to 2.0.0"))
+				Expect(testUI.Out).To(Say("successfully updated from 1.0.0 to 2.0.0"))
+			})
+
+			Context("when a plugin has no binary for this platform", func() {
+				BeforeEach(func() {
+					fakeActor.GetPluginInfoFromRepositoriesForPlatformStub = func(name string, _ []configv3.PluginRepository, _ string) (pluginaction.PluginInfo, []string, error) {
+						if name == "plugin-1" {
+							return pluginaction.PluginInfo{}, nil, pluginaction.NoCompatibleBinaryError{}
+						}
+						return pluginaction.PluginInfo{
+							Name:    name,
+							Version: "2.0.0",
+						}, []string{"repo-1"}, nil
+					}
+				})
+
+				It("warns and updates the remaining plugins", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Plugin plugin-1 has no binary available for your platform. Skipping it."))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when updating a plugin fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("download failed")
+					fakeActor.DownloadExecutableBinaryFromURLReturns("", expectedErr)
+				})
+
+				It("stops and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+})
@@ -13,11 +13,14 @@ type Config interface {
 	AccessToken() string
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
+	AddPluginTrustedKey(name string, publicKey string)
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
 	ColorEnabled() configv3.ColorSetting
+	CompletionCache(key string) ([]string, bool)
 	CurrentUser() (configv3.User, error)
+	DeleteTargetProfile(name string) error
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
@@ -27,27 +30,40 @@ type Config interface {
 	HasTargetedSpace() bool
 	Locale() string
 	MinCLIVersion() string
+	OutputFormat() configv3.OutputFormat
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
+	PluginTrustedKeys() []configv3.PluginTrustedKey
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	RemovePluginTrustedKey(name string)
+	SaveTargetProfile(name string, force bool) error
 	SetAccessToken(token string)
+	SetCompletionCache(key string, names []string) error
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetUAAClientCredentials(client string, clientSecret string)
 	SetUAAEndpoint(uaaEndpoint string)
+	SetUAAGrantType(uaaGrantType string)
 	SkipSSLValidation() bool
 	SSHOAuthClient() string
+	SwitchTargetProfile(name string) error
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	TargetProfileName() string
+	TargetProfiles() ([]configv3.TargetProfile, error)
+	TokenRefreshSkew() time.Duration
+	TraceFormat() configv3.TraceFormat
+	UAAGrantType() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
 	UnsetOrganizationInformation()
@@ -9,3 +9,17 @@ type ExtendedCommander interface {
 	flags.Commander
 	Setup(Config, UI) error
 }
+
+// StructuredOutputCommander is an ExtendedCommander that can display its
+// results as JSON or YAML when the global --output flag is provided.
+type StructuredOutputCommander interface {
+	ExtendedCommander
+	SupportsStructuredOutput()
+}
+
+// ConfigReadOnlyCommander is an ExtendedCommander that only reads the config,
+// so the config is not written back after it runs.
+type ConfigReadOnlyCommander interface {
+	ExtendedCommander
+	DoesNotWriteConfig()
+}
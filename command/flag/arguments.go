@@ -8,10 +8,18 @@ type OptionalAppName struct {
 	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
 }
 
+type AppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type BuildpackName struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
 
+type CompletionShell struct {
+	Shell Shell `positional-arg-name:"SHELL" required:"true" description:"The shell to generate the completion script for: bash, zsh or fish"`
+}
+
 type CommandName struct {
 	CommandName string `positional-arg-name:"COMMAND_NAME" description:"The command name"`
 }
@@ -48,6 +56,10 @@ type PluginRepoName struct {
 	PluginRepoName string `positional-arg-name:"REPO_NAME" required:"true" description:"The plugin repo name"`
 }
 
+type PluginKeyName struct {
+	KeyName string `positional-arg-name:"KEY_NAME" required:"true" description:"The name of the key"`
+}
+
 type PluginName struct {
 	PluginName string `positional-arg-name:"PLUGIN_NAME" required:"true" description:"The plugin name"`
 }
@@ -162,6 +174,11 @@ type CopySourceArgs struct {
 	TargetAppName string `positional-arg-name:"TARGET-NAME" required:"true" description:"The new application name"`
 }
 
+type SCPPaths struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The path to copy from, given as APP_NAME[:INDEX]:PATH when it is on an app instance"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The path to copy to, given as APP_NAME[:INDEX]:PATH when it is on an app instance"`
+}
+
 type CreateServiceArgs struct {
 	ServiceOffering string `positional-arg-name:"SERVICE" required:"true" description:"The service offering"`
 	ServicePlan     string `positional-arg-name:"SERVICE_PLAN" required:"true" description:"The service plan that the service instance will use"`
@@ -278,6 +295,15 @@ type AddPluginRepoArgs struct {
 	PluginRepoURL  string `positional-arg-name:"URL" required:"true" description:"The URL to the plugin repo"`
 }
 
+type AddPluginKeyArgs struct {
+	KeyName   string `positional-arg-name:"KEY_NAME" required:"true" description:"The name of the key"`
+	PublicKey string `positional-arg-name:"PUBLIC_KEY" required:"true" description:"The base64 encoded ed25519 public key"`
+}
+
+type PluginRepoDirectory struct {
+	Directory PathWithExistenceCheck `positional-arg-name:"DIR" required:"true" description:"The directory containing the plugin binaries"`
+}
+
 type InstallPluginArgs struct {
 	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, if the plugin exists locally; the URL to the plugin, if the plugin exists online; or the plugin name, if a repo is specified"`
 }
@@ -313,3 +339,11 @@ type ResetSpaceIsolationArgs struct {
 type ResetOrgDefaultIsolationArgs struct {
 	OrgName string `positional-arg-name:"ORG_NAME" required:"true" description:"The organization name"`
 }
+
+type OptionalTargetName struct {
+	TargetName string `positional-arg-name:"NAME" description:"The saved target name"`
+}
+
+type TargetName struct {
+	TargetName string `positional-arg-name:"NAME" required:"true" description:"The saved target name"`
+}
@@ -0,0 +1,23 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// InstanceIndex is the index of an app instance, such as the value of
+// --instance or --app-instance-index.
+type InstanceIndex struct {
+	types.NullInt
+}
+
+func (i *InstanceIndex) UnmarshalFlag(val string) error {
+	err := i.ParseFlagValue(val)
+	if err != nil || i.Value < 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for instance index (expected int >= 0)",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceIndex", func() {
+	var index InstanceIndex
+
+	BeforeEach(func() {
+		index = InstanceIndex{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when an invalid integer is provided", func() {
+			It("returns an error", func() {
+				err := index.UnmarshalFlag("abcdef")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for instance index (expected int >= 0)",
+				}))
+				Expect(index.IsSet).To(BeFalse())
+			})
+		})
+
+		Context("when a negative integer is provided", func() {
+			It("returns an error", func() {
+				err := index.UnmarshalFlag("-1")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for instance index (expected int >= 0)",
+				}))
+			})
+		})
+
+		Context("when a valid integer is provided", func() {
+			It("stores the integer and sets IsSet to true", func() {
+				err := index.UnmarshalFlag("0")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(index).To(Equal(InstanceIndex{NullInt: types.NullInt{Value: 0, IsSet: true}}))
+			})
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogTime is the time given to --since or --until. It accepts a duration
+// before now such as 10m, or an RFC3339 timestamp such as
+// 2017-06-01T15:04:05Z.
+type LogTime struct {
+	Ago       time.Duration
+	Timestamp time.Time
+	IsSet     bool
+}
+
+func (t *LogTime) UnmarshalFlag(val string) error {
+	if ago, err := time.ParseDuration(val); err == nil && ago >= 0 {
+		*t = LogTime{Ago: ago, IsSet: true}
+		return nil
+	}
+
+	if timestamp, err := time.Parse(time.RFC3339, val); err == nil {
+		*t = LogTime{Timestamp: timestamp, IsSet: true}
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: "Time must be a duration such as 10m or a timestamp such as 2017-06-01T15:04:05Z",
+	}
+}
+
+// Time returns the time the flag describes, with durations counted back from
+// now. It returns the zero time when the flag is not set.
+func (t LogTime) Time(now time.Time) time.Time {
+	switch {
+	case !t.IsSet:
+		return time.Time{}
+	case t.Timestamp.IsZero():
+		return now.Add(-t.Ago)
+	default:
+		return t.Timestamp
+	}
+}
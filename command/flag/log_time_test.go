@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogTime", func() {
+	var logTime LogTime
+	now := time.Date(2017, time.June, 1, 15, 4, 5, 0, time.UTC)
+
+	BeforeEach(func() {
+		logTime = LogTime{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("sets the time and IsSet to true",
+			func(input string, expected time.Time) {
+				err := logTime.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logTime.IsSet).To(BeTrue())
+				Expect(logTime.Time(now).Equal(expected)).To(BeTrue())
+			},
+			Entry("when a duration is provided", "10m", now.Add(-10*time.Minute)),
+			Entry("when a zero duration is provided", "0s", now),
+			Entry("when a UTC timestamp is provided", "2017-05-31T10:00:00Z", time.Date(2017, time.May, 31, 10, 0, 0, 0, time.UTC)),
+			Entry("when a timestamp with an offset is provided", "2017-05-31T12:00:00+02:00", time.Date(2017, time.May, 31, 10, 0, 0, 0, time.UTC)),
+		)
+
+		DescribeTable("returns an error and leaves IsSet false",
+			func(input string) {
+				err := logTime.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Time must be a duration such as 10m or a timestamp such as 2017-06-01T15:04:05Z",
+				}))
+				Expect(logTime).To(Equal(LogTime{}))
+			},
+			Entry("when the empty string is provided", ""),
+			Entry("when something other than a duration or timestamp is provided", "yesterday"),
+			Entry("when a negative duration is provided", "-5m"),
+			Entry("when a date without a time is provided", "2017-05-31"),
+		)
+	})
+
+	Describe("Time", func() {
+		It("returns the zero time when the flag is not set", func() {
+			Expect(logTime.Time(now).IsZero()).To(BeTrue())
+		})
+	})
+})
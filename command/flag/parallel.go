@@ -0,0 +1,22 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Parallel is the number of operations run at the same time.
+type Parallel struct {
+	types.NullInt
+}
+
+func (p *Parallel) UnmarshalFlag(val string) error {
+	err := p.ParseFlagValue(val)
+	if err != nil || (p.IsSet && p.Value < 1) {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--parallel' (expected int > 0)",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parallel", func() {
+	var parallel Parallel
+
+	BeforeEach(func() {
+		parallel = Parallel{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when a positive integer is provided", func() {
+			It("stores the integer and sets IsSet to true", func() {
+				err := parallel.UnmarshalFlag("4")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parallel).To(Equal(Parallel{NullInt: types.NullInt{Value: 4, IsSet: true}}))
+			})
+		})
+
+		DescribeTable("returns an error for values that are not positive integers",
+			func(val string) {
+				err := parallel.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--parallel' (expected int > 0)",
+				}))
+			},
+			Entry("not an integer", "abcdef"),
+			Entry("zero", "0"),
+			Entry("negative", "-2"),
+		)
+	})
+})
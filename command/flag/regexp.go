@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"regexp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Regexp is a regular expression given to --grep.
+type Regexp struct {
+	*regexp.Regexp
+}
+
+func (r *Regexp) UnmarshalFlag(val string) error {
+	expression, err := regexp.Compile(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--grep' (expected a regular expression)",
+		}
+	}
+
+	r.Regexp = expression
+	return nil
+}
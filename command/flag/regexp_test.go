@@ -0,0 +1,37 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regexp", func() {
+	var expression Regexp
+
+	BeforeEach(func() {
+		expression = Regexp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when a valid regular expression is provided", func() {
+			It("compiles it", func() {
+				err := expression.UnmarshalFlag(`GET /health \d+`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expression.MatchString("GET /health 200")).To(BeTrue())
+			})
+		})
+
+		Context("when an invalid regular expression is provided", func() {
+			It("returns an error", func() {
+				err := expression.UnmarshalFlag("(unclosed")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--grep' (expected a regular expression)",
+				}))
+				Expect(expression.Regexp).To(BeNil())
+			})
+		})
+	})
+})
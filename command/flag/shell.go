@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Shell struct {
+	Shell string
+}
+
+func (Shell) Complete(prefix string) []flags.Completion {
+	return completions([]string{"bash", "zsh", "fish"}, prefix, false)
+}
+
+func (s *Shell) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case "bash", "zsh", "fish":
+		s.Shell = strings.ToLower(val)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SHELL must be "bash", "zsh" or "fish"`,
+		}
+	}
+
+	return nil
+}
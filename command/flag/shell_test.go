@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Shell", func() {
+	var shell Shell
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := shell.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'bash' when passed 'b'", "b",
+				[]flags.Completion{{Item: "bash"}}),
+			Entry("returns 'fish' when passed 'F'", "F",
+				[]flags.Completion{{Item: "fish"}}),
+			Entry("returns all shells when passed nothing", "",
+				[]flags.Completion{{Item: "bash"}, {Item: "zsh"}, {Item: "fish"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			shell = Shell{}
+		})
+
+		DescribeTable("accepts the supported shells case insensitively",
+			func(input string, expected string) {
+				err := shell.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(shell).To(Equal(Shell{Shell: expected}))
+			},
+			Entry("bash", "bash", "bash"),
+			Entry("zsh", "ZSH", "zsh"),
+			Entry("fish", "Fish", "fish"),
+		)
+
+		It("errors on anything else", func() {
+			err := shell.UnmarshalFlag("powershell")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `SHELL must be "bash", "zsh" or "fish"`,
+			}))
+			Expect(shell.Shell).To(BeEmpty())
+		})
+	})
+})
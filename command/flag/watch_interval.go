@@ -0,0 +1,36 @@
+package flag
+
+import (
+	"strconv"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// WatchInterval is the refresh interval given to --watch. It accepts a number
+// of seconds or a duration such as 500ms or 1m.
+type WatchInterval struct {
+	Value time.Duration
+	IsSet bool
+}
+
+func (w *WatchInterval) UnmarshalFlag(val string) error {
+	interval, err := time.ParseDuration(val)
+	if err != nil {
+		seconds, convErr := strconv.ParseFloat(val, 64)
+		if convErr == nil {
+			interval, err = time.Duration(seconds*float64(time.Second)), nil
+		}
+	}
+
+	if err != nil || interval <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--watch' (expected a number of seconds > 0 or a duration such as 5s)",
+		}
+	}
+
+	w.Value = interval
+	w.IsSet = true
+	return nil
+}
@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WatchInterval", func() {
+	var interval WatchInterval
+
+	BeforeEach(func() {
+		interval = WatchInterval{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("sets the interval and IsSet to true",
+			func(input string, expected time.Duration) {
+				err := interval.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(interval).To(Equal(WatchInterval{Value: expected, IsSet: true}))
+			},
+			Entry("when a number of seconds is provided", "5", 5*time.Second),
+			Entry("when a fractional number of seconds is provided", "0.5", 500*time.Millisecond),
+			Entry("when a duration is provided", "1m30s", 90*time.Second),
+		)
+
+		DescribeTable("returns an error and leaves IsSet false",
+			func(input string) {
+				err := interval.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--watch' (expected a number of seconds > 0 or a duration such as 5s)",
+				}))
+				Expect(interval).To(Equal(WatchInterval{}))
+			},
+			Entry("when the empty string is provided", ""),
+			Entry("when something other than a number or duration is provided", "often"),
+			Entry("when zero is provided", "0"),
+			Entry("when a negative duration is provided", "-5s"),
+		)
+	})
+})
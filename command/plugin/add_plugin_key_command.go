@@ -0,0 +1,42 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+)
+
+//go:generate counterfeiter . AddPluginKeyActor
+
+type AddPluginKeyActor interface {
+	AddPluginTrustedKey(keyName string, publicKey string) error
+}
+
+type AddPluginKeyCommand struct {
+	RequiredArgs    flag.AddPluginKeyArgs `positional-args:"yes"`
+	usage           interface{}           `usage:"CF_NAME add-plugin-key KEY_NAME PUBLIC_KEY\n\nEXAMPLES:\n   CF_NAME add-plugin-key ExampleAuthor TNsqVpXWtS1iN6ZxtgCSrMMgpUF8iPn6wlcdGKTXkjU="`
+	relatedCommands interface{}           `related_commands:"install-plugin, plugin-keys, remove-plugin-key"`
+	UI              command.UI
+	Config          command.Config
+	Actor           AddPluginKeyActor
+}
+
+func (cmd *AddPluginKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd AddPluginKeyCommand) Execute(args []string) error {
+	err := cmd.Actor.AddPluginTrustedKey(cmd.RequiredArgs.KeyName, cmd.RequiredArgs.PublicKey)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Plugins signed with key {{.KeyName}} are now trusted.", map[string]interface{}{
+		"KeyName": cmd.RequiredArgs.KeyName,
+	})
+	return nil
+}
@@ -0,0 +1,69 @@
+package plugin_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("add-plugin-key command", func() {
+	var (
+		cmd        AddPluginKeyCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeAddPluginKeyActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeAddPluginKeyActor)
+		cmd = AddPluginKeyCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+		cmd.RequiredArgs.KeyName = "some-key"
+		cmd.RequiredArgs.PublicKey = "some-public-key"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the public key is invalid", func() {
+		BeforeEach(func() {
+			fakeActor.AddPluginTrustedKeyReturns(pluginaction.PluginKeyInvalidError{Name: "some-key"})
+		})
+
+		It("returns a PluginKeyInvalidError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginKeyInvalidError{Name: "some-key"}))
+		})
+	})
+
+	Context("when a different key is already trusted under the name", func() {
+		BeforeEach(func() {
+			fakeActor.AddPluginTrustedKeyReturns(pluginaction.PluginKeyNameTakenError{Name: "some-key"})
+		})
+
+		It("returns a PluginKeyNameTakenError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginKeyNameTakenError{Name: "some-key"}))
+		})
+	})
+
+	Context("when no errors are encountered", func() {
+		It("adds the key and displays a success message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.AddPluginTrustedKeyCallCount()).To(Equal(1))
+			keyName, publicKey := fakeActor.AddPluginTrustedKeyArgsForCall(0)
+			Expect(keyName).To(Equal("some-key"))
+			Expect(publicKey).To(Equal("some-public-key"))
+
+			Expect(testUI.Out).To(Say("Plugins signed with key some-key are now trusted."))
+		})
+	})
+})
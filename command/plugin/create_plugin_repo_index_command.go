@@ -0,0 +1,121 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+//go:generate counterfeiter . CreatePluginRepoIndexActor
+
+type CreatePluginRepoIndexActor interface {
+	CreatePluginRepositoryIndex(metadata pluginaction.PluginMetadata, dir string, baseURL string, platform string, tempPluginDir string) (plugin.PluginRepository, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	WritePluginRepositoryIndex(dir string, repository plugin.PluginRepository) (string, error)
+}
+
+type CreatePluginRepoIndexCommand struct {
+	RequiredArgs    flag.PluginRepoDirectory `positional-args:"yes"`
+	URL             string                   `long:"url" default:"http://127.0.0.1:8080" description:"URL the directory is served from, which the binary URLs in the index start with"`
+	usage           interface{}              `usage:"CF_NAME create-plugin-repo-index DIR [--url URL]\n\n   Reads the name, version and commands of each plugin binary in DIR and writes a plugin repo index\n   named list to DIR. Binaries of a plugin for different platforms must have the same file name apart\n   from the platform, such as my-plugin_linux_amd64 and my-plugin_windows_amd64.exe, and one of them\n   must be built for this platform.\n\nEXAMPLES:\n   CF_NAME create-plugin-repo-index ./plugins\n   CF_NAME create-plugin-repo-index ./plugins --url https://plugins.example.com"`
+	relatedCommands interface{}              `related_commands:"add-plugin-repo, serve-plugin-repo"`
+	UI              command.UI
+	Config          command.Config
+	Actor           CreatePluginRepoIndexActor
+}
+
+func (cmd *CreatePluginRepoIndexCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd CreatePluginRepoIndexCommand) Execute(args []string) error {
+	dir := string(cmd.RequiredArgs.Directory)
+
+	cmd.UI.DisplayTextWithFlavor("Creating plugin repo index for {{.Path}}...", map[string]interface{}{
+		"Path": dir,
+	})
+
+	err := os.MkdirAll(cmd.Config.PluginHome(), 0700)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	defer os.RemoveAll(tempPluginDir)
+
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	currentPlatform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	repository, err := cmd.Actor.CreatePluginRepositoryIndex(rpcService, dir, cmd.URL, currentPlatform, tempPluginDir)
+	if err != nil {
+		switch e := err.(type) {
+		case pluginaction.PluginBinaryInvalidError:
+			return translatableerror.PluginBinaryInvalidError{Path: e.Path}
+		case pluginaction.PluginBinaryNotInspectableError:
+			return translatableerror.PluginBinaryNotInspectableError{
+				Path:            e.Path,
+				Platform:        e.Platform,
+				CurrentPlatform: currentPlatform,
+			}
+		case pluginaction.DuplicatePluginError:
+			return translatableerror.DuplicatePluginError{PluginName: e.PluginName}
+		case pluginaction.DuplicatePluginBinaryError:
+			return translatableerror.DuplicatePluginBinaryError{
+				PluginName: e.PluginName,
+				Platform:   e.Platform,
+			}
+		default:
+			return shared.HandleError(err)
+		}
+	}
+
+	indexPath, err := cmd.Actor.WritePluginRepositoryIndex(dir, repository)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(repository.Plugins) == 0 {
+		cmd.UI.DisplayText("No plugin binaries found.")
+	} else {
+		table := [][]string{{"plugin", "version", "platforms"}}
+		for _, repositoryPlugin := range repository.Plugins {
+			var platforms []string
+			for _, binary := range repositoryPlugin.Binaries {
+				platforms = append(platforms, binary.Platform)
+			}
+			table = append(table, []string{repositoryPlugin.Name, repositoryPlugin.Version, strings.Join(platforms, ", ")})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, 3)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Plugin repo index written to {{.Path}}. Serve the directory at {{.URL}}, for example with '{{.BinaryName}} serve-plugin-repo {{.Dir}}'.", map[string]interface{}{
+		"Path":       indexPath,
+		"URL":        cmd.URL,
+		"BinaryName": cmd.Config.BinaryName(),
+		"Dir":        dir,
+	})
+
+	return nil
+}
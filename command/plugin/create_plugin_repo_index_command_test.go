@@ -0,0 +1,171 @@
+package plugin_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-plugin-repo-index command", func() {
+	var (
+		cmd        CreatePluginRepoIndexCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeCreatePluginRepoIndexActor
+		executeErr error
+		pluginHome string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeCreatePluginRepoIndexActor)
+
+		cmd = CreatePluginRepoIndexCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+		cmd.RequiredArgs.Directory = "some-dir"
+		cmd.URL = "https://plugins.example.com"
+
+		tmpDirectorySeed := strconv.Itoa(int(rand.Int63()))
+		pluginHome = fmt.Sprintf("some-pluginhome-%s", tmpDirectorySeed)
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor.GetPlatformStringReturns("some-platform")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the index is created", func() {
+		BeforeEach(func() {
+			fakeActor.CreatePluginRepositoryIndexReturns(plugin.PluginRepository{
+				Plugins: []plugin.Plugin{
+					{
+						Name:    "plugin-1",
+						Version: "1.2.3",
+						Binaries: []plugin.PluginBinary{
+							{Platform: "linux64"},
+							{Platform: "osx"},
+						},
+					},
+					{
+						Name:     "plugin-2",
+						Version:  "0.1.0",
+						Binaries: []plugin.PluginBinary{{Platform: "some-platform"}},
+					},
+				},
+			}, nil)
+			fakeActor.WritePluginRepositoryIndexReturns(filepath.Join("some-dir", "list"), nil)
+		})
+
+		It("reads the plugin metadata with binaries for this platform and writes the index", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreatePluginRepositoryIndexCallCount()).To(Equal(1))
+			_, dir, baseURL, platform, tempPluginDir := fakeActor.CreatePluginRepositoryIndexArgsForCall(0)
+			Expect(dir).To(Equal("some-dir"))
+			Expect(baseURL).To(Equal("https://plugins.example.com"))
+			Expect(platform).To(Equal("some-platform"))
+			Expect(filepath.Dir(tempPluginDir)).To(Equal(pluginHome))
+
+			Expect(fakeActor.WritePluginRepositoryIndexCallCount()).To(Equal(1))
+			writeDir, repository := fakeActor.WritePluginRepositoryIndexArgsForCall(0)
+			Expect(writeDir).To(Equal("some-dir"))
+			Expect(repository.Plugins).To(HaveLen(2))
+		})
+
+		It("lists the plugins in the index", func() {
+			Expect(testUI.Out).To(Say("Creating plugin repo index for some-dir..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`plugin\s+version\s+platforms`))
+			Expect(testUI.Out).To(Say(`plugin-1\s+1\.2\.3\s+linux64, osx`))
+			Expect(testUI.Out).To(Say(`plugin-2\s+0\.1\.0\s+some-platform`))
+			Expect(testUI.Out).To(Say(`Plugin repo index written to some-dir/list\. Serve the directory at https://plugins\.example\.com, for example with 'faceman serve-plugin-repo some-dir'\.`))
+		})
+	})
+
+	Context("when the directory contains no plugin binaries", func() {
+		It("writes an empty index", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.WritePluginRepositoryIndexCallCount()).To(Equal(1))
+			Expect(testUI.Out).To(Say("No plugin binaries found."))
+		})
+	})
+
+	Context("when a binary is not a plugin", func() {
+		BeforeEach(func() {
+			fakeActor.CreatePluginRepositoryIndexReturns(plugin.PluginRepository{}, pluginaction.PluginBinaryInvalidError{Path: "some-dir/some-binary"})
+		})
+
+		It("returns a PluginBinaryInvalidError and does not write the index", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginBinaryInvalidError{Path: "some-dir/some-binary"}))
+			Expect(fakeActor.WritePluginRepositoryIndexCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a plugin has no binary for this platform", func() {
+		BeforeEach(func() {
+			fakeActor.CreatePluginRepositoryIndexReturns(plugin.PluginRepository{}, pluginaction.PluginBinaryNotInspectableError{Path: "some-dir/some-binary", Platform: "win64"})
+		})
+
+		It("returns a PluginBinaryNotInspectableError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginBinaryNotInspectableError{
+				Path:            "some-dir/some-binary",
+				Platform:        "win64",
+				CurrentPlatform: "some-platform",
+			}))
+		})
+	})
+
+	Context("when binaries with different names contain the same plugin", func() {
+		BeforeEach(func() {
+			fakeActor.CreatePluginRepositoryIndexReturns(plugin.PluginRepository{}, pluginaction.DuplicatePluginError{PluginName: "some-plugin"})
+		})
+
+		It("returns a DuplicatePluginError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DuplicatePluginError{PluginName: "some-plugin"}))
+		})
+	})
+
+	Context("when a plugin has two binaries for the same platform", func() {
+		BeforeEach(func() {
+			fakeActor.CreatePluginRepositoryIndexReturns(plugin.PluginRepository{}, pluginaction.DuplicatePluginBinaryError{PluginName: "some-plugin", Platform: "linux64"})
+		})
+
+		It("returns a DuplicatePluginBinaryError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DuplicatePluginBinaryError{PluginName: "some-plugin", Platform: "linux64"}))
+		})
+	})
+
+	Context("when writing the index fails", func() {
+		BeforeEach(func() {
+			fakeActor.WritePluginRepositoryIndexReturns("", errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
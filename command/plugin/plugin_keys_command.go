@@ -0,0 +1,44 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . PluginKeysActor
+
+type PluginKeysActor interface {
+	GetPluginTrustedKeys() []configv3.PluginTrustedKey
+}
+
+type PluginKeysCommand struct {
+	usage           interface{} `usage:"CF_NAME plugin-keys"`
+	relatedCommands interface{} `related_commands:"add-plugin-key, install-plugin, remove-plugin-key"`
+	UI              command.UI
+	Config          command.Config
+	Actor           PluginKeysActor
+}
+
+func (cmd *PluginKeysCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd PluginKeysCommand) Execute(args []string) error {
+	keys := cmd.Actor.GetPluginTrustedKeys()
+	if len(keys) == 0 {
+		cmd.UI.DisplayText("No plugin keys are trusted.")
+		return nil
+	}
+
+	table := [][]string{{"name", "public key"}}
+	for _, key := range keys {
+		table = append(table, []string{key.Name, key.PublicKey})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
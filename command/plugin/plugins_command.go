@@ -30,7 +30,7 @@ type PluginsCommand struct {
 func (cmd *PluginsCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
-	pluginClient := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	pluginClient := shared.NewClient(config, ui, cmd.SkipSSLValidation, false)
 	cmd.Actor = pluginaction.NewActor(config, pluginClient)
 	return nil
 }
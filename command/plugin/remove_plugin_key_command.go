@@ -0,0 +1,47 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+//go:generate counterfeiter . RemovePluginKeyActor
+
+type RemovePluginKeyActor interface {
+	RemovePluginTrustedKey(keyName string) error
+}
+
+type RemovePluginKeyCommand struct {
+	RequiredArgs    flag.PluginKeyName `positional-args:"yes"`
+	usage           interface{}        `usage:"CF_NAME remove-plugin-key KEY_NAME\n\nEXAMPLES:\n   CF_NAME remove-plugin-key ExampleAuthor"`
+	relatedCommands interface{}        `related_commands:"plugin-keys"`
+	UI              command.UI
+	Config          command.Config
+	Actor           RemovePluginKeyActor
+}
+
+func (cmd *RemovePluginKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd RemovePluginKeyCommand) Execute(args []string) error {
+	err := cmd.Actor.RemovePluginTrustedKey(cmd.RequiredArgs.KeyName)
+	if _, ok := err.(pluginaction.PluginKeyNotFoundError); ok {
+		return translatableerror.PluginKeyNotFoundError{
+			BinaryName: cmd.Config.BinaryName(),
+			Name:       cmd.RequiredArgs.KeyName,
+		}
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Plugins signed with key {{.KeyName}} are no longer trusted.", map[string]interface{}{
+		"KeyName": cmd.RequiredArgs.KeyName,
+	})
+	return nil
+}
@@ -0,0 +1,72 @@
+package plugin_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("remove-plugin-key command", func() {
+	var (
+		cmd        RemovePluginKeyCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeRemovePluginKeyActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor = new(pluginfakes.FakeRemovePluginKeyActor)
+		cmd = RemovePluginKeyCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+		cmd.RequiredArgs.KeyName = "some-key"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the key is not trusted", func() {
+		BeforeEach(func() {
+			fakeActor.RemovePluginTrustedKeyReturns(pluginaction.PluginKeyNotFoundError{Name: "some-key"})
+		})
+
+		It("returns a PluginKeyNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginKeyNotFoundError{BinaryName: "faceman", Name: "some-key"}))
+		})
+	})
+
+	Context("when removing the key returns an unexpected error", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.RemovePluginTrustedKeyReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when no errors are encountered", func() {
+		It("removes the key and displays a success message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.RemovePluginTrustedKeyCallCount()).To(Equal(1))
+			Expect(fakeActor.RemovePluginTrustedKeyArgsForCall(0)).To(Equal("some-key"))
+
+			Expect(testUI.Out).To(Say("Plugins signed with key some-key are no longer trusted."))
+		})
+	})
+})
@@ -1,23 +1,87 @@
 package plugin
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+//go:generate counterfeiter . RepoPluginsActor
+
+type RepoPluginsActor interface {
+	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
+	GetRepositoryPlugins(repository configv3.PluginRepository) ([]pluginaction.RepositoryPlugin, error)
+}
+
 type RepoPluginsCommand struct {
 	RegisteredRepository string      `short:"r" description:"Name of a registered repository"`
-	usage                interface{} `usage:"CF_NAME repo-plugins [-r REPO_NAME]\n\nEXAMPLES:\n   CF_NAME repo-plugins -r PrivateRepo"`
+	Offline              bool        `long:"offline" description:"List the plugins in the cached repository indexes without connecting to the repositories"`
+	SkipSSLValidation    bool        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	usage                interface{} `usage:"CF_NAME repo-plugins [-r REPO_NAME] [--offline]\n\nEXAMPLES:\n   CF_NAME repo-plugins -r PrivateRepo"`
 	relatedCommands      interface{} `related_commands:"add-plugin-repo, delete-plugin-repo, install-plugin"`
+	UI                   command.UI
+	Config               command.Config
+	Actor                RepoPluginsActor
 }
 
-func (RepoPluginsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RepoPluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation, cmd.Offline))
 	return nil
 }
 
-func (RepoPluginsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RepoPluginsCommand) Execute(args []string) error {
+	var repositories []configv3.PluginRepository
+	if cmd.RegisteredRepository == "" {
+		cmd.UI.DisplayText("Getting plugins from all repositories...")
+		repositories = cmd.Config.PluginRepositories()
+	} else {
+		repository, err := cmd.Actor.GetPluginRepository(cmd.RegisteredRepository)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Getting plugins from repository {{.RepositoryName}}...", map[string]interface{}{
+			"RepositoryName": repository.Name,
+		})
+		repositories = []configv3.PluginRepository{repository}
+	}
+
+	for _, repository := range repositories {
+		if repository.URL == "http://plugins.cloudfoundry.org" {
+			repository.URL = configv3.DefaultPluginRepoURL
+		}
+
+		plugins, err := cmd.Actor.GetRepositoryPlugins(repository)
+		if err != nil {
+			// When listing all repositories, a repository that cannot be read
+			// does not stop the others from being listed.
+			repositoryErr, ok := err.(pluginaction.GettingPluginRepositoryError)
+			if !ok || cmd.RegisteredRepository != "" {
+				return shared.HandleError(err)
+			}
+
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayWarning("Could not get plugin repository '{{.RepositoryName}}'\n{{.ErrorMessage}}", map[string]interface{}{
+				"RepositoryName": repositoryErr.Name,
+				"ErrorMessage":   repositoryErr.Message,
+			})
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithBold("Repository: {{.RepositoryName}}", map[string]interface{}{
+			"RepositoryName": repository.Name,
+		})
+
+		table := [][]string{{"name", "version", "description"}}
+		for _, repositoryPlugin := range plugins {
+			table = append(table, []string{repositoryPlugin.Name, repositoryPlugin.Version, repositoryPlugin.Description})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, 3)
+	}
+
 	return nil
 }
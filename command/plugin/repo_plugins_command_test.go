@@ -0,0 +1,133 @@
+package plugin_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("repo-plugins command", func() {
+	var (
+		cmd        RepoPluginsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeRepoPluginsActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeRepoPluginsActor)
+		cmd = RepoPluginsCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+			{Name: "repo-1", URL: "https://repo-1.example.com"},
+			{Name: "repo-2", URL: "https://repo-2.example.com"},
+		})
+		fakeActor.GetRepositoryPluginsStub = func(repository configv3.PluginRepository) ([]pluginaction.RepositoryPlugin, error) {
+			switch repository.Name {
+			case "repo-1":
+				return []pluginaction.RepositoryPlugin{
+					{Name: "plugin-1", Version: "1.2.3", Description: "some-description"},
+				}, nil
+			default:
+				return nil, pluginaction.GettingPluginRepositoryError{Name: repository.Name, Message: "some-error"}
+			}
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no repository is specified", func() {
+		It("lists the plugins in all repositories and warns about the ones that cannot be read", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting plugins from all repositories..."))
+			Expect(testUI.Out).To(Say("Repository: repo-1"))
+			Expect(testUI.Out).To(Say(`name\s+version\s+description`))
+			Expect(testUI.Out).To(Say(`plugin-1\s+1\.2\.3\s+some-description`))
+			Expect(testUI.Out).ToNot(Say("Repository: repo-2"))
+
+			Expect(testUI.Err).To(Say("Could not get plugin repository 'repo-2'"))
+			Expect(testUI.Err).To(Say("some-error"))
+
+			Expect(fakeActor.GetRepositoryPluginsCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when a repository still has the old plain HTTP URL of the default repository", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "CF-Community", URL: "http://plugins.cloudfoundry.org"},
+			})
+		})
+
+		It("gets the plugins over HTTPS", func() {
+			Expect(fakeActor.GetRepositoryPluginsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetRepositoryPluginsArgsForCall(0)).To(Equal(configv3.PluginRepository{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"}))
+		})
+	})
+
+	Context("when a repository is specified", func() {
+		BeforeEach(func() {
+			cmd.RegisteredRepository = "REPO-1"
+			fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: "repo-1", URL: "https://repo-1.example.com"}, nil)
+		})
+
+		It("only lists the plugins in that repository", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetPluginRepositoryArgsForCall(0)).To(Equal("REPO-1"))
+			Expect(fakeActor.GetRepositoryPluginsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetRepositoryPluginsArgsForCall(0)).To(Equal(configv3.PluginRepository{Name: "repo-1", URL: "https://repo-1.example.com"}))
+
+			Expect(testUI.Out).To(Say("Getting plugins from repository repo-1..."))
+			Expect(testUI.Out).To(Say("Repository: repo-1"))
+			Expect(testUI.Out).To(Say(`plugin-1\s+1\.2\.3\s+some-description`))
+		})
+
+		Context("when the repository cannot be read", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: "repo-2"}, nil)
+			})
+
+			It("returns a GettingPluginRepositoryError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.GettingPluginRepositoryError{Name: "repo-2", Message: "some-error"}))
+			})
+		})
+
+		Context("when the repository is not registered", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{}, pluginaction.RepositoryNotRegisteredError{Name: "REPO-1"})
+			})
+
+			It("returns a RepositoryNotRegisteredError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RepositoryNotRegisteredError{Name: "REPO-1"}))
+				Expect(fakeActor.GetRepositoryPluginsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when getting the plugins of a repository returns an unexpected error", func() {
+		BeforeEach(func() {
+			fakeActor.GetRepositoryPluginsStub = nil
+			fakeActor.GetRepositoryPluginsReturns(nil, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
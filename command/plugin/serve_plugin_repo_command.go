@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+)
+
+//go:generate counterfeiter . ServePluginRepoActor
+
+type ServePluginRepoActor interface {
+	ServePluginRepository(dir string, listener net.Listener) error
+}
+
+type ServePluginRepoCommand struct {
+	RequiredArgs    flag.PluginRepoDirectory `positional-args:"yes"`
+	Port            int                      `long:"port" default:"8080" description:"Port to listen on"`
+	usage           interface{}              `usage:"CF_NAME serve-plugin-repo DIR [--port PORT]\n\n   Serves the plugin repo in DIR on 127.0.0.1 until interrupted. Create its index with\n   create-plugin-repo-index first.\n\nEXAMPLES:\n   CF_NAME serve-plugin-repo ./plugins\n   CF_NAME serve-plugin-repo ./plugins --port 9000"`
+	relatedCommands interface{}              `related_commands:"add-plugin-repo, create-plugin-repo-index"`
+	UI              command.UI
+	Config          command.Config
+	Actor           ServePluginRepoActor
+}
+
+func (cmd *ServePluginRepoCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd ServePluginRepoCommand) Execute(args []string) error {
+	dir := string(cmd.RequiredArgs.Directory)
+
+	_, err := os.Stat(filepath.Join(dir, pluginaction.PluginRepositoryIndexFilename))
+	if os.IsNotExist(err) {
+		cmd.UI.DisplayWarning("No plugin repo index found in {{.Path}}. Create it with '{{.BinaryName}} create-plugin-repo-index {{.Path}}'.", map[string]interface{}{
+			"Path":       dir,
+			"BinaryName": cmd.Config.BinaryName(),
+		})
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", cmd.Port))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	defer listener.Close()
+
+	url := fmt.Sprintf("http://%s", listener.Addr())
+	cmd.UI.DisplayTextWithFlavor("Serving plugin repo {{.Path}} at {{.URL}}...", map[string]interface{}{
+		"Path": dir,
+		"URL":  url,
+	})
+	cmd.UI.DisplayText("Add it with '{{.BinaryName}} add-plugin-repo REPO_NAME {{.URL}}'. Press Ctrl+C to stop.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"URL":        url,
+	})
+
+	err = cmd.Actor.ServePluginRepository(dir, listener)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return nil
+}
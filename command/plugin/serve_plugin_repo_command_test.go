@@ -0,0 +1,122 @@
+package plugin_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("serve-plugin-repo command", func() {
+	var (
+		cmd        ServePluginRepoCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeServePluginRepoActor
+		executeErr error
+		repoDir    string
+		listenAddr string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor = new(pluginfakes.FakeServePluginRepoActor)
+		fakeActor.ServePluginRepositoryStub = func(_ string, listener net.Listener) error {
+			listenAddr = listener.Addr().String()
+			return nil
+		}
+
+		var err error
+		repoDir, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd = ServePluginRepoCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+		cmd.RequiredArgs.Directory = flag.PathWithExistenceCheck(repoDir)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(repoDir)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the directory has an index", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(filepath.Join(repoDir, "list"), []byte("{}"), 0600)).To(Succeed())
+		})
+
+		It("serves the directory on localhost", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ServePluginRepositoryCallCount()).To(Equal(1))
+			dir, _ := fakeActor.ServePluginRepositoryArgsForCall(0)
+			Expect(dir).To(Equal(repoDir))
+
+			host, _, err := net.SplitHostPort(listenAddr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(host).To(Equal("127.0.0.1"))
+
+			Expect(testUI.Out).To(Say(`Serving plugin repo %s at http://%s\.\.\.`, regexp.QuoteMeta(repoDir), listenAddr))
+			Expect(testUI.Out).To(Say(`Add it with 'faceman add-plugin-repo REPO_NAME http://%s'\. Press Ctrl\+C to stop\.`, listenAddr))
+			Expect(testUI.Err).ToNot(Say("No plugin repo index found"))
+		})
+	})
+
+	Context("when the directory has no index", func() {
+		It("warns and serves the directory anyway", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say(`No plugin repo index found in %s\. Create it with 'faceman create-plugin-repo-index %s'\.`, regexp.QuoteMeta(repoDir), regexp.QuoteMeta(repoDir)))
+			Expect(fakeActor.ServePluginRepositoryCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the port is in use", func() {
+		var listener net.Listener
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			cmd.Port = listener.Addr().(*net.TCPAddr).Port
+		})
+
+		AfterEach(func() {
+			listener.Close()
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(fakeActor.ServePluginRepositoryCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when serving fails", func() {
+		BeforeEach(func() {
+			fakeActor.ServePluginRepositoryReturns(errors.New("some-error"))
+			fakeActor.ServePluginRepositoryStub = nil
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
@@ -12,6 +12,8 @@ func HandleError(err error) error {
 	switch e := err.(type) {
 	case *json.SyntaxError:
 		return translatableerror.JSONSyntaxError{Err: e}
+	case pluginerror.NotCachedError:
+		return translatableerror.PluginNotCachedError{URL: e.URL}
 	case pluginerror.RawHTTPStatusError:
 		return translatableerror.DownloadPluginHTTPError{Message: e.Status}
 	case pluginerror.SSLValidationHostnameError:
@@ -34,6 +36,10 @@ func HandleError(err error) error {
 		}
 	case pluginaction.PluginInvalidError:
 		return translatableerror.PluginInvalidError{Err: e.Err}
+	case pluginaction.PluginKeyInvalidError:
+		return translatableerror.PluginKeyInvalidError{Name: e.Name}
+	case pluginaction.PluginKeyNameTakenError:
+		return translatableerror.PluginKeyNameTakenError{Name: e.Name}
 	case pluginaction.PluginNotFoundError:
 		return translatableerror.PluginNotFoundError{PluginName: e.PluginName}
 	case pluginaction.RepositoryNameTakenError:
@@ -29,6 +29,10 @@ var _ = Describe("HandleError", func() {
 			translatableerror.JSONSyntaxError{Err: jsonErr},
 		),
 
+		Entry("pluginerror.NotCachedError -> PluginNotCachedError",
+			pluginerror.NotCachedError{URL: "some-url"},
+			translatableerror.PluginNotCachedError{URL: "some-url"},
+		),
 		Entry("pluginerror.RawHTTPStatusError -> DownloadPluginHTTPError",
 			pluginerror.RawHTTPStatusError{Status: "some status"},
 			translatableerror.DownloadPluginHTTPError{Message: "some status"},
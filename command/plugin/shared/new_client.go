@@ -1,14 +1,18 @@
 package shared
 
 import (
+	"path/filepath"
+	"time"
+
 	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/api/plugin/wrapper"
 	"code.cloudfoundry.org/cli/command"
 )
 
-// NewClients creates a new V2 Cloud Controller client and UAA client using the
-// passed in config.
-func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *plugin.Client {
+// NewClient creates a new plugin client using the passed in config.
+// Repository indexes and plugin binaries are cached in the plugin home; when
+// offline, they are only read from the cache.
+func NewClient(config command.Config, ui command.UI, skipSSLValidation bool, offline bool) *plugin.Client {
 
 	verbose, location := config.Verbose()
 
@@ -20,13 +24,35 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 	})
 
 	if verbose {
-		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+		pluginClient.WrapConnection(wrapper.NewRequestLogger(command.RequestLoggerTerminalDisplay(config, ui)))
 	}
 	if location != nil {
-		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		pluginClient.WrapConnection(wrapper.NewRequestLogger(command.RequestLoggerFileWriter(config, ui, location)))
 	}
 
 	pluginClient.WrapConnection(wrapper.NewRetryRequest(2))
+	pluginClient.WrapConnection(wrapper.NewCacheRequest(
+		PluginCacheDir(config),
+		PluginCacheSize,
+		offline,
+		func(url string, cachedAt time.Time, err error) {
+			ui.DisplayWarning("Could not reach {{.URL}}, using the copy cached at {{.CachedAt}}: {{.Error}}", map[string]interface{}{
+				"URL":      url,
+				"CachedAt": cachedAt.Format(time.RFC3339),
+				"Error":    err.Error(),
+			})
+		},
+	))
 
 	return pluginClient
 }
+
+// PluginCacheSize is the number of bytes of repository indexes and plugin
+// binaries that are kept in the plugin cache.
+const PluginCacheSize = 512 * 1024 * 1024
+
+// PluginCacheDir returns the directory that repository indexes and plugin
+// binaries are cached in.
+func PluginCacheDir(config command.Config) string {
+	return filepath.Join(config.PluginHome(), "cache")
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// RequestLoggerOutput is implemented by every request logger the UI provides.
+type RequestLoggerOutput interface {
+	DisplayAttempt(attempt int) error
+	DisplayBody(body []byte) error
+	DisplayBodySize(size int64) error
+	DisplayDump(dump string) error
+	DisplayElapsedTime(elapsed time.Duration) error
+	DisplayHeader(name string, value string) error
+	DisplayHost(name string) error
+	DisplayJSONBody(body []byte) error
+	DisplayMessage(msg string) error
+	DisplayRequestHeader(method string, uri string, httpProtocol string) error
+	DisplayResponseHeader(httpProtocol string, status string) error
+	DisplayType(name string, requestDate time.Time) error
+	DisplayURL(url string) error
+	HandleInternalError(err error)
+	Start() error
+	Stop() error
+}
+
+// RequestLoggerTerminalDisplay returns the terminal request logger for the
+// configured trace format.
+func RequestLoggerTerminalDisplay(config Config, ui UI) RequestLoggerOutput {
+	if config.TraceFormat() == configv3.TraceFormatJSON {
+		return ui.RequestLoggerJSONTerminalDisplay()
+	}
+	return ui.RequestLoggerTerminalDisplay()
+}
+
+// RequestLoggerFileWriter returns the file request logger for the configured
+// trace format.
+func RequestLoggerFileWriter(config Config, ui UI, filePaths []string) RequestLoggerOutput {
+	if config.TraceFormat() == configv3.TraceFormatJSON {
+		return ui.RequestLoggerJSONFileWriter(filePaths)
+	}
+	return ui.RequestLoggerFileWriter(filePaths)
+}
@@ -0,0 +1,46 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Request Logger", func() {
+	var (
+		fakeConfig *commandfakes.FakeConfig
+		testUI     *ui.UI
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+	})
+
+	Context("when the trace format is text", func() {
+		BeforeEach(func() {
+			fakeConfig.TraceFormatReturns(configv3.TraceFormatText)
+		})
+
+		It("returns the human readable request loggers", func() {
+			Expect(RequestLoggerTerminalDisplay(fakeConfig, testUI)).To(BeAssignableToTypeOf(&ui.RequestLoggerTerminalDisplay{}))
+			Expect(RequestLoggerFileWriter(fakeConfig, testUI, []string{"some-file"})).To(BeAssignableToTypeOf(&ui.RequestLoggerFileWriter{}))
+		})
+	})
+
+	Context("when the trace format is json", func() {
+		BeforeEach(func() {
+			fakeConfig.TraceFormatReturns(configv3.TraceFormatJSON)
+		})
+
+		It("returns the JSON request loggers", func() {
+			Expect(RequestLoggerTerminalDisplay(fakeConfig, testUI)).To(BeAssignableToTypeOf(&ui.RequestLoggerJSONWriter{}))
+			Expect(RequestLoggerFileWriter(fakeConfig, testUI, []string{"some-file"})).To(BeAssignableToTypeOf(&ui.RequestLoggerJSONWriter{}))
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package translatableerror
+
+// DuplicatePluginBinaryError is returned when a plugin repository directory
+// contains more than one binary of a plugin for the same platform.
+type DuplicatePluginBinaryError struct {
+	PluginName string
+	Platform   string
+}
+
+func (DuplicatePluginBinaryError) Error() string {
+	return "Plugin {{.PluginName}} has more than one binary for {{.Platform}}."
+}
+
+func (e DuplicatePluginBinaryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Platform":   e.Platform,
+	})
+}
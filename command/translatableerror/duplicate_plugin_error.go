@@ -0,0 +1,17 @@
+package translatableerror
+
+// DuplicatePluginError is returned when binaries with different names in a
+// plugin repository directory contain the same plugin.
+type DuplicatePluginError struct {
+	PluginName string
+}
+
+func (DuplicatePluginError) Error() string {
+	return "Plugin {{.PluginName}} is provided by more than one set of binaries."
+}
+
+func (e DuplicatePluginError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+	})
+}
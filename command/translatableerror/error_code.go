@@ -0,0 +1,267 @@
+package translatableerror
+
+// ExitStatus is the status the CLI exits with when a command fails. Each
+// category of error exits with its own status, so that scripts can tell them
+// apart without matching the translated error message.
+type ExitStatus int
+
+const (
+	// ExitStatusFailure is returned for every error that is not in one of the
+	// categories below, including incorrect usage.
+	ExitStatusFailure ExitStatus = 1
+	// ExitStatusNotLoggedIn is returned when the user is not logged in or
+	// their credentials are rejected.
+	ExitStatusNotLoggedIn ExitStatus = 2
+	// ExitStatusNotTargeted is returned when no API, organization or space is
+	// targeted.
+	ExitStatusNotTargeted ExitStatus = 3
+	// ExitStatusNotFound is returned when a resource named on the command line
+	// does not exist.
+	ExitStatusNotFound ExitStatus = 4
+	// ExitStatusStagingFailed is returned when an application fails to stage,
+	// upload or start, or a task or job fails.
+	ExitStatusStagingFailed ExitStatus = 5
+	// ExitStatusAPIUnreachable is returned when the API or one of its
+	// endpoints cannot be reached or its certificate cannot be verified.
+	ExitStatusAPIUnreachable ExitStatus = 6
+	// ExitStatusTimeout is returned when staging, starting or a job does not
+	// finish in time.
+	ExitStatusTimeout ExitStatus = 7
+)
+
+// ExitStatuses lists the exit statuses in order with the categories of error
+// they are returned for, as displayed by 'cf help -a'.
+var ExitStatuses = []struct {
+	ExitStatus  ExitStatus
+	Description string
+}{
+	{ExitStatusFailure, "Command failed or was used incorrectly"},
+	{ExitStatusNotLoggedIn, "Not logged in, or the credentials were rejected"},
+	{ExitStatusNotTargeted, "No API, org or space targeted"},
+	{ExitStatusNotFound, "App, org, space, service or other resource not found"},
+	{ExitStatusStagingFailed, "App failed to stage, upload or start, or a task or job failed"},
+	{ExitStatusAPIUnreachable, "API unreachable or its SSL certificate is invalid"},
+	{ExitStatusTimeout, "Staging, starting or a job timed out"},
+}
+
+// ErrorCode returns the stable code that identifies the type of err. Unlike
+// the error message the code is never translated and does not change between
+// releases. It returns "" for errors that are not translatable errors.
+func ErrorCode(err error) string {
+	code, _ := errorCodeAndExitStatus(err)
+	return code
+}
+
+// ExitStatusFor returns the status the CLI exits with when a command fails
+// with err.
+func ExitStatusFor(err error) ExitStatus {
+	_, status := errorCodeAndExitStatus(err)
+	return status
+}
+
+func errorCodeAndExitStatus(err error) (string, ExitStatus) {
+	switch err.(type) {
+	case BadCredentialsError:
+		return "BadCredentials", ExitStatusNotLoggedIn
+	case InvalidRefreshTokenError:
+		return "InvalidRefreshToken", ExitStatusNotLoggedIn
+	case NotLoggedInError:
+		return "NotLoggedIn", ExitStatusNotLoggedIn
+	case PasswordGrantTypeLogoutRequiredError:
+		return "PasswordGrantTypeLogoutRequired", ExitStatusNotLoggedIn
+
+	case NoAPISetError:
+		return "NoAPISet", ExitStatusNotTargeted
+	case NoOrganizationTargetedError:
+		return "NoOrganizationTargeted", ExitStatusNotTargeted
+	case NoSpaceTargetedError:
+		return "NoSpaceTargeted", ExitStatusNotTargeted
+
+	case AppNotFoundInManifestError:
+		return "AppNotFoundInManifest", ExitStatusNotFound
+	case ApplicationNotFoundError:
+		return "ApplicationNotFound", ExitStatusNotFound
+	case FileNotFoundError:
+		return "FileNotFound", ExitStatusNotFound
+	case IsolationSegmentNotFoundError:
+		return "IsolationSegmentNotFound", ExitStatusNotFound
+	case NoRunningInstancesError:
+		return "NoRunningInstances", ExitStatusNotFound
+	case OrganizationNotFoundError:
+		return "OrganizationNotFound", ExitStatusNotFound
+	case PluginKeyNotFoundError:
+		return "PluginKeyNotFound", ExitStatusNotFound
+	case PluginNotFoundError:
+		return "PluginNotFound", ExitStatusNotFound
+	case PluginNotFoundInAnyRepositoryError:
+		return "PluginNotFoundInAnyRepository", ExitStatusNotFound
+	case PluginNotFoundInRepositoryError:
+		return "PluginNotFoundInRepository", ExitStatusNotFound
+	case PluginNotFoundOnDiskOrInAnyRepositoryError:
+		return "PluginNotFoundOnDiskOrInAnyRepository", ExitStatusNotFound
+	case ProcessInstanceNotFoundError:
+		return "ProcessInstanceNotFound", ExitStatusNotFound
+	case ProcessNotFoundError:
+		return "ProcessNotFound", ExitStatusNotFound
+	case RepositoryNotRegisteredError:
+		return "RepositoryNotRegistered", ExitStatusNotFound
+	case SecurityGroupNotFoundError:
+		return "SecurityGroupNotFound", ExitStatusNotFound
+	case ServiceInstanceNotFoundError:
+		return "ServiceInstanceNotFound", ExitStatusNotFound
+	case SpaceNotFoundError:
+		return "SpaceNotFound", ExitStatusNotFound
+	case StackNotFoundError:
+		return "StackNotFound", ExitStatusNotFound
+	case TargetProfileNotFoundError:
+		return "TargetProfileNotFound", ExitStatusNotFound
+
+	case JobFailedError:
+		return "JobFailed", ExitStatusStagingFailed
+	case RunTaskError:
+		return "RunTaskFailed", ExitStatusStagingFailed
+	case StagingFailedError:
+		return "StagingFailed", ExitStatusStagingFailed
+	case StagingFailedNoAppDetectedError:
+		return "StagingFailedNoAppDetected", ExitStatusStagingFailed
+	case UnsuccessfulStartError:
+		return "UnsuccessfulStart", ExitStatusStagingFailed
+	case UploadFailedError:
+		return "UploadFailed", ExitStatusStagingFailed
+
+	case APINotFoundError:
+		return "APINotFound", ExitStatusAPIUnreachable
+	case APIRequestError:
+		return "APIRequestFailed", ExitStatusAPIUnreachable
+	case AuthorizationEndpointNotFoundError:
+		return "AuthorizationEndpointNotFound", ExitStatusAPIUnreachable
+	case InvalidSSLCertError:
+		return "InvalidSSLCert", ExitStatusAPIUnreachable
+	case SSHEndpointNotFoundError:
+		return "SSHEndpointNotFound", ExitStatusAPIUnreachable
+	case SSLCertError:
+		return "SSLCertInvalidHostname", ExitStatusAPIUnreachable
+	case UAAEndpointNotFoundError:
+		return "UAAEndpointNotFound", ExitStatusAPIUnreachable
+
+	case JobTimeoutError:
+		return "JobTimeout", ExitStatusTimeout
+	case StagingTimeoutError:
+		return "StagingTimeout", ExitStatusTimeout
+	case StartupTimeoutError:
+		return "StartupTimeout", ExitStatusTimeout
+
+	case AddPluginRepositoryError:
+		return "AddPluginRepositoryFailed", ExitStatusFailure
+	case ArgumentCombinationError:
+		return "ArgumentCombination", ExitStatusFailure
+	case AssignDropletError:
+		return "AssignDropletFailed", ExitStatusFailure
+	case CommandLineArgsWithMultipleAppsError:
+		return "CommandLineArgsWithMultipleApps", ExitStatusFailure
+	case ConflictingBuildpacksError:
+		return "ConflictingBuildpacks", ExitStatusFailure
+	case DockerPasswordNotSetError:
+		return "DockerPasswordNotSet", ExitStatusFailure
+	case DownloadPluginHTTPError:
+		return "DownloadPluginFailed", ExitStatusFailure
+	case DuplicatePluginBinaryError:
+		return "DuplicatePluginBinary", ExitStatusFailure
+	case DuplicatePluginError:
+		return "DuplicatePlugin", ExitStatusFailure
+	case EmptyConfigError:
+		return "EmptyConfig", ExitStatusFailure
+	case EmptyDirectoryError:
+		return "EmptyDirectory", ExitStatusFailure
+	case FetchingPluginInfoFromRepositoriesError:
+		return "FetchingPluginInfoFromRepositoriesFailed", ExitStatusFailure
+	case FileChangedError:
+		return "FileChanged", ExitStatusFailure
+	case GettingPluginRepositoryError:
+		return "GettingPluginRepositoryFailed", ExitStatusFailure
+	case HealthCheckTypeUnsupportedError:
+		return "HealthCheckTypeUnsupported", ExitStatusFailure
+	case HTTPHealthCheckInvalidError:
+		return "HTTPHealthCheckInvalid", ExitStatusFailure
+	case InvalidLogFormatError:
+		return "InvalidLogFormat", ExitStatusFailure
+	case InvalidTableFilterError:
+		return "InvalidTableFilter", ExitStatusFailure
+	case InvalidTableSortError:
+		return "InvalidTableSort", ExitStatusFailure
+	case InvalidTargetProfileNameError:
+		return "InvalidTargetProfileName", ExitStatusFailure
+	case JSONSyntaxError:
+		return "JSONSyntax", ExitStatusFailure
+	case LifecycleMinimumAPIVersionNotMetError:
+		return "LifecycleMinimumAPIVersionNotMet", ExitStatusFailure
+	case LockedPluginVersionUnavailableError:
+		return "LockedPluginVersionUnavailable", ExitStatusFailure
+	case MinimumAPIVersionNotMetError:
+		return "MinimumAPIVersionNotMet", ExitStatusFailure
+	case NoCompatibleBinaryError:
+		return "NoCompatibleBinary", ExitStatusFailure
+	case NoDomainsFoundError:
+		return "NoDomainsFound", ExitStatusFailure
+	case NoPluginRepositoriesError:
+		return "NoPluginRepositories", ExitStatusFailure
+	case ParseArgumentError:
+		return "ParseArgument", ExitStatusFailure
+	case PluginAlreadyInstalledError:
+		return "PluginAlreadyInstalled", ExitStatusFailure
+	case PluginBinaryInvalidError:
+		return "PluginBinaryInvalid", ExitStatusFailure
+	case PluginBinaryNotInspectableError:
+		return "PluginBinaryNotInspectable", ExitStatusFailure
+	case PluginBinaryRemoveFailedError:
+		return "PluginBinaryRemoveFailed", ExitStatusFailure
+	case PluginBinaryUninstallError:
+		return "PluginBinaryUninstallFailed", ExitStatusFailure
+	case PluginCommandsConflictError:
+		return "PluginCommandsConflict", ExitStatusFailure
+	case PluginInvalidError:
+		return "PluginInvalid", ExitStatusFailure
+	case PluginKeyInvalidError:
+		return "PluginKeyInvalid", ExitStatusFailure
+	case PluginKeyNameTakenError:
+		return "PluginKeyNameTaken", ExitStatusFailure
+	case PluginNotCachedError:
+		return "PluginNotCached", ExitStatusFailure
+	case PluginNotSignedError:
+		return "PluginNotSigned", ExitStatusFailure
+	case PluginSetInvalidError:
+		return "PluginSetInvalid", ExitStatusFailure
+	case PluginSignatureInvalidError:
+		return "PluginSignatureInvalid", ExitStatusFailure
+	case PluginVersionConstraintInvalidError:
+		return "PluginVersionConstraintInvalid", ExitStatusFailure
+	case PluginVersionConstraintNotSatisfiedError:
+		return "PluginVersionConstraintNotSatisfied", ExitStatusFailure
+	case RepositoryNameTakenError:
+		return "RepositoryNameTaken", ExitStatusFailure
+	case RequiredArgumentError:
+		return "RequiredArgument", ExitStatusFailure
+	case RequiredFlagsError:
+		return "RequiredFlags", ExitStatusFailure
+	case RequiredNameForPushError:
+		return "RequiredNameForPush", ExitStatusFailure
+	case RouteInDifferentSpaceError:
+		return "RouteInDifferentSpace", ExitStatusFailure
+	case SSHCommandFailedError:
+		return "SSHCommandFailed", ExitStatusFailure
+	case StructuredOutputNotSupportedError:
+		return "StructuredOutputNotSupported", ExitStatusFailure
+	case TableColumnNotFoundError:
+		return "TableColumnNotFound", ExitStatusFailure
+	case TargetProfileAlreadyExistsError:
+		return "TargetProfileAlreadyExists", ExitStatusFailure
+	case ThreeRequiredArgumentsError:
+		return "ThreeRequiredArguments", ExitStatusFailure
+	case UnsupportedURLSchemeError:
+		return "UnsupportedURLScheme", ExitStatusFailure
+	case V3APIDoesNotExistError:
+		return "V3APIDoesNotExist", ExitStatusFailure
+	}
+
+	return "", ExitStatusFailure
+}
@@ -0,0 +1,37 @@
+package translatableerror_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ErrorCode", func() {
+	DescribeTable("returns the code and exit status of the error",
+		func(err error, expectedCode string, expectedStatus ExitStatus) {
+			Expect(ErrorCode(err)).To(Equal(expectedCode))
+			Expect(ExitStatusFor(err)).To(Equal(expectedStatus))
+		},
+
+		Entry("not logged in", NotLoggedInError{}, "NotLoggedIn", ExitStatusNotLoggedIn),
+		Entry("bad credentials", BadCredentialsError{}, "BadCredentials", ExitStatusNotLoggedIn),
+		Entry("no space targeted", NoSpaceTargetedError{}, "NoSpaceTargeted", ExitStatusNotTargeted),
+		Entry("app not found", ApplicationNotFoundError{}, "ApplicationNotFound", ExitStatusNotFound),
+		Entry("staging failed", StagingFailedError{}, "StagingFailed", ExitStatusStagingFailed),
+		Entry("API unreachable", APIRequestError{}, "APIRequestFailed", ExitStatusAPIUnreachable),
+		Entry("timeout", StagingTimeoutError{}, "StagingTimeout", ExitStatusTimeout),
+		Entry("incorrect usage", RequiredArgumentError{}, "RequiredArgument", ExitStatusFailure),
+		Entry("not a translatable error", errors.New("some-error"), "", ExitStatusFailure),
+	)
+
+	It("lists every exit status once, in order", func() {
+		for i, exitStatus := range ExitStatuses {
+			Expect(exitStatus.ExitStatus).To(Equal(ExitStatus(i + 1)))
+			Expect(exitStatus.Description).NotTo(BeEmpty())
+		}
+	})
+})
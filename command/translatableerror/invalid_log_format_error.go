@@ -0,0 +1,26 @@
+package translatableerror
+
+// InvalidLogFormatError is returned when --format is neither json nor a valid
+// Go template.
+type InvalidLogFormatError struct {
+	Format string
+	Err    error
+}
+
+func (InvalidLogFormatError) DisplayUsage() {}
+
+func (InvalidLogFormatError) Error() string {
+	return "Incorrect Usage: '{{.Format}}' is not a valid log format, expected json or a Go template: {{.Err}}"
+}
+
+func (e InvalidLogFormatError) Translate(translate func(string, ...interface{}) string) string {
+	var err string
+	if e.Err != nil {
+		err = e.Err.Error()
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Format": e.Format,
+		"Err":    err,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidTableFilterError is returned when a --filter is not of the form
+// COLUMN=GLOB.
+type InvalidTableFilterError struct {
+	Filter string
+}
+
+func (InvalidTableFilterError) DisplayUsage() {}
+
+func (InvalidTableFilterError) Error() string {
+	return "Incorrect Usage: '{{.Filter}}' is not a valid filter, expected COLUMN=GLOB."
+}
+
+func (e InvalidTableFilterError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Filter": e.Filter,
+	})
+}
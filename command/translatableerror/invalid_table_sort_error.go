@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidTableSortError is returned when --sort-by is not of the form
+// COLUMN[:asc|:desc].
+type InvalidTableSortError struct {
+	SortBy string
+}
+
+func (InvalidTableSortError) DisplayUsage() {}
+
+func (InvalidTableSortError) Error() string {
+	return "Incorrect Usage: '{{.SortBy}}' is not a valid sort order, expected COLUMN[:asc|:desc]."
+}
+
+func (e InvalidTableSortError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SortBy": e.SortBy,
+	})
+}
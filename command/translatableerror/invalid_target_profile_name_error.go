@@ -0,0 +1,15 @@
+package translatableerror
+
+type InvalidTargetProfileNameError struct {
+	Name string
+}
+
+func (e InvalidTargetProfileNameError) Error() string {
+	return "Invalid target name '{{.Name}}'. Target names may only contain letters, numbers, '.', '_' and '-', and must start with a letter or number."
+}
+
+func (e InvalidTargetProfileNameError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
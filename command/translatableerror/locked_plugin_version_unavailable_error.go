@@ -0,0 +1,26 @@
+package translatableerror
+
+// LockedPluginVersionUnavailableError is returned when the registered
+// repositories no longer provide the version of a plugin recorded in a plugin
+// set's lockfile.
+type LockedPluginVersionUnavailableError struct {
+	BinaryName    string
+	PluginSetPath string
+	PluginName    string
+	LockedVersion string
+	Version       string
+}
+
+func (LockedPluginVersionUnavailableError) Error() string {
+	return "Plugin {{.PluginName}} is locked to version {{.LockedVersion}}, but the registered repositories provide version {{.Version}}.\nUse '{{.BinaryName}} install-plugins -f {{.PluginSetPath}} --update' to update the lockfile."
+}
+
+func (e LockedPluginVersionUnavailableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BinaryName":    e.BinaryName,
+		"PluginSetPath": e.PluginSetPath,
+		"PluginName":    e.PluginName,
+		"LockedVersion": e.LockedVersion,
+		"Version":       e.Version,
+	})
+}
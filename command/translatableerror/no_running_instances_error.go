@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoRunningInstancesError is returned when a command needs a running instance
+// of an app and none are running.
+type NoRunningInstancesError struct {
+	AppName string
+}
+
+func (NoRunningInstancesError) Error() string {
+	return "App {{.AppName}} has no running instances."
+}
+
+func (e NoRunningInstancesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+import "fmt"
+
+type PasswordGrantTypeLogoutRequiredError struct {
+	BinaryName string
+}
+
+func (PasswordGrantTypeLogoutRequiredError) Error() string {
+	return "Service account currently logged in. Use '{{.CFLogoutCommand}}' to log out service account and try again."
+}
+
+func (e PasswordGrantTypeLogoutRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"CFLogoutCommand": fmt.Sprintf("%s logout", e.BinaryName),
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginBinaryInvalidError is returned when a binary in a plugin repository
+// directory does not respond to the plugin metadata handshake.
+type PluginBinaryInvalidError struct {
+	Path string
+}
+
+func (PluginBinaryInvalidError) Error() string {
+	return "{{.Path}} is not a valid cf CLI plugin binary."
+}
+
+func (e PluginBinaryInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
@@ -0,0 +1,23 @@
+package translatableerror
+
+// PluginBinaryNotInspectableError is returned when the metadata of a plugin
+// in a plugin repository directory cannot be read because none of its
+// binaries is built for this platform.
+type PluginBinaryNotInspectableError struct {
+	Path     string
+	Platform string
+	// CurrentPlatform is the platform of this CLI.
+	CurrentPlatform string
+}
+
+func (PluginBinaryNotInspectableError) Error() string {
+	return "Cannot read the plugin metadata from {{.Path}}, which is built for {{.Platform}}.\nAdd a binary of the plugin for {{.CurrentPlatform}} with the same name apart from the platform."
+}
+
+func (e PluginBinaryNotInspectableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":            e.Path,
+		"Platform":        e.Platform,
+		"CurrentPlatform": e.CurrentPlatform,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginKeyInvalidError is returned when the public key passed to
+// add-plugin-key is not a base64 encoded ed25519 public key.
+type PluginKeyInvalidError struct {
+	Name string
+}
+
+func (PluginKeyInvalidError) Error() string {
+	return "Key {{.Name}} is not a base64 encoded ed25519 public key."
+}
+
+func (e PluginKeyInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginKeyNameTakenError is returned when adding a trusted plugin key fails
+// due to a different key already being trusted under the same name.
+type PluginKeyNameTakenError struct {
+	Name string
+}
+
+func (PluginKeyNameTakenError) Error() string {
+	return "Plugin key named '{{.Name}}' already exists, please use another name."
+}
+
+func (e PluginKeyNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginKeyNotFoundError is returned when no trusted plugin key has the given
+// name.
+type PluginKeyNotFoundError struct {
+	BinaryName string
+	Name       string
+}
+
+func (PluginKeyNotFoundError) Error() string {
+	return "Plugin key {{.Name}} not found.\nUse '{{.BinaryName}} plugin-keys' to list trusted keys."
+}
+
+func (e PluginKeyNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BinaryName": e.BinaryName,
+		"Name":       e.Name,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginNotCachedError is returned when a plugin is installed offline and the
+// repository index or plugin binary at URL is not in the plugin cache.
+type PluginNotCachedError struct {
+	URL string
+}
+
+func (PluginNotCachedError) Error() string {
+	return "{{.URL}} is not in the plugin cache. Run the command without --offline to download it."
+}
+
+func (e PluginNotCachedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"URL": e.URL,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginNotFoundInAnyRepositoryError is returned when an installed plugin
+// cannot be updated because no registered repository contains it.
+type PluginNotFoundInAnyRepositoryError struct {
+	BinaryName string
+	PluginName string
+}
+
+func (e PluginNotFoundInAnyRepositoryError) Error() string {
+	return "Plugin {{.PluginName}} not found in any registered repo.\nUse '{{.BinaryName}} repo-plugins' to list plugins available in the repos."
+}
+
+func (e PluginNotFoundInAnyRepositoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BinaryName": e.BinaryName,
+		"PluginName": e.PluginName,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginNotSignedError is returned when installing a plugin binary that has no
+// detached signature.
+type PluginNotSignedError struct {
+	BinaryName string
+}
+
+func (PluginNotSignedError) Error() string {
+	return "Plugin is not signed.\nTIP: Use '{{.BinaryName}} install-plugin --allow-unsigned' to install it anyway if you trust its author."
+}
+
+func (e PluginNotSignedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BinaryName": e.BinaryName,
+	})
+}
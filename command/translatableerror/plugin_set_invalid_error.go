@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginSetInvalidError is returned when a plugin set file or its lockfile
+// cannot be parsed.
+type PluginSetInvalidError struct {
+	Path    string
+	Message string
+}
+
+func (PluginSetInvalidError) Error() string {
+	return "Plugin set {{.Path}} is invalid: {{.Message}}"
+}
+
+func (e PluginSetInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginSignatureInvalidError is returned when the signature of a plugin
+// binary cannot be verified with any trusted key.
+type PluginSignatureInvalidError struct {
+	BinaryName string
+}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "Plugin signature could not be verified with any trusted key. The plugin may have been modified, or signed with a key that is not trusted.\nTIP: Use '{{.BinaryName}} plugin-keys' to list trusted keys."
+}
+
+func (e PluginSignatureInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BinaryName": e.BinaryName,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginVersionConstraintInvalidError is returned when the version of a plugin
+// in a plugin set is not a semantic version range.
+type PluginVersionConstraintInvalidError struct {
+	PluginName string
+	Constraint string
+}
+
+func (PluginVersionConstraintInvalidError) Error() string {
+	return "Version constraint '{{.Constraint}}' of plugin {{.PluginName}} is not a valid semantic version range."
+}
+
+func (e PluginVersionConstraintInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Constraint": e.Constraint,
+	})
+}
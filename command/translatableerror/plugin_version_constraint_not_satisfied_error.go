@@ -0,0 +1,22 @@
+package translatableerror
+
+// PluginVersionConstraintNotSatisfiedError is returned when the newest version
+// of a plugin in the registered repositories is outside the version range
+// required by a plugin set.
+type PluginVersionConstraintNotSatisfiedError struct {
+	PluginName string
+	Constraint string
+	Version    string
+}
+
+func (PluginVersionConstraintNotSatisfiedError) Error() string {
+	return "Plugin {{.PluginName}} {{.Version}} is the newest version in the registered repositories and does not satisfy version constraint '{{.Constraint}}'."
+}
+
+func (e PluginVersionConstraintNotSatisfiedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Constraint": e.Constraint,
+		"Version":    e.Version,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// SSHCommandFailedError is returned when a command run over SSH on several
+// app instances fails on some of them.
+type SSHCommandFailedError struct {
+	Failed int
+	Total  int
+}
+
+func (SSHCommandFailedError) Error() string {
+	return "Command failed on {{.Failed}} of {{.Total}} instances."
+}
+
+func (e SSHCommandFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed": e.Failed,
+		"Total":  e.Total,
+	})
+}
@@ -0,0 +1,12 @@
+package translatableerror
+
+type SSHEndpointNotFoundError struct {
+}
+
+func (SSHEndpointNotFoundError) Error() string {
+	return "No SSH Endpoint Found"
+}
+
+func (e SSHEndpointNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
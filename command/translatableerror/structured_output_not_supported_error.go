@@ -0,0 +1,11 @@
+package translatableerror
+
+type StructuredOutputNotSupportedError struct{}
+
+func (StructuredOutputNotSupportedError) Error() string {
+	return "This command does not support the --output flag."
+}
+
+func (e StructuredOutputNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+import "strings"
+
+// TableColumnNotFoundError is returned when --columns, --sort-by or --filter
+// names a column the table does not have.
+type TableColumnNotFoundError struct {
+	Column  string
+	Columns []string
+}
+
+func (TableColumnNotFoundError) Error() string {
+	return "Column '{{.Column}}' not found. Available columns: {{.Columns}}"
+}
+
+func (e TableColumnNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Column":  e.Column,
+		"Columns": strings.Join(e.Columns, ", "),
+	})
+}
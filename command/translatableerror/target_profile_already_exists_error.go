@@ -0,0 +1,15 @@
+package translatableerror
+
+type TargetProfileAlreadyExistsError struct {
+	Name string
+}
+
+func (e TargetProfileAlreadyExistsError) Error() string {
+	return "Target {{.Name}} already exists. Use -f to overwrite it."
+}
+
+func (e TargetProfileAlreadyExistsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type TargetProfileNotFoundError struct {
+	Name string
+}
+
+func (e TargetProfileNotFoundError) Error() string {
+	return "Target {{.Name}} not found."
+}
+
+func (e TargetProfileNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
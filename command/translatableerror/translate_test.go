@@ -34,6 +34,7 @@ var _ = Describe("Translatable Errors", func() {
 			err, ok := e.(TranslatableError)
 			Expect(ok).To(BeTrue())
 			err.Translate(translateFunc)
+			Expect(ErrorCode(e)).NotTo(BeEmpty())
 		},
 
 		Entry("AddPluginRepositoryError", AddPluginRepositoryError{}),
@@ -47,6 +48,8 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
+		Entry("DuplicatePluginBinaryError", DuplicatePluginBinaryError{}),
+		Entry("DuplicatePluginError", DuplicatePluginError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
 		Entry("FileChangedError", FileChangedError{}),
@@ -55,30 +58,49 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("HealthCheckTypeUnsupportedError", HealthCheckTypeUnsupportedError{SupportedTypes: []string{"some-type", "another-type"}}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
+		Entry("InvalidLogFormatError", InvalidLogFormatError{}),
+		Entry("InvalidTableFilterError", InvalidTableFilterError{}),
+		Entry("InvalidTableSortError", InvalidTableSortError{}),
+		Entry("InvalidTargetProfileNameError", InvalidTargetProfileNameError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
+		Entry("LockedPluginVersionUnavailableError", LockedPluginVersionUnavailableError{}),
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
 		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
 		Entry("NoDomainsFoundError", NoDomainsFoundError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
+		Entry("NoRunningInstancesError", NoRunningInstancesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
 		Entry("ParseArgumentError", ParseArgumentError{}),
+		Entry("PasswordGrantTypeLogoutRequiredError", PasswordGrantTypeLogoutRequiredError{}),
 		Entry("PluginAlreadyInstalledError", PluginAlreadyInstalledError{}),
+		Entry("PluginBinaryInvalidError", PluginBinaryInvalidError{}),
+		Entry("PluginBinaryNotInspectableError", PluginBinaryNotInspectableError{}),
 		Entry("PluginBinaryRemoveFailedError", PluginBinaryRemoveFailedError{}),
 		Entry("PluginBinaryUninstallError", PluginBinaryUninstallError{}),
 		Entry("PluginCommandsConflictError", PluginCommandsConflictError{}),
 		Entry("PluginInvalidError", PluginInvalidError{Err: errors.New("invalid error")}),
 		Entry("PluginInvalidError", PluginInvalidError{}),
+		Entry("PluginKeyInvalidError", PluginKeyInvalidError{}),
+		Entry("PluginKeyNameTakenError", PluginKeyNameTakenError{}),
+		Entry("PluginKeyNotFoundError", PluginKeyNotFoundError{}),
+		Entry("PluginNotCachedError", PluginNotCachedError{}),
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
+		Entry("PluginNotFoundInAnyRepositoryError", PluginNotFoundInAnyRepositoryError{}),
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
+		Entry("PluginNotSignedError", PluginNotSignedError{}),
+		Entry("PluginSetInvalidError", PluginSetInvalidError{}),
+		Entry("PluginSignatureInvalidError", PluginSignatureInvalidError{}),
+		Entry("PluginVersionConstraintInvalidError", PluginVersionConstraintInvalidError{}),
+		Entry("PluginVersionConstraintNotSatisfiedError", PluginVersionConstraintNotSatisfiedError{}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
@@ -88,6 +110,8 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("SSHCommandFailedError", SSHCommandFailedError{}),
+		Entry("SSHEndpointNotFoundError", SSHEndpointNotFoundError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
 		Entry("StackNotFoundError without name", SpaceNotFoundError{}),
@@ -95,6 +119,10 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("StructuredOutputNotSupportedError", StructuredOutputNotSupportedError{}),
+		Entry("TableColumnNotFoundError", TableColumnNotFoundError{}),
+		Entry("TargetProfileAlreadyExistsError", TargetProfileAlreadyExistsError{}),
+		Entry("TargetProfileNotFoundError", TargetProfileNotFoundError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
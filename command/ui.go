@@ -4,11 +4,13 @@ import (
 	"io"
 	"time"
 
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
 // UI is the interface to STDOUT
 type UI interface {
+	ClearScreen()
 	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
 	DisplayChangesForPush(changeSet []ui.Change) error
 	DisplayError(err error)
@@ -18,16 +20,21 @@ type UI interface {
 	DisplayKeyValueTableForApp(table [][]string)
 	DisplayKeyValueTableForV3App(table [][]string, crashedProcesses []string)
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
+	DisplayLogMessageWithFormat(message ui.LogMessage, format ui.LogFormat) error
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
 	DisplayOK()
+	DisplayStructuredOutput(format configv3.OutputFormat, data interface{}) error
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
+	DisplayTableWithView(prefix string, table [][]string, padding int, view ui.TableView) error
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
 	DisplayTextWithBold(text string, keys ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
 	DisplayWarnings(warnings []string)
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
+	RequestLoggerJSONFileWriter(filePaths []string) *ui.RequestLoggerJSONWriter
+	RequestLoggerJSONTerminalDisplay() *ui.RequestLoggerJSONWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
 	TranslateText(template string, data ...map[string]interface{}) string
 	UserFriendlyDate(input time.Time) string
@@ -1,13 +1,57 @@
 package v2
 
 import (
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+// appOutput is the document displayed by app when --output is provided.
+// State is the lowercased requested state, memory and disk limits are in
+// megabytes and LastUploaded is in RFC3339.
+type appOutput struct {
+	Name             string              `json:"name" yaml:"name"`
+	GUID             string              `json:"guid" yaml:"guid"`
+	State            string              `json:"state" yaml:"state"`
+	Instances        int                 `json:"instances" yaml:"instances"`
+	RunningInstances int                 `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB       uint64              `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64              `json:"disk_in_mb" yaml:"disk_in_mb"`
+	IsolationSegment string              `json:"isolation_segment" yaml:"isolation_segment"`
+	Stack            string              `json:"stack" yaml:"stack"`
+	Buildpack        string              `json:"buildpack" yaml:"buildpack"`
+	LastUploaded     string              `json:"last_uploaded" yaml:"last_uploaded"`
+	Routes           []string            `json:"routes" yaml:"routes"`
+	InstanceDetails  []appInstanceOutput `json:"instance_details" yaml:"instance_details"`
+}
+
+// appInstanceOutput describes a single running instance in appOutput. Since is
+// in RFC3339 and CPU is a fraction of one core.
+type appInstanceOutput struct {
+	Index       int     `json:"index" yaml:"index"`
+	State       string  `json:"state" yaml:"state"`
+	Since       string  `json:"since" yaml:"since"`
+	CPU         float64 `json:"cpu" yaml:"cpu"`
+	Memory      int     `json:"memory_in_bytes" yaml:"memory_in_bytes"`
+	MemoryQuota int     `json:"memory_quota_in_bytes" yaml:"memory_quota_in_bytes"`
+	Disk        int     `json:"disk_in_bytes" yaml:"disk_in_bytes"`
+	DiskQuota   int     `json:"disk_quota_in_bytes" yaml:"disk_quota_in_bytes"`
+	Details     string  `json:"details" yaml:"details"`
+}
+
+// appGUIDOutput is the document displayed by app --guid when --output is
+// provided.
+type appGUIDOutput struct {
+	GUID string `json:"guid" yaml:"guid"`
+}
+
 //go:generate counterfeiter . AppActor
 
 type AppActor interface {
@@ -16,10 +60,11 @@ type AppActor interface {
 }
 
 type AppCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME"`
-	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
+	RequiredArgs    flag.AppName       `positional-args:"yes"`
+	GUID            bool               `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
+	Watch           flag.WatchInterval `long:"watch" optional:"true" optional-value:"3s" description:"Redisplay the app's health and status every INTERVAL (seconds or a duration such as 10s, default 3s) until interrupted, highlighting instance state changes"`
+	usage           interface{}        `usage:"CF_NAME app APP_NAME [--watch[=INTERVAL]]"`
+	relatedCommands interface{}        `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 
 	UI          command.UI
 	Config      command.Config
@@ -42,6 +87,15 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd AppCommand) Execute(args []string) error {
+	if cmd.Watch.IsSet {
+		switch {
+		case cmd.GUID:
+			return translatableerror.ArgumentCombinationError{Arg1: "--guid", Arg2: "--watch"}
+		case cmd.Config.OutputFormat() != configv3.OutputFormatText:
+			return translatableerror.ArgumentCombinationError{Arg1: "--output", Arg2: "--watch"}
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -51,6 +105,13 @@ func (cmd AppCommand) Execute(args []string) error {
 		return cmd.displayAppGUID()
 	}
 
+	if cmd.Watch.IsSet {
+		tracker := shared.NewInstanceStateTracker(shared.CrashCountWindow)
+		return shared.WatchApp(cmd.UI, cmd.Watch.Value, tracker, func() (func(), error) {
+			return cmd.fetchAppSummary(tracker)
+		})
+	}
+
 	return cmd.displayAppSummary()
 }
 
@@ -61,6 +122,10 @@ func (cmd AppCommand) displayAppGUID() error {
 		return shared.HandleError(err)
 	}
 
+	if outputFormat := cmd.Config.OutputFormat(); outputFormat != configv3.OutputFormatText {
+		return cmd.UI.DisplayStructuredOutput(outputFormat, appGUIDOutput{GUID: app.GUID})
+	}
+
 	cmd.UI.DisplayText(app.GUID)
 	return nil
 }
@@ -71,23 +136,100 @@ func (cmd AppCommand) displayAppSummary() error {
 		return shared.HandleError(err)
 	}
 
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.displayAppSummaryHeader(user.Name)
+	}
+
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if outputFormat != configv3.OutputFormatText {
+		return cmd.UI.DisplayStructuredOutput(outputFormat, newAppOutput(appSummary))
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, false)
+
+	return nil
+}
+
+// fetchAppSummary gets the app's health and status and returns a function
+// that displays them, highlighting the state changes seen by tracker, so that
+// a watched app is only redrawn once its status has been fetched. The
+// warnings of a failed fetch are displayed right away.
+func (cmd AppCommand) fetchAppSummary(tracker *shared.InstanceStateTracker) (func(), error) {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return nil, shared.HandleError(err)
+	}
+
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	if err != nil {
+		cmd.UI.DisplayWarnings(warnings)
+		return nil, shared.HandleError(err)
+	}
+
+	return func() {
+		cmd.displayAppSummaryHeader(user.Name)
+		cmd.UI.DisplayWarnings(warnings)
+		shared.DisplayTrackedAppSummary(cmd.UI, appSummary, false, tracker)
+	}, nil
+}
+
+func (cmd AppCommand) displayAppSummaryHeader(username string) {
 	cmd.UI.DisplayTextWithFlavor(
 		"Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
 			"OrgName":   cmd.Config.TargetedOrganization().Name,
 			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
+			"Username":  username,
 		})
 	cmd.UI.DisplayNewline()
+}
 
-	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return shared.HandleError(err)
+func (AppCommand) SupportsStructuredOutput() {}
+
+func newAppOutput(appSummary v2action.ApplicationSummary) appOutput {
+	output := appOutput{
+		Name:             appSummary.Name,
+		GUID:             appSummary.GUID,
+		State:            strings.ToLower(string(appSummary.State)),
+		Instances:        appSummary.Instances.Value,
+		RunningInstances: appSummary.StartingOrRunningInstanceCount(),
+		MemoryInMB:       appSummary.Memory,
+		DiskInMB:         appSummary.DiskQuota,
+		IsolationSegment: appSummary.IsolationSegment,
+		Stack:            appSummary.Stack.Name,
+		Buildpack:        appSummary.Application.CalculatedBuildpack(),
+		Routes:           []string{},
+		InstanceDetails:  []appInstanceOutput{},
 	}
 
-	shared.DisplayAppSummary(cmd.UI, appSummary, false)
+	if !appSummary.PackageUpdatedAt.IsZero() {
+		output.LastUploaded = appSummary.PackageUpdatedAt.UTC().Format(time.RFC3339)
+	}
 
-	return nil
+	for _, route := range appSummary.Routes {
+		output.Routes = append(output.Routes, route.String())
+	}
+
+	for _, instance := range appSummary.RunningInstances {
+		output.InstanceDetails = append(output.InstanceDetails, appInstanceOutput{
+			Index:       instance.ID,
+			State:       strings.ToLower(string(instance.State)),
+			Since:       instance.TimeSinceCreation().UTC().Format(time.RFC3339),
+			CPU:         instance.CPU,
+			Memory:      instance.Memory,
+			MemoryQuota: instance.MemoryQuota,
+			Disk:        instance.Disk,
+			DiskQuota:   instance.DiskQuota,
+			Details:     instance.Details,
+		})
+	}
+
+	return output
 }
@@ -8,8 +8,10 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -121,6 +123,18 @@ var _ = Describe("App Command", func() {
 					Expect(testUI.Err).To(Say("warning-1"))
 					Expect(testUI.Err).To(Say("warning-2"))
 				})
+
+				Context("when an output format is provided", func() {
+					BeforeEach(func() {
+						fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+					})
+
+					It("displays the application guid as structured output", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`^{\n  "guid": "some-guid"\n}\n`))
+					})
+				})
 			})
 
 			Context("when an error is encountered getting the app", func() {
@@ -293,6 +307,46 @@ var _ = Describe("App Command", func() {
 						})
 					})
 
+					Context("when an output format is provided", func() {
+						BeforeEach(func() {
+							fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
+						})
+
+						It("displays the app summary as structured output without flavor text", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say(`^name: some-app
+guid: some-app-guid
+state: started
+instances: 3
+running_instances: 1
+memory_in_mb: 128
+disk_in_mb: 0
+isolation_segment: some-isolation-segment
+stack: potatos
+buildpack: some-buildpack
+last_uploaded: 1970-01-01T00:00:00Z
+routes:
+- banana.fruit.com/hi
+- foobar.com:13
+instance_details:
+- index: 0
+  state: running
+  since: 2014-06-19T01:18:37Z
+  cpu: 0.73
+  memory_in_bytes: 104857600
+  memory_quota_in_bytes: 134217728
+  disk_in_bytes: 52428800
+  disk_quota_in_bytes: 2147483648
+  details: info from the backend
+- index: 1
+  state: crashed
+`))
+							Expect(testUI.Err).To(Say("app-summary-warning"))
+						})
+					})
+
 					Context("when the isolation segment is empty", func() {
 						BeforeEach(func() {
 							applicationSummary.IsolationSegment = ""
@@ -358,5 +412,88 @@ var _ = Describe("App Command", func() {
 				})
 			})
 		})
+
+		Context("when the --watch flag is provided", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				cmd.Watch = flag.WatchInterval{Value: time.Millisecond, IsSet: true}
+
+				applicationSummary := v2action.ApplicationSummary{
+					Application: v2action.Application{
+						Name:      "some-app",
+						Instances: types.NullInt{Value: 2, IsSet: true},
+						State:     "STARTED",
+					},
+				}
+				instanceStates := [][]ccv2.ApplicationInstanceState{
+					{ccv2.ApplicationInstanceStarting, ccv2.ApplicationInstanceRunning},
+					{ccv2.ApplicationInstanceRunning, ccv2.ApplicationInstanceRunning},
+					{ccv2.ApplicationInstanceRunning, ccv2.ApplicationInstanceCrashed},
+				}
+				expectedErr = errors.New("get app summary error")
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v2action.ApplicationSummary{}, v2action.Warnings{"failed-app-summary-warning"}, expectedErr)
+
+				for i, states := range instanceStates {
+					applicationSummary.RunningInstances = nil
+					for id, state := range states {
+						applicationSummary.RunningInstances = append(applicationSummary.RunningInstances,
+							v2action.ApplicationInstanceWithStats{ID: id, State: v2action.ApplicationInstanceState(state)})
+					}
+					// the second refresh fails and is retried
+					call := i
+					if i > 0 {
+						call++
+					}
+					fakeActor.GetApplicationSummaryByNameAndSpaceReturnsOnCall(call, applicationSummary, v2action.Warnings{"app-summary-warning"}, nil)
+				}
+			})
+
+			It("redisplays the app summary, highlighting state changes and counting crashes, until refreshing keeps failing", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(4 + shared.MaxWatchFailures))
+
+				Expect(testUI.Out).To(Say("Showing health and status for app some-app"))
+				Expect(testUI.Out).To(Say("#0\\s+starting\\s+"))
+				Expect(testUI.Out).To(Say("#1\\s+running\\s+"))
+				Expect(testUI.Out).To(Say("Crashes in the last 5 minutes: 0"))
+				Expect(testUI.Out).To(Say("Refreshing every 1ms, press Ctrl-C to stop."))
+
+				Expect(testUI.Out).To(Say("Showing health and status for app some-app"))
+				Expect(testUI.Out).To(Say("#0\\s+starting → running\\s+"))
+				Expect(testUI.Out).To(Say("#1\\s+running\\s+"))
+				Expect(testUI.Out).To(Say("Crashes in the last 5 minutes: 0"))
+
+				Expect(testUI.Out).To(Say("#0\\s+running\\s+"))
+				Expect(testUI.Out).To(Say("#1\\s+running → crashed\\s+"))
+				Expect(testUI.Out).To(Say("Crashes in the last 5 minutes: 1"))
+
+				Expect(testUI.Err).To(Say("failed-app-summary-warning"))
+				Expect(testUI.Err).To(Say("Refreshing failed, retrying in 1ms: get app summary error"))
+				Expect(testUI.Err).To(Say("app-summary-warning"))
+			})
+
+			Context("when the --guid flag is also provided", func() {
+				BeforeEach(func() {
+					cmd.GUID = true
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Arg1: "--guid", Arg2: "--watch"}))
+					Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when an output format is provided", func() {
+				BeforeEach(func() {
+					fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Arg1: "--output", Arg2: "--watch"}))
+					Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
@@ -2,21 +2,133 @@ package v2
 
 import (
 	"os"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
+// appsOutput is the document displayed by apps when --output is provided.
+type appsOutput struct {
+	Apps []appsAppOutput `json:"apps" yaml:"apps"`
+}
+
+// appsAppOutput describes a single app in appsOutput. State is the lowercased
+// requested state and memory and disk limits are in megabytes.
+type appsAppOutput struct {
+	Name             string   `json:"name" yaml:"name"`
+	GUID             string   `json:"guid" yaml:"guid"`
+	State            string   `json:"state" yaml:"state"`
+	Instances        int      `json:"instances" yaml:"instances"`
+	RunningInstances int      `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB       uint64   `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64   `json:"disk_in_mb" yaml:"disk_in_mb"`
+	Routes           []string `json:"routes" yaml:"routes"`
+}
+
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetApplicationInstancesByApplication(guid string) (map[int]v2action.ApplicationInstance, v2action.Warnings, error)
+	GetApplicationRoutes(applicationGUID string) (v2action.Routes, v2action.Warnings, error)
+}
+
 type AppsCommand struct {
-	usage           interface{} `usage:"CF_NAME apps"`
+	ui.TableViewFlags
+	usage           interface{} `usage:"CF_NAME apps [--columns COLUMN,...] [--sort-by COLUMN[:desc]] [--filter COLUMN=GLOB]..."`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
 }
 
-func (AppsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if config.OutputFormat() == configv3.OutputFormatText {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (AppsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd AppsCommand) Execute(args []string) error {
+	if cmd.Config.OutputFormat() == configv3.OutputFormatText {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	apps, warnings, err := cmd.Actor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	output := appsOutput{Apps: []appsAppOutput{}}
+	for _, app := range apps {
+		appOutput := appsAppOutput{
+			Name:       app.Name,
+			GUID:       app.GUID,
+			State:      strings.ToLower(string(app.State)),
+			Instances:  app.Instances.Value,
+			MemoryInMB: app.Memory,
+			DiskInMB:   app.DiskQuota,
+			Routes:     []string{},
+		}
+
+		// cloud controller calls the instance reporter only when the desired
+		// application state is STARTED
+		if app.State == ccv2.ApplicationStarted {
+			instances, warnings, err := cmd.Actor.GetApplicationInstancesByApplication(app.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			switch err.(type) {
+			case nil:
+				for _, instance := range instances {
+					if instance.Running() {
+						appOutput.RunningInstances++
+					}
+				}
+			case v2action.ApplicationInstancesNotFoundError:
+			default:
+				return shared.HandleError(err)
+			}
+		}
+
+		routes, warnings, err := cmd.Actor.GetApplicationRoutes(app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		for _, route := range routes {
+			appOutput.Routes = append(appOutput.Routes, route.String())
+		}
+
+		output.Apps = append(output.Apps, appOutput)
+	}
+
+	return cmd.UI.DisplayStructuredOutput(cmd.Config.OutputFormat(), output)
 }
+
+func (AppsCommand) SupportsStructuredOutput() {}
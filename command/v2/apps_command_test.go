@@ -0,0 +1,184 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			GUID: "some-space-guid",
+			Name: "some-space",
+		})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when an output format is provided", func() {
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get apps error")
+				fakeActor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("apps-warning"))
+			})
+		})
+
+		Context("when the space has apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns(
+					[]v2action.Application{
+						{
+							Name:      "some-app-1",
+							GUID:      "some-app-guid-1",
+							State:     ccv2.ApplicationStarted,
+							Instances: types.NullInt{Value: 2, IsSet: true},
+							Memory:    256,
+							DiskQuota: 1024,
+						},
+						{
+							Name:      "some-app-2",
+							GUID:      "some-app-guid-2",
+							State:     ccv2.ApplicationStopped,
+							Instances: types.NullInt{Value: 1, IsSet: true},
+							Memory:    128,
+							DiskQuota: 512,
+						},
+					},
+					v2action.Warnings{"apps-warning"},
+					nil)
+				fakeActor.GetApplicationInstancesByApplicationReturns(
+					map[int]v2action.ApplicationInstance{
+						0: {ID: 0, State: ccv2.ApplicationInstanceRunning},
+						1: {ID: 1, State: ccv2.ApplicationInstanceCrashed},
+					},
+					v2action.Warnings{"instances-warning"},
+					nil)
+				fakeActor.GetApplicationRoutesStub = func(appGUID string) (v2action.Routes, v2action.Warnings, error) {
+					if appGUID == "some-app-guid-1" {
+						return v2action.Routes{
+							{Host: "some-app-1", Domain: v2action.Domain{Name: "some-domain"}},
+						}, v2action.Warnings{"routes-warning"}, nil
+					}
+					return nil, nil, nil
+				}
+			})
+
+			It("displays the apps as structured output and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`^{
+  "apps": \[
+    {
+      "name": "some-app-1",
+      "guid": "some-app-guid-1",
+      "state": "started",
+      "instances": 2,
+      "running_instances": 1,
+      "memory_in_mb": 256,
+      "disk_in_mb": 1024,
+      "routes": \[
+        "some-app-1.some-domain"
+      \]
+    },
+    {
+      "name": "some-app-2",
+      "guid": "some-app-guid-2",
+      "state": "stopped",
+      "instances": 1,
+      "running_instances": 0,
+      "memory_in_mb": 128,
+      "disk_in_mb": 512,
+      "routes": \[\]
+    }
+  \]
+}
+`))
+
+				Expect(testUI.Err).To(Say("apps-warning"))
+				Expect(testUI.Err).To(Say("instances-warning"))
+				Expect(testUI.Err).To(Say("routes-warning"))
+
+				Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeActor.GetApplicationInstancesByApplicationCallCount()).To(Equal(1))
+				Expect(fakeActor.GetApplicationInstancesByApplicationArgsForCall(0)).To(Equal("some-app-guid-1"))
+			})
+		})
+
+		Context("when the instances of a started app are not found", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns(
+					[]v2action.Application{{Name: "some-app", GUID: "some-app-guid", State: ccv2.ApplicationStarted}},
+					nil,
+					nil)
+				fakeActor.GetApplicationInstancesByApplicationReturns(nil, nil, v2action.ApplicationInstancesNotFoundError{ApplicationGUID: "some-app-guid"})
+			})
+
+			It("displays no running instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"running_instances": 0`))
+			})
+		})
+	})
+})
@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -13,12 +15,14 @@ import (
 
 type AuthActor interface {
 	Authenticate(config v2action.Config, username string, password string) error
+	AuthenticateClient(config v2action.Config, clientID string, clientSecret string) error
 }
 
 type AuthCommand struct {
-	RequiredArgs    flag.Authentication `positional-args:"yes"`
-	usage           interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
-	relatedCommands interface{}         `related_commands:"api, login, target"`
+	RequiredArgs      flag.Authentication `positional-args:"yes"`
+	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
+	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth my-client-id my-client-secret --client-credentials"`
+	relatedCommands   interface{}         `related_commands:"api, login, target"`
 
 	UI     command.UI
 	Config command.Config
@@ -39,6 +43,10 @@ func (cmd *AuthCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd AuthCommand) Execute(args []string) error {
+	if !cmd.ClientCredentials && cmd.Config.UAAGrantType() == string(uaa.GrantTypeClientCredentials) {
+		return translatableerror.PasswordGrantTypeLogoutRequiredError{BinaryName: cmd.Config.BinaryName()}
+	}
+
 	err := command.WarnAPIVersionCheck(cmd.Config, cmd.UI)
 	if err != nil {
 		return err
@@ -51,7 +59,11 @@ func (cmd AuthCommand) Execute(args []string) error {
 		})
 	cmd.UI.DisplayText("Authenticating...")
 
-	err = cmd.Actor.Authenticate(cmd.Config, cmd.RequiredArgs.Username, cmd.RequiredArgs.Password)
+	if cmd.ClientCredentials {
+		err = cmd.Actor.AuthenticateClient(cmd.Config, cmd.RequiredArgs.Username, cmd.RequiredArgs.Password)
+	} else {
+		err = cmd.Actor.Authenticate(cmd.Config, cmd.RequiredArgs.Username, cmd.RequiredArgs.Password)
+	}
 	if err != nil {
 		return shared.HandleError(err)
 	}
@@ -76,6 +76,68 @@ var _ = Describe("auth Command", func() {
 		})
 	})
 
+	Context("when the --client-credentials flag is provided", func() {
+		BeforeEach(func() {
+			cmd.ClientCredentials = true
+			cmd.RequiredArgs.Username = "some-client-id"
+			cmd.RequiredArgs.Password = "some-client-secret"
+
+			fakeConfig.TargetReturns("some-api-target")
+		})
+
+		It("authenticates the client with the client credentials grant", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("API endpoint: %s", fakeConfig.Target()))
+			Expect(testUI.Out).To(Say("Authenticating\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Use '%s target' to view or set your target org and space", binaryName))
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			Expect(fakeActor.AuthenticateClientCallCount()).To(Equal(1))
+			config, clientID, clientSecret := fakeActor.AuthenticateClientArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(clientID).To(Equal("some-client-id"))
+			Expect(clientSecret).To(Equal("some-client-secret"))
+		})
+
+		Context("when the client credentials are rejected", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateClientReturns(uaa.BadCredentialsError{"some message"})
+			})
+
+			It("returns a BadCredentialsError", func() {
+				Expect(err).To(MatchError(translatableerror.BadCredentialsError{}))
+			})
+		})
+	})
+
+	Context("when a service account is already logged in", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Username = "foo"
+			cmd.RequiredArgs.Password = "bar"
+
+			fakeConfig.UAAGrantTypeReturns("client_credentials")
+		})
+
+		It("requires the service account to log out before authenticating as a user", func() {
+			Expect(err).To(MatchError(translatableerror.PasswordGrantTypeLogoutRequiredError{BinaryName: binaryName}))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			Expect(testUI.Out).ToNot(Say("Authenticating..."))
+		})
+
+		Context("when the --client-credentials flag is provided", func() {
+			BeforeEach(func() {
+				cmd.ClientCredentials = true
+			})
+
+			It("authenticates the client", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeActor.AuthenticateClientCallCount()).To(Equal(1))
+			})
+		})
+	})
+
 	Context("when there is an auth error", func() {
 		BeforeEach(func() {
 			cmd.RequiredArgs.Username = "foo"
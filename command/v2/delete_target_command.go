@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type DeleteTargetCommand struct {
+	RequiredArgs    flag.TargetName `positional-args:"yes"`
+	Force           bool            `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}     `usage:"CF_NAME delete-target NAME [-f]"`
+	relatedCommands interface{}     `related_commands:"save-target, targets"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *DeleteTargetCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd DeleteTargetCommand) Execute(args []string) error {
+	if !cmd.Force {
+		deleteTarget, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the saved target {{.TargetName}}?", map[string]interface{}{
+			"TargetName": cmd.RequiredArgs.TargetName,
+		})
+
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteTarget {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting saved target {{.TargetName}}...", map[string]interface{}{
+		"TargetName": cmd.RequiredArgs.TargetName,
+	})
+
+	err := cmd.Config.DeleteTargetProfile(cmd.RequiredArgs.TargetName)
+	if _, ok := err.(translatableerror.TargetProfileNotFoundError); ok {
+		cmd.UI.DisplayWarning("Saved target {{.TargetName}} does not exist.", map[string]interface{}{
+			"TargetName": cmd.RequiredArgs.TargetName,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
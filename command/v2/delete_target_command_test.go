@@ -0,0 +1,113 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-target Command", func() {
+	var (
+		cmd        DeleteTargetCommand
+		input      *Buffer
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = DeleteTargetCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.TargetName = "some-target"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the saved target without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(testUI.Out).To(Say("Deleting saved target some-target..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.DeleteTargetProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.DeleteTargetProfileArgsForCall(0)).To(Equal("some-target"))
+		})
+
+		Context("when the saved target does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteTargetProfileReturns(translatableerror.TargetProfileNotFoundError{Name: "some-target"})
+			})
+
+			It("displays a warning and OK", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("Saved target some-target does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when deleting the saved target fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeConfig.DeleteTargetProfileReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Context("when the -f flag is not provided", func() {
+		Context("when the user confirms the deletion", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the saved target", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Really delete the saved target some-target\?`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeConfig.DeleteTargetProfileCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the user declines the deletion", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete the saved target", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeConfig.DeleteTargetProfileCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,27 +1,47 @@
 package v2
 
 import (
+	"strings"
+	"time"
+
 	"github.com/cloudfoundry/noaa/consumer"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . LogsActor
 
 type LogsActor interface {
-	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
-	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetRecentLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetRecentLogsForSpace(spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsForSpace(spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.AppNames      `positional-args:"yes"`
+	Recent          bool               `long:"recent" description:"Dump recent logs instead of tailing"`
+	Follow          bool               `long:"follow" description:"Dump recent logs and then continue tailing"`
+	Since           flag.LogTime       `long:"since" description:"Display only the logs written at or after this time, given as a duration before now such as 10m or a timestamp such as 2017-06-01T15:04:05Z"`
+	Until           flag.LogTime       `long:"until" description:"Display only the logs written at or before this time, given like --since. Tailing stops at this time"`
+	Space           bool               `long:"space" description:"Display the logs of every app in the targeted space"`
+	Source          []string           `long:"source" choice:"APP" choice:"RTR" choice:"STG" choice:"API" choice:"CELL" choice:"SSH" description:"Display only the logs from this source (may be repeated)"`
+	Instance        flag.InstanceIndex `long:"instance" description:"Display only the logs from the app instance with this index"`
+	Grep            flag.Regexp        `long:"grep" description:"Display only the logs that match this regular expression"`
+	StdoutOnly      bool               `long:"stdout-only" description:"Display only the logs written to stdout"`
+	StderrOnly      bool               `long:"stderr-only" description:"Display only the logs written to stderr"`
+	Format          string             `long:"format" description:"Display each log as a JSON object on its own line with json, or formatted with a Go template using the fields AppName, Timestamp, SourceType, SourceInstance, Stream and Message"`
+	UTC             bool               `long:"utc" description:"Display timestamps in UTC instead of the local time zone"`
+	usage           interface{}        `usage:"CF_NAME logs (APP_NAME... | --space) [--recent | --follow] [--since DURATION|TIMESTAMP] [--until DURATION|TIMESTAMP] [--source APP|RTR|STG|API|CELL|SSH]... [--instance INDEX] [--grep REGEX] [--stdout-only | --stderr-only] [--format json|TEMPLATE] [--utc]"`
+	relatedCommands interface{}        `related_commands:"app, apps, ssh"`
 
 	UI          command.UI
 	Config      command.Config
@@ -47,7 +67,23 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	switch {
+	case len(cmd.RequiredArgs.AppNames) == 0 && !cmd.Space:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case len(cmd.RequiredArgs.AppNames) > 0 && cmd.Space:
+		return translatableerror.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--space"}
+	case cmd.Recent && cmd.Follow:
+		return translatableerror.ArgumentCombinationError{Arg1: "--recent", Arg2: "--follow"}
+	case cmd.StdoutOnly && cmd.StderrOnly:
+		return translatableerror.ArgumentCombinationError{Arg1: "--stdout-only", Arg2: "--stderr-only"}
+	}
+
+	format, err := ui.NewLogFormat(cmd.Format, cmd.UTC)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
 	}
@@ -57,49 +93,157 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
+	filter := cmd.logMessageFilter(time.Now())
+
+	if format.JSON || format.Template != nil {
+		// only the logs are displayed so that they can be parsed
+		return cmd.displayLogs(filter, format)
+	}
+
+	switch {
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  user.Name,
+			})
+	case len(cmd.RequiredArgs.AppNames) > 1:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppNames":  strings.Join(cmd.RequiredArgs.AppNames, ", "),
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  user.Name,
+			})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   cmd.RequiredArgs.AppNames[0],
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  user.Name,
+			})
+	}
 	cmd.UI.DisplayNewline()
 
-	if cmd.Recent {
-		return cmd.displayRecentLogs()
+	return cmd.displayLogs(filter, format)
+}
+
+func (cmd LogsCommand) logMessageFilter(now time.Time) v2action.LogMessageFilter {
+	filter := v2action.LogMessageFilter{
+		SourceTypes:    cmd.Source,
+		SourceInstance: cmd.Instance.NullInt,
+		Pattern:        cmd.Grep.Regexp,
+		Since:          cmd.Since.Time(now),
+		Until:          cmd.Until.Time(now),
 	}
 
-	return cmd.streamLogs()
+	switch {
+	case cmd.StdoutOnly:
+		filter.Type = "OUT"
+	case cmd.StderrOnly:
+		filter.Type = "ERR"
+	}
+
+	return filter
 }
 
-func (cmd LogsCommand) displayRecentLogs() error {
-	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.NOAAClient,
-		cmd.Config,
-	)
+func (cmd LogsCommand) displayLogs(filter v2action.LogMessageFilter, format ui.LogFormat) error {
+	switch {
+	case cmd.Recent:
+		return cmd.displayRecentLogs(filter, format)
+	case cmd.Follow:
+		return cmd.followLogs(filter, format)
+	default:
+		return cmd.streamLogs(filter, format)
+	}
+}
+
+func (cmd LogsCommand) getRecentLogs(filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error) {
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	switch {
+	case cmd.Space:
+		return cmd.Actor.GetRecentLogsForSpace(spaceGUID, cmd.NOAAClient, cmd.Config, filter)
+	case len(cmd.RequiredArgs.AppNames) > 1:
+		return cmd.Actor.GetRecentLogsForApplicationsByNameAndSpace(cmd.RequiredArgs.AppNames, spaceGUID, cmd.NOAAClient, cmd.Config, filter)
+	default:
+		return cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppNames[0], spaceGUID, cmd.NOAAClient, cmd.Config, filter)
+	}
+}
+
+func (cmd LogsCommand) getStreamingLogs(filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	switch {
+	case cmd.Space:
+		return cmd.Actor.GetStreamingLogsForSpace(spaceGUID, cmd.NOAAClient, cmd.Config, filter)
+	case len(cmd.RequiredArgs.AppNames) > 1:
+		return cmd.Actor.GetStreamingLogsForApplicationsByNameAndSpace(cmd.RequiredArgs.AppNames, spaceGUID, cmd.NOAAClient, cmd.Config, filter)
+	default:
+		return cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppNames[0], spaceGUID, cmd.NOAAClient, cmd.Config, filter)
+	}
+}
+
+func (cmd LogsCommand) displayRecentLogs(filter v2action.LogMessageFilter, format ui.LogFormat) error {
+	messages, warnings, err := cmd.getRecentLogs(filter)
 
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		displayErr := cmd.UI.DisplayLogMessageWithFormat(message, format)
+		if displayErr != nil {
+			return displayErr
+		}
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
-	return err
+	return shared.HandleError(err)
 }
 
-func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.NOAAClient,
-		cmd.Config,
-	)
+func (cmd LogsCommand) streamLogs(filter v2action.LogMessageFilter, format ui.LogFormat) error {
+	messages, logErrs, warnings, err := cmd.getStreamingLogs(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
 
+	return cmd.displayStreamingLogs(messages, logErrs, filter, format, nil)
+}
+
+// followLogs displays the recent logs and then the streamed logs that were not
+// among them. The stream is opened first so that no logs are missed between
+// the two.
+func (cmd LogsCommand) followLogs(filter v2action.LogMessageFilter, format ui.LogFormat) error {
+	messages, logErrs, warnings, err := cmd.getStreamingLogs(filter)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
-		return err
+		return shared.HandleError(err)
+	}
+
+	recentMessages, warnings, err := cmd.getRecentLogs(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.NOAAClient.Close()
+		return shared.HandleError(err)
+	}
+
+	for _, message := range recentMessages {
+		err = cmd.UI.DisplayLogMessageWithFormat(message, format)
+		if err != nil {
+			cmd.NOAAClient.Close()
+			return err
+		}
+	}
+
+	return cmd.displayStreamingLogs(messages, logErrs, filter, format, v2action.NewLogMessageSet(recentMessages))
+}
+
+// displayStreamingLogs displays the streamed messages, skipping those already
+// displayed, until the stream closes or, when the filter has an end, until
+// shortly after that end.
+func (cmd LogsCommand) displayStreamingLogs(messages <-chan *v2action.LogMessage, logErrs <-chan error, filter v2action.LogMessageFilter, format ui.LogFormat, displayed v2action.LogMessageSet) error {
+	var untilReached <-chan time.Time
+	if !filter.Until.IsZero() {
+		// messages written just before the end can be delivered after it
+		untilReached = time.After(filter.Until.Add(v2action.LogMergeWindow).Sub(time.Now()))
 	}
 
 	var messagesClosed, errLogsClosed bool
@@ -111,7 +255,15 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
-			cmd.UI.DisplayLogMessage(message, true)
+			if displayed.Contains(*message) {
+				break
+			}
+
+			err := cmd.UI.DisplayLogMessageWithFormat(message, format)
+			if err != nil {
+				cmd.NOAAClient.Close()
+				return err
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -120,6 +272,9 @@ func (cmd LogsCommand) streamLogs() error {
 
 			cmd.NOAAClient.Close()
 			return logErr
+		case <-untilReached:
+			cmd.NOAAClient.Close()
+			return nil
 		}
 
 		if messagesClosed && errLogsClosed {
@@ -2,14 +2,17 @@ package v2_test
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/cloudfoundry/noaa/consumer"
@@ -47,7 +50,7 @@ var _ = Describe("logs command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.AppNames = []string{"some-app"}
 		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 	})
 
@@ -55,6 +58,72 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when neither an app name nor --space is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppNames = nil
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when both an app name and --space are provided", func() {
+		BeforeEach(func() {
+			cmd.Space = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Arg1: "APP_NAME",
+				Arg2: "--space",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when both --recent and --follow are provided", func() {
+		BeforeEach(func() {
+			cmd.Recent = true
+			cmd.Follow = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Arg1: "--recent",
+				Arg2: "--follow",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when both --stdout-only and --stderr-only are provided", func() {
+		BeforeEach(func() {
+			cmd.StdoutOnly = true
+			cmd.StderrOnly = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Arg1: "--stdout-only",
+				Arg2: "--stderr-only",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --format is not a valid template", func() {
+		BeforeEach(func() {
+			cmd.Format = "{{.Message"
+		})
+
+		It("returns an InvalidLogFormatError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidLogFormatError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -139,12 +208,314 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("i am message 2"))
 
 					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client, config := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					appName, spaceGUID, client, config, filter := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(noaaClient))
 					Expect(config).To(Equal(fakeConfig))
+					Expect(filter).To(Equal(v2action.LogMessageFilter{}))
+				})
+			})
+		})
+
+		Context("when --since and --until are provided", func() {
+			var since, until time.Time
+
+			BeforeEach(func() {
+				cmd.Recent = true
+				Expect(cmd.Since.UnmarshalFlag("2017-06-01T15:00:00Z")).To(Succeed())
+				Expect(cmd.Until.UnmarshalFlag("5m")).To(Succeed())
+				since = time.Date(2017, time.June, 1, 15, 0, 0, 0, time.UTC)
+				until = time.Now().Add(-5 * time.Minute)
+			})
+
+			It("passes the time window to the actor", func() {
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				_, _, _, _, filter := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(filter.Since.Equal(since)).To(BeTrue())
+				Expect(filter.Until).To(BeTemporally("~", until, time.Second))
+			})
+		})
+
+		Context("when --until is provided without --recent", func() {
+			BeforeEach(func() {
+				Expect(cmd.Until.UnmarshalFlag("0s")).To(Succeed())
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config, _ v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+					// the stream never closes
+					return make(chan *v2action.LogMessage), make(chan error), nil, nil
+				}
+			})
+
+			It("stops streaming once the end of the window is reached", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when --follow is provided", func() {
+			BeforeEach(func() {
+				cmd.Follow = true
+
+				fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					[]v2action.LogMessage{
+						*v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 1), "APP/PROC/WEB", "0"),
+						*v2action.NewLogMessage("i am message 2", 1, time.Unix(0, 2), "APP/PROC/WEB", "0"),
+					},
+					v2action.Warnings{"recent-warning"},
+					nil)
+
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config, _ v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+					messages := make(chan *v2action.LogMessage)
+					logErrs := make(chan error)
+
+					go func() {
+						messages <- v2action.NewLogMessage("i am message 2", 1, time.Unix(0, 2), "APP/PROC/WEB", "0")
+						messages <- v2action.NewLogMessage("i am message 3", 1, time.Unix(0, 3), "APP/PROC/WEB", "0")
+						close(messages)
+						close(logErrs)
+					}()
+
+					return messages, logErrs, v2action.Warnings{"streaming-warning"}, nil
+				}
+			})
+
+			It("displays the recent logs and then the streamed logs without duplicates", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+
+				Expect(testUI.Out).To(Say("i am message 1"))
+				Expect(testUI.Out).To(Say("i am message 2"))
+				Expect(testUI.Out).To(Say("i am message 3"))
+				Expect(strings.Count(string(testUI.Out.(*Buffer).Contents()), "i am message 2")).To(Equal(1))
+
+				Expect(testUI.Err).To(Say("streaming-warning"))
+				Expect(testUI.Err).To(Say("recent-warning"))
+			})
+
+			Context("when getting the recent logs returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("banana")
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(nil, v2action.Warnings{"recent-warning"}, expectedErr)
+				})
+
+				It("returns the error and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("streaming-warning"))
+					Expect(testUI.Err).To(Say("recent-warning"))
+				})
+			})
+		})
+
+		Context("when several app names are provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppNames = []string{"some-app", "some-other-app"}
+			})
+
+			Context("when the --recent flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+					fakeActor.GetRecentLogsForApplicationsByNameAndSpaceReturns(
+						[]v2action.LogMessage{
+							*v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "1"),
+						},
+						v2action.Warnings{"some-warning"},
+						nil)
+				})
+
+				It("displays flavor text, the log messages and warnings of all the apps", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving logs for apps some-app, some-other-app in org some-org-name / space some-space-name as some-user..."))
+					Expect(testUI.Out).To(Say("i am message 1"))
+					Expect(testUI.Err).To(Say("some-warning"))
+
+					Expect(fakeActor.GetRecentLogsForApplicationsByNameAndSpaceCallCount()).To(Equal(1))
+					appNames, spaceGUID, client, _, _ := fakeActor.GetRecentLogsForApplicationsByNameAndSpaceArgsForCall(0)
+					Expect(appNames).To(Equal([]string{"some-app", "some-other-app"}))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(client).To(Equal(noaaClient))
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the --recent flag is not provided", func() {
+				BeforeEach(func() {
+					fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceStub = func(_ []string, _ string, _ v2action.NOAAClient, _ v2action.Config, _ v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+
+						go func() {
+							messages <- v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "1")
+							close(messages)
+							close(logErrs)
+						}()
+
+						return messages, logErrs, nil, nil
+					}
+				})
+
+				It("streams the logs of all the apps", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving logs for apps some-app, some-other-app in org some-org-name / space some-space-name as some-user..."))
+					Expect(testUI.Out).To(Say("i am message 1"))
+
+					Expect(fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceCallCount()).To(Equal(1))
+					appNames, spaceGUID, _, _, _ := fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceArgsForCall(0)
+					Expect(appNames).To(Equal([]string{"some-app", "some-other-app"}))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when an app does not exist", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+					fakeActor.GetRecentLogsForApplicationsByNameAndSpaceReturns(
+						nil,
+						v2action.Warnings{"some-warning"},
+						v2action.ApplicationNotFoundError{Name: "some-other-app"})
+				})
+
+				It("returns an ApplicationNotFoundError and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-other-app"}))
+					Expect(testUI.Err).To(Say("some-warning"))
+				})
+			})
+		})
+
+		Context("when --space is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppNames = nil
+				cmd.Space = true
+			})
+
+			Context("when the --recent flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+				})
+
+				It("displays flavor text and gets the logs of every app in the space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving logs for all apps in org some-org-name / space some-space-name as some-user..."))
+
+					Expect(fakeActor.GetRecentLogsForSpaceCallCount()).To(Equal(1))
+					spaceGUID, client, _, _ := fakeActor.GetRecentLogsForSpaceArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(client).To(Equal(noaaClient))
+				})
+			})
+
+			Context("when the --recent flag is not provided", func() {
+				BeforeEach(func() {
+					fakeActor.GetStreamingLogsForSpaceStub = func(_ string, _ v2action.NOAAClient, _ v2action.Config, _ v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+						close(messages)
+						close(logErrs)
+						return messages, logErrs, nil, nil
+					}
+				})
+
+				It("streams the logs of every app in the space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving logs for all apps in org some-org-name / space some-space-name as some-user..."))
+
+					Expect(fakeActor.GetStreamingLogsForSpaceCallCount()).To(Equal(1))
+					spaceGUID, _, _, _ := fakeActor.GetStreamingLogsForSpaceArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+		})
+
+		Context("when --format json is provided", func() {
+			BeforeEach(func() {
+				cmd.Recent = true
+				cmd.Format = "json"
+				cmd.UTC = true
+				fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					[]v2action.LogMessage{
+						*v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "1"),
+						*v2action.NewLogMessage("i am message 2", 2, time.Unix(1, 0), "RTR", "0"),
+					},
+					nil,
+					nil)
+			})
+
+			It("displays only one JSON object per log message", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+					`{"timestamp":"1970-01-01T00:00:00Z","source_type":"APP/PROC/WEB","source_instance":"1","stream":"stdout","message":"i am message 1"}` + "\n" +
+						`{"timestamp":"1970-01-01T00:00:01Z","source_type":"RTR","source_instance":"0","stream":"stderr","message":"i am message 2"}` + "\n",
+				))
+			})
+		})
+
+		Context("when --format is a template", func() {
+			BeforeEach(func() {
+				cmd.Format = "{{.SourceType}}: {{.Message}}"
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config, _ v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+					messages := make(chan *v2action.LogMessage)
+					logErrs := make(chan error)
+
+					go func() {
+						messages <- v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "1")
+						close(messages)
+						close(logErrs)
+					}()
+
+					return messages, logErrs, nil, nil
+				}
+			})
+
+			It("displays only the streamed log messages formatted with the template", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("APP/PROC/WEB: i am message 1\n"))
+			})
+		})
+
+		Context("when filter flags are provided", func() {
+			BeforeEach(func() {
+				cmd.Source = []string{"APP", "RTR"}
+				cmd.Instance = flag.InstanceIndex{NullInt: types.NullInt{IsSet: true, Value: 2}}
+				Expect(cmd.Grep.UnmarshalFlag("some-pattern")).To(Succeed())
+				cmd.StderrOnly = true
+			})
+
+			Context("when the --recent flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+				})
+
+				It("passes the filter to the actor", func() {
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					_, _, _, _, filter := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(filter.SourceTypes).To(Equal([]string{"APP", "RTR"}))
+					Expect(filter.SourceInstance).To(Equal(types.NullInt{IsSet: true, Value: 2}))
+					Expect(filter.Pattern.String()).To(Equal("some-pattern"))
+					Expect(filter.Type).To(Equal("ERR"))
+				})
+			})
+
+			Context("when the --recent flag is not provided", func() {
+				BeforeEach(func() {
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config, _ v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+						close(messages)
+						close(logErrs)
+						return messages, logErrs, nil, nil
+					}
+				})
+
+				It("passes the filter to the actor", func() {
+					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					_, _, _, _, filter := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(filter.SourceTypes).To(Equal([]string{"APP", "RTR"}))
+					Expect(filter.SourceInstance).To(Equal(types.NullInt{IsSet: true, Value: 2}))
+					Expect(filter.Pattern.String()).To(Equal("some-pattern"))
+					Expect(filter.Type).To(Equal("ERR"))
 				})
 			})
 		})
@@ -175,7 +546,7 @@ var _ = Describe("logs command", func() {
 				BeforeEach(func() {
 					expectedErr = errors.New("some-error")
 
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config, _ v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
 						messages := make(chan *v2action.LogMessage)
 						logErrs := make(chan error)
 
@@ -198,7 +569,7 @@ var _ = Describe("logs command", func() {
 
 			Context("when the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config, _ v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
 						messages := make(chan *v2action.LogMessage)
 						logErrs := make(chan error)
 						message1 := v2action.NewLogMessage(
@@ -240,12 +611,13 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("i am message 2"))
 
 					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client, config := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					appName, spaceGUID, client, config, filter := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(noaaClient))
 					Expect(config).To(Equal(fakeConfig))
+					Expect(filter).To(Equal(v2action.LogMessageFilter{}))
 				})
 			})
 		})
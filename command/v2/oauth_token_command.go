@@ -1,6 +1,9 @@
 package v2
 
 import (
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
@@ -10,11 +13,13 @@ import (
 //go:generate counterfeiter . OauthTokenActor
 
 type OauthTokenActor interface {
+	DecodeAccessToken(accessToken string) (v2action.AccessTokenClaims, error)
 	RefreshAccessToken(refreshToken string) (string, error)
 }
 
 type OauthTokenCommand struct {
-	usage           interface{} `usage:"CF_NAME oauth-token"`
+	Decode          bool        `long:"decode" description:"Display the user, client, scopes and expiry of the token instead of the token"`
+	usage           interface{} `usage:"CF_NAME oauth-token [--decode]"`
 	relatedCommands interface{} `related_commands:"curl"`
 
 	UI          command.UI
@@ -48,6 +53,37 @@ func (cmd OauthTokenCommand) Execute(_ []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.Decode {
+		return cmd.displayDecodedToken(accessToken)
+	}
+
 	cmd.UI.DisplayText(accessToken)
 	return nil
 }
+
+func (cmd OauthTokenCommand) displayDecodedToken(accessToken string) error {
+	claims, err := cmd.Actor.DecodeAccessToken(accessToken)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("user:"), claims.UserName},
+		{cmd.UI.TranslateText("user id:"), claims.UserID},
+		{cmd.UI.TranslateText("client:"), claims.ClientID},
+		{cmd.UI.TranslateText("grant type:"), claims.GrantType},
+		{cmd.UI.TranslateText("scopes:"), strings.Join(claims.Scopes, ", ")},
+		{cmd.UI.TranslateText("issued at:"), cmd.formatTime(claims.IssuedAt)},
+		{cmd.UI.TranslateText("expires at:"), cmd.formatTime(claims.ExpiresAt)},
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	return nil
+}
+
+func (cmd OauthTokenCommand) formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return cmd.UI.UserFriendlyDate(t)
+}
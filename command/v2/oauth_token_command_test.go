@@ -2,8 +2,10 @@ package v2_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
@@ -98,5 +100,62 @@ var _ = Describe("oauth-token command", func() {
 				Expect(fakeActor.RefreshAccessTokenArgsForCall(0)).To(Equal("existing-refresh-token"))
 			})
 		})
+
+		Context("when the --decode flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Decode = true
+				fakeActor.RefreshAccessTokenReturns("new-access-token", nil)
+			})
+
+			Context("when the token can be decoded", func() {
+				var (
+					issuedAt  time.Time
+					expiresAt time.Time
+				)
+
+				BeforeEach(func() {
+					issuedAt = time.Unix(1473284577, 0)
+					expiresAt = time.Unix(1473285177, 0)
+					fakeActor.DecodeAccessTokenReturns(v2action.AccessTokenClaims{
+						UserName:  "some-user",
+						UserID:    "some-user-guid",
+						ClientID:  "cf",
+						GrantType: "password",
+						Scopes:    []string{"openid", "cloud_controller.read"},
+						IssuedAt:  issuedAt,
+						ExpiresAt: expiresAt,
+					}, nil)
+				})
+
+				It("displays the claims of the refreshed token instead of the token", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.DecodeAccessTokenCallCount()).To(Equal(1))
+					Expect(fakeActor.DecodeAccessTokenArgsForCall(0)).To(Equal("new-access-token"))
+
+					Expect(testUI.Out).To(Say(`user:\s+some-user`))
+					Expect(testUI.Out).To(Say(`user id:\s+some-user-guid`))
+					Expect(testUI.Out).To(Say(`client:\s+cf`))
+					Expect(testUI.Out).To(Say(`grant type:\s+password`))
+					Expect(testUI.Out).To(Say(`scopes:\s+openid, cloud_controller.read`))
+					Expect(testUI.Out).To(Say(`issued at:\s+%s`, testUI.UserFriendlyDate(issuedAt)))
+					Expect(testUI.Out).To(Say(`expires at:\s+%s`, testUI.UserFriendlyDate(expiresAt)))
+					Expect(testUI.Out).ToNot(Say("new-access-token"))
+				})
+			})
+
+			Context("when the token cannot be decoded", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("decode error")
+					fakeActor.DecodeAccessTokenReturns(v2action.AccessTokenClaims{}, expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+		})
 	})
 })
@@ -5,10 +5,12 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type OrgsCommand struct {
-	usage interface{} `usage:"CF_NAME orgs"`
+	ui.TableViewFlags
+	usage interface{} `usage:"CF_NAME orgs [--columns COLUMN,...] [--sort-by COLUMN[:desc]] [--filter COLUMN=GLOB]..."`
 }
 
 func (OrgsCommand) Setup(config command.Config, ui command.UI) error {
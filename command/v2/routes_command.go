@@ -3,21 +3,129 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
+// routesOutput is the document displayed by routes when --output is provided.
+type routesOutput struct {
+	Routes []routeOutput `json:"routes" yaml:"routes"`
+}
+
+// routeOutput describes a single route in routesOutput. URL is formatted as
+// host.domain/path or domain:port, and Port is 0 for HTTP routes.
+type routeOutput struct {
+	GUID   string   `json:"guid" yaml:"guid"`
+	Space  string   `json:"space" yaml:"space"`
+	Host   string   `json:"host" yaml:"host"`
+	Domain string   `json:"domain" yaml:"domain"`
+	Port   int      `json:"port" yaml:"port"`
+	Path   string   `json:"path" yaml:"path"`
+	URL    string   `json:"url" yaml:"url"`
+	Apps   []string `json:"apps" yaml:"apps"`
+}
+
+//go:generate counterfeiter . RoutesActor
+
+type RoutesActor interface {
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetSpaceRoutes(spaceGUID string) ([]v2action.Route, v2action.Warnings, error)
+	GetRouteApplications(routeGUID string) ([]v2action.Application, v2action.Warnings, error)
+}
+
 type RoutesCommand struct {
 	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
-	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
+	usage           interface{} `usage:"CF_NAME routes [--orglevel] [--columns COLUMN,...] [--sort-by COLUMN[:desc]] [--filter COLUMN=GLOB]..."`
 	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
+	ui.TableViewFlags
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RoutesActor
 }
 
-func (RoutesCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if config.OutputFormat() == configv3.OutputFormatText {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (RoutesCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd RoutesCommand) Execute(args []string) error {
+	if cmd.Config.OutputFormat() == configv3.OutputFormatText {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, !cmd.OrgLevel)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	spaces := []v2action.Space{{
+		GUID: cmd.Config.TargetedSpace().GUID,
+		Name: cmd.Config.TargetedSpace().Name,
+	}}
+	if cmd.OrgLevel {
+		var warnings v2action.Warnings
+		spaces, warnings, err = cmd.Actor.GetOrganizationSpaces(cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	output := routesOutput{Routes: []routeOutput{}}
+	for _, space := range spaces {
+		routes, warnings, err := cmd.Actor.GetSpaceRoutes(space.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		for _, route := range routes {
+			apps, warnings, err := cmd.Actor.GetRouteApplications(route.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+
+			routeOutput := routeOutput{
+				GUID:   route.GUID,
+				Space:  space.Name,
+				Host:   route.Host,
+				Domain: route.Domain.Name,
+				Port:   route.Port,
+				Path:   route.Path,
+				URL:    route.String(),
+				Apps:   []string{},
+			}
+			for _, app := range apps {
+				routeOutput.Apps = append(routeOutput.Apps, app.Name)
+			}
+
+			output.Routes = append(output.Routes, routeOutput)
+		}
+	}
+
+	return cmd.UI.DisplayStructuredOutput(cmd.Config.OutputFormat(), output)
 }
+
+func (RoutesCommand) SupportsStructuredOutput() {}
@@ -0,0 +1,166 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("routes Command", func() {
+	var (
+		cmd             RoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRoutesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRoutesActor)
+
+		cmd = RoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			GUID: "some-org-guid",
+			Name: "some-org",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			GUID: "some-space-guid",
+			Name: "some-space",
+		})
+
+		fakeActor.GetSpaceRoutesStub = func(spaceGUID string) ([]v2action.Route, v2action.Warnings, error) {
+			switch spaceGUID {
+			case "some-space-guid":
+				return []v2action.Route{
+					{GUID: "route-guid-1", Host: "host-1", Domain: v2action.Domain{Name: "some-domain"}, Path: "/path"},
+					{GUID: "route-guid-2", Domain: v2action.Domain{Name: "tcp-domain"}, Port: 1024},
+				}, v2action.Warnings{"routes-warning"}, nil
+			default:
+				return []v2action.Route{
+					{GUID: "route-guid-3", Host: "host-3", Domain: v2action.Domain{Name: "some-domain"}},
+				}, nil, nil
+			}
+		}
+		fakeActor.GetRouteApplicationsStub = func(routeGUID string) ([]v2action.Application, v2action.Warnings, error) {
+			if routeGUID == "route-guid-1" {
+				return []v2action.Application{{Name: "app-1"}, {Name: "app-2"}}, v2action.Warnings{"route-apps-warning"}, nil
+			}
+			return nil, nil, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when an output format is provided", func() {
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		Context("when getting the routes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get routes error")
+				fakeActor.GetSpaceRoutesStub = nil
+				fakeActor.GetSpaceRoutesReturns(nil, v2action.Warnings{"routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("routes-warning"))
+			})
+		})
+
+		It("displays the routes in the targeted space as structured output", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`^routes:
+- guid: route-guid-1
+  space: some-space
+  host: host-1
+  domain: some-domain
+  port: 0
+  path: /path
+  url: host-1.some-domain/path
+  apps:
+  - app-1
+  - app-2
+- guid: route-guid-2
+  space: some-space
+  host: ""
+  domain: tcp-domain
+  port: 1024
+  path: ""
+  url: tcp-domain:1024
+  apps: \[\]
+`))
+			Expect(testUI.Err).To(Say("routes-warning"))
+			Expect(testUI.Err).To(Say("route-apps-warning"))
+
+			Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+		})
+
+		Context("when --orglevel is provided", func() {
+			BeforeEach(func() {
+				cmd.OrgLevel = true
+				fakeActor.GetOrganizationSpacesReturns(
+					[]v2action.Space{
+						{GUID: "some-space-guid", Name: "some-space"},
+						{GUID: "other-space-guid", Name: "other-space"},
+					},
+					v2action.Warnings{"spaces-warning"},
+					nil)
+			})
+
+			It("displays the routes in all spaces of the targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(testUI.Out).To(Say("guid: route-guid-1"))
+				Expect(testUI.Out).To(Say("guid: route-guid-3\n  space: other-space"))
+				Expect(testUI.Err).To(Say("spaces-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type SaveTargetCommand struct {
+	RequiredArgs    flag.TargetName `positional-args:"yes"`
+	Force           bool            `short:"f" description:"Overwrite the saved target if it already exists"`
+	usage           interface{}     `usage:"CF_NAME save-target NAME [-f]\n\nEXAMPLES:\n   CF_NAME save-target prod\n   CF_NAME target prod\n   CF_PROFILE=prod CF_NAME apps"`
+	relatedCommands interface{}     `related_commands:"delete-target, target, targets"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *SaveTargetCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd SaveTargetCommand) Execute(args []string) error {
+	if cmd.Config.Target() == "" {
+		return translatableerror.NoAPISetError{BinaryName: cmd.Config.BinaryName()}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Saving target {{.TargetName}} for {{.API}}...", map[string]interface{}{
+		"TargetName": cmd.RequiredArgs.TargetName,
+		"API":        cmd.Config.Target(),
+	})
+
+	err := cmd.Config.SaveTargetProfile(cmd.RequiredArgs.TargetName, cmd.Force)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
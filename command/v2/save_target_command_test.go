@@ -0,0 +1,88 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("save-target Command", func() {
+	var (
+		cmd        SaveTargetCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		binaryName string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = SaveTargetCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.TargetName = "some-target"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no API endpoint is set", func() {
+		It("returns a NoAPISetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoAPISetError{BinaryName: binaryName}))
+			Expect(fakeConfig.SaveTargetProfileCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when an API endpoint is set", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.some-domain.com")
+		})
+
+		It("saves the target and displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Saving target some-target for https://api.some-domain.com..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.SaveTargetProfileCallCount()).To(Equal(1))
+			name, force := fakeConfig.SaveTargetProfileArgsForCall(0)
+			Expect(name).To(Equal("some-target"))
+			Expect(force).To(BeFalse())
+		})
+
+		Context("when the -f flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("overwrites the saved target", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, force := fakeConfig.SaveTargetProfileArgsForCall(0)
+				Expect(force).To(BeTrue())
+			})
+		})
+
+		Context("when saving the target fails", func() {
+			BeforeEach(func() {
+				fakeConfig.SaveTargetProfileReturns(translatableerror.TargetProfileAlreadyExistsError{Name: "some-target"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.TargetProfileAlreadyExistsError{Name: "some-target"}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SCPCommand struct {
+	RequiredArgs       flag.SCPPaths `positional-args:"yes"`
+	Recursive          bool          `short:"r" description:"Recursively copy directories"`
+	SkipHostValidation bool          `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{}   `usage:"CF_NAME scp [-r] [--skip-host-validation] APP_NAME[:INDEX]:REMOTE_PATH LOCAL_PATH\n   CF_NAME scp [-r] [--skip-host-validation] LOCAL_PATH APP_NAME[:INDEX]:REMOTE_PATH"`
+	relatedCommands    interface{}   `related_commands:"ssh, ssh-enabled"`
+}
+
+func (SCPCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (SCPCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
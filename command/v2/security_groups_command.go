@@ -8,8 +8,34 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+// securityGroupsOutput is the document displayed by security-groups when
+// --output is provided.
+type securityGroupsOutput struct {
+	SecurityGroups []securityGroupOutput `json:"security_groups" yaml:"security_groups"`
+}
+
+// securityGroupOutput describes a single security group. Groups that are
+// running or staging defaults apply to all spaces in that lifecycle, which is
+// represented by the default flags rather than by bindings.
+type securityGroupOutput struct {
+	Name           string                       `json:"name" yaml:"name"`
+	GUID           string                       `json:"guid" yaml:"guid"`
+	RunningDefault bool                         `json:"running_default" yaml:"running_default"`
+	StagingDefault bool                         `json:"staging_default" yaml:"staging_default"`
+	Bindings       []securityGroupBindingOutput `json:"bindings" yaml:"bindings"`
+}
+
+// securityGroupBindingOutput describes a space a security group is bound to
+// and the lifecycle, running or staging, it is bound for.
+type securityGroupBindingOutput struct {
+	Organization string `json:"organization" yaml:"organization"`
+	Space        string `json:"space" yaml:"space"`
+	Lifecycle    string `json:"lifecycle" yaml:"lifecycle"`
+}
+
 //go:generate counterfeiter . SecurityGroupsActor
 
 type SecurityGroupsActor interface {
@@ -65,8 +91,11 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		}
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting security groups as {{.UserName}}...",
-		map[string]interface{}{"UserName": user.Name})
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Getting security groups as {{.UserName}}...",
+			map[string]interface{}{"UserName": user.Name})
+	}
 
 	secGroupOrgSpaces, warnings, err := cmd.Actor.GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeStaging)
 	cmd.UI.DisplayWarnings(warnings)
@@ -74,6 +103,10 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatText {
+		return cmd.displayStructuredOutput(outputFormat, secGroupOrgSpaces)
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
@@ -124,3 +157,37 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (SecurityGroupsCommand) SupportsStructuredOutput() {}
+
+func (cmd SecurityGroupsCommand) displayStructuredOutput(outputFormat configv3.OutputFormat, secGroupOrgSpaces []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) error {
+	output := securityGroupsOutput{SecurityGroups: []securityGroupOutput{}}
+
+	for _, secGroupOrgSpace := range secGroupOrgSpaces {
+		last := len(output.SecurityGroups) - 1
+		if last < 0 || output.SecurityGroups[last].Name != secGroupOrgSpace.SecurityGroup.Name {
+			output.SecurityGroups = append(output.SecurityGroups, securityGroupOutput{
+				Name:     secGroupOrgSpace.SecurityGroup.Name,
+				GUID:     secGroupOrgSpace.SecurityGroup.GUID,
+				Bindings: []securityGroupBindingOutput{},
+			})
+			last++
+		}
+
+		group := &output.SecurityGroups[last]
+		group.RunningDefault = group.RunningDefault || secGroupOrgSpace.SecurityGroup.RunningDefault
+		group.StagingDefault = group.StagingDefault || secGroupOrgSpace.SecurityGroup.StagingDefault
+
+		if secGroupOrgSpace.Organization.Name == "" && secGroupOrgSpace.Space.Name == "" {
+			continue
+		}
+
+		group.Bindings = append(group.Bindings, securityGroupBindingOutput{
+			Organization: secGroupOrgSpace.Organization.Name,
+			Space:        secGroupOrgSpace.Space.Name,
+			Lifecycle:    string(secGroupOrgSpace.Lifecycle),
+		})
+	}
+
+	return cmd.UI.DisplayStructuredOutput(outputFormat, output)
+}
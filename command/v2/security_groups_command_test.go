@@ -180,6 +180,46 @@ var _ = Describe("security-groups Command", func() {
 				Expect(testUI.Err).To(Say("warning-1"))
 				Expect(testUI.Err).To(Say("warning-2"))
 			})
+
+			Context("when the output format is yaml", func() {
+				BeforeEach(func() {
+					fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+				})
+
+				It("displays the security groups grouped by name as YAML", func() {
+					Expect(executeErr).To(BeNil())
+
+					Expect(testUI.Out).To(Say(`^security_groups:
+- name: seg-group-1
+  guid: ""
+  running_default: false
+  staging_default: false
+  bindings:
+  - organization: org-11
+    space: space-111
+    lifecycle: running
+  - organization: org-12
+    space: space-121
+    lifecycle: running
+  - organization: org-12
+    space: space-122
+    lifecycle: staging
+- name: seg-group-2
+  guid: ""
+  running_default: false
+  staging_default: false
+  bindings: \[\]
+- name: seg-group-3
+`))
+					Expect(testUI.Out).To(Say(`- name: seg-group-4
+  guid: ""
+  running_default: true
+  staging_default: true
+  bindings: \[\]
+`))
+					Expect(testUI.Err).To(Say("warning-1"))
+				})
+			})
 		})
 
 		Context("when an error is encountered fetching the security groups", func() {
@@ -5,10 +5,12 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type ServicesCommand struct {
-	usage           interface{} `usage:"CF_NAME services"`
+	ui.TableViewFlags
+	usage           interface{} `usage:"CF_NAME services [--columns COLUMN,...] [--sort-by COLUMN[:desc]] [--filter COLUMN=GLOB]..."`
 	relatedCommands interface{} `related_commands:"create-service, marketplace"`
 }
 
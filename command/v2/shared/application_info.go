@@ -13,6 +13,13 @@ import (
 // DisplayAppSummary displays the application summary to the UI, and optionally
 // the command to start the app.
 func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, displayStartCommand bool) {
+	DisplayTrackedAppSummary(ui, appSummary, displayStartCommand, nil)
+}
+
+// DisplayTrackedAppSummary displays the application summary like
+// DisplayAppSummary, highlighting the instances whose state changed since
+// tracker last saw them.
+func DisplayTrackedAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, displayStartCommand bool, tracker *InstanceStateTracker) {
 	instances := fmt.Sprintf("%d/%d", appSummary.StartingOrRunningInstanceCount(), appSummary.Instances.Value)
 
 	usage := ui.TranslateText(
@@ -55,11 +62,11 @@ func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, di
 	if len(appSummary.RunningInstances) == 0 {
 		ui.DisplayText("There are no running instances of this app.")
 	} else {
-		displayAppInstances(ui, appSummary.RunningInstances)
+		displayAppInstances(ui, appSummary.RunningInstances, tracker)
 	}
 }
 
-func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstanceWithStats) {
+func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstanceWithStats, tracker *InstanceStateTracker) {
 	table := [][]string{
 		{
 			"",
@@ -77,7 +84,7 @@ func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstance
 			table,
 			[]string{
 				fmt.Sprintf("#%d", instance.ID),
-				ui.TranslateText(strings.ToLower(string(instance.State))),
+				tracker.StateText(ui, fmt.Sprintf("#%d", instance.ID), string(instance.State)),
 				zuluDate(instance.TimeSinceCreation()),
 				fmt.Sprintf("%.1f%%", instance.CPU*100),
 				fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Memory)), bytefmt.ByteSize(uint64(instance.MemoryQuota))),
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 func HandleError(err error) error {
@@ -70,6 +71,15 @@ func HandleError(err error) error {
 		return translatableerror.RequiredNameForPushError{}
 	case pushaction.UploadFailedError:
 		return translatableerror.UploadFailedError{Err: HandleError(e.Err)}
+
+	case ui.InvalidLogFormatError:
+		return translatableerror.InvalidLogFormatError(e)
+	case ui.InvalidTableFilterError:
+		return translatableerror.InvalidTableFilterError(e)
+	case ui.InvalidTableSortError:
+		return translatableerror.InvalidTableSortError(e)
+	case ui.TableColumnNotFoundError:
+		return translatableerror.TableColumnNotFoundError(e)
 	}
 
 	return err
@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -144,6 +145,26 @@ var _ = Describe("HandleError", func() {
 			translatableerror.CommandLineArgsWithMultipleAppsError{},
 		),
 
+		Entry("ui.InvalidLogFormatError -> InvalidLogFormatError",
+			ui.InvalidLogFormatError{Format: "some-format", Err: err},
+			translatableerror.InvalidLogFormatError{Format: "some-format", Err: err},
+		),
+
+		Entry("ui.InvalidTableFilterError -> InvalidTableFilterError",
+			ui.InvalidTableFilterError{Filter: "some-filter"},
+			translatableerror.InvalidTableFilterError{Filter: "some-filter"},
+		),
+
+		Entry("ui.InvalidTableSortError -> InvalidTableSortError",
+			ui.InvalidTableSortError{SortBy: "some-sort"},
+			translatableerror.InvalidTableSortError{SortBy: "some-sort"},
+		),
+
+		Entry("ui.TableColumnNotFoundError -> TableColumnNotFoundError",
+			ui.TableColumnNotFoundError{Column: "some-column", Columns: []string{"name"}},
+			translatableerror.TableColumnNotFoundError{Column: "some-column", Columns: []string{"name"}},
+		),
+
 		Entry("default case -> original error",
 			err,
 			err),
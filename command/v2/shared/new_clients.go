@@ -16,14 +16,15 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 
 	verbose, location := config.Verbose()
 	if verbose {
-		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(command.RequestLoggerTerminalDisplay(config, ui)))
 	}
 
 	if location != nil {
-		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(command.RequestLoggerFileWriter(config, ui, location)))
 	}
 
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
+	authWrapper.SetTokenRefreshSkew(config.TokenRefreshSkew())
 
 	ccWrappers = append(ccWrappers, authWrapper)
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
@@ -64,18 +65,20 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		AppVersion:        config.BinaryVersion(),
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
+		GrantType:         uaa.GrantType(config.UAAGrantType()),
 		DialTimeout:       config.DialTimeout(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 	})
 
 	if verbose {
-		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(command.RequestLoggerTerminalDisplay(config, ui)))
 	}
 	if location != nil {
-		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(command.RequestLoggerFileWriter(config, ui, location)))
 	}
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(nil, config)
+	uaaAuthWrapper.SetTokenRefreshSkew(config.TokenRefreshSkew())
 	uaaClient.WrapConnection(uaaAuthWrapper)
 	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
 
@@ -0,0 +1,124 @@
+package shared
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// CrashCountWindow is how far back the rolling crash count of a watched app
+// looks.
+const CrashCountWindow = 5 * time.Minute
+
+// MaxWatchFailures is the number of refreshes in a row that can fail before
+// watching an app stops.
+const MaxWatchFailures = 10
+
+// InstanceStateTracker remembers the state of each instance of a watched app
+// between refreshes so that state transitions and crashes can be displayed.
+type InstanceStateTracker struct {
+	window  time.Duration
+	states  map[string]string
+	crashes []time.Time
+}
+
+// NewInstanceStateTracker returns a tracker that counts the crashes seen in
+// the last window.
+func NewInstanceStateTracker(window time.Duration) *InstanceStateTracker {
+	return &InstanceStateTracker{
+		window: window,
+		states: map[string]string{},
+	}
+}
+
+// StateText returns the translated state of the instance identified by key,
+// preceded by its previous state when it changed since the last refresh. A
+// change to CRASHED is counted as a crash. A nil tracker returns the state
+// alone.
+func (tracker *InstanceStateTracker) StateText(commandUI command.UI, key string, state string) string {
+	text := commandUI.TranslateText(strings.ToLower(state))
+	if tracker == nil {
+		return text
+	}
+
+	previous, seen := tracker.states[key]
+	tracker.states[key] = state
+	if !seen || previous == state {
+		return text
+	}
+
+	if state == "CRASHED" {
+		tracker.crashes = append(tracker.crashes, time.Now())
+	}
+	return commandUI.TranslateText(strings.ToLower(previous)) + ui.InstanceStateTransition + text
+}
+
+// CrashCount returns the number of crashes seen in the last window.
+func (tracker *InstanceStateTracker) CrashCount() int {
+	cutoff := time.Now().Add(-tracker.window)
+	for len(tracker.crashes) > 0 && tracker.crashes[0].Before(cutoff) {
+		tracker.crashes = tracker.crashes[1:]
+	}
+	return len(tracker.crashes)
+}
+
+// WatchApp calls fetch every interval and redraws the screen with the display
+// function it returns, followed by the rolling crash count. When fetch fails
+// the error is displayed as a warning below the last refresh and watching
+// continues, until MaxWatchFailures refreshes in a row have failed.
+func WatchApp(commandUI command.UI, interval time.Duration, tracker *InstanceStateTracker, fetch func() (func(), error)) error {
+	failures := 0
+	for {
+		display, err := fetch()
+		if err != nil {
+			failures++
+			if failures >= MaxWatchFailures {
+				return err
+			}
+
+			commandUI.DisplayWarning("Refreshing failed, retrying in {{.Interval}}: {{.Error}}", map[string]interface{}{
+				"Interval": interval,
+				"Error":    errorText(commandUI, err),
+			})
+			time.Sleep(interval)
+			continue
+		}
+		failures = 0
+
+		commandUI.ClearScreen()
+		display()
+
+		commandUI.DisplayNewline()
+		commandUI.DisplayText("Crashes in the last {{.Minutes}} minutes: {{.CrashCount}}", map[string]interface{}{
+			"Minutes":    int(tracker.window.Minutes()),
+			"CrashCount": tracker.CrashCount(),
+		})
+		commandUI.DisplayText("Refreshing every {{.Interval}}, press Ctrl-C to stop.", map[string]interface{}{
+			"Interval": interval,
+		})
+		commandUI.DisplayNewline()
+
+		time.Sleep(interval)
+	}
+}
+
+// errorText returns the message of err, translated if it is a
+// TranslatableError.
+func errorText(commandUI command.UI, err error) string {
+	translatableErr, ok := err.(translatableerror.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatableErr.Translate(func(template string, templateValues ...interface{}) string {
+		if len(templateValues) == 1 {
+			if values, ok := templateValues[0].(map[string]interface{}); ok {
+				return commandUI.TranslateText(template, values)
+			}
+		}
+		return commandUI.TranslateText(template)
+	})
+}
@@ -0,0 +1,77 @@
+package shared_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("InstanceStateTracker", func() {
+	var (
+		testUI  *ui.UI
+		tracker *InstanceStateTracker
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		tracker = NewInstanceStateTracker(time.Minute)
+	})
+
+	Describe("StateText", func() {
+		It("returns the state the first time an instance is seen", func() {
+			Expect(tracker.StateText(testUI, "#0", "STARTING")).To(Equal("starting"))
+		})
+
+		It("returns the previous and current state when the state changes", func() {
+			tracker.StateText(testUI, "#0", "STARTING")
+			Expect(tracker.StateText(testUI, "#0", "RUNNING")).To(Equal("starting → running"))
+			Expect(tracker.StateText(testUI, "#0", "RUNNING")).To(Equal("running"))
+		})
+
+		It("tracks each instance separately", func() {
+			tracker.StateText(testUI, "web#0", "RUNNING")
+			Expect(tracker.StateText(testUI, "worker#0", "CRASHED")).To(Equal("crashed"))
+			Expect(tracker.StateText(testUI, "web#0", "CRASHED")).To(Equal("running → crashed"))
+		})
+
+		Context("when the tracker is nil", func() {
+			It("returns the state", func() {
+				var nilTracker *InstanceStateTracker
+				Expect(nilTracker.StateText(testUI, "#0", "RUNNING")).To(Equal("running"))
+			})
+		})
+	})
+
+	Describe("CrashCount", func() {
+		It("counts the changes to crashed", func() {
+			tracker.StateText(testUI, "#0", "CRASHED")
+			Expect(tracker.CrashCount()).To(Equal(0))
+
+			tracker.StateText(testUI, "#1", "RUNNING")
+			tracker.StateText(testUI, "#1", "CRASHED")
+			tracker.StateText(testUI, "#1", "CRASHED")
+			tracker.StateText(testUI, "#1", "STARTING")
+			tracker.StateText(testUI, "#1", "CRASHED")
+			Expect(tracker.CrashCount()).To(Equal(2))
+		})
+
+		Context("when the crashes are older than the window", func() {
+			BeforeEach(func() {
+				tracker = NewInstanceStateTracker(10 * time.Millisecond)
+			})
+
+			It("stops counting them", func() {
+				tracker.StateText(testUI, "#0", "RUNNING")
+				tracker.StateText(testUI, "#0", "CRASHED")
+				Expect(tracker.CrashCount()).To(Equal(1))
+
+				Eventually(tracker.CrashCount).Should(Equal(0))
+			})
+		})
+	})
+})
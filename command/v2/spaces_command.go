@@ -5,10 +5,12 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type SpacesCommand struct {
-	usage           interface{} `usage:"CF_NAME spaces"`
+	ui.TableViewFlags
+	usage           interface{} `usage:"CF_NAME spaces [--columns COLUMN,...] [--sort-by COLUMN[:desc]] [--filter COLUMN=GLOB]..."`
 	relatedCommands interface{} `related_commands:"target"`
 }
 
@@ -1,32 +1,326 @@
 package v2
 
 import (
+	"bytes"
+	"fmt"
 	"os"
+	"strconv"
+	"sync"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/cf/models"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . SSHActor
+
+type SSHActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationInstancesWithStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
+	GetSSHAuthentication() (v2action.SSHAuthentication, error)
+}
+
+//go:generate counterfeiter . SecureShellFactory
+
+// SecureShellFactory creates the secure shell used to connect to a single
+// app instance.
+type SecureShellFactory interface {
+	NewSecureShell(app v2action.Application, authentication v2action.SSHAuthentication) sshCmd.SecureShell
+}
+
 type SSHCommand struct {
-	RequiredArgs        flag.AppName `positional-args:"yes"`
-	AppInstanceIndex    int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
-	Command             string       `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
-	DisablePseudoTTY    bool         `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY      bool         `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPort           string       `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
-	RemotePseudoTTY     bool         `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation  bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
-	SkipRemoteExecution bool         `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]"`
-	relatedCommands     interface{}  `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
-}
-
-func (SSHCommand) Setup(config command.Config, ui command.UI) error {
+	RequiredArgs        flag.AppName       `positional-args:"yes"`
+	AppInstanceIndex    flag.InstanceIndex `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	AllInstances        bool               `long:"all-instances" description:"Run the command on every running instance, prefixing its output with the instance index"`
+	Command             []string           `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
+	DisablePseudoTTY    bool               `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY      bool               `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	LocalPort           string             `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
+	RemotePort          string             `short:"R" description:"Remote port forward specification, listening on the app instance. This flag can be defined more than once."`
+	DynamicPort         string             `short:"D" description:"Dynamic port forward specification, a local SOCKS5 proxy that connects from the app instance. This flag can be defined more than once."`
+	Parallel            flag.Parallel      `long:"parallel" description:"Number of instances to run the command on at the same time with --all-instances (Default: 1)"`
+	RemotePseudoTTY     bool               `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation  bool               `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	SkipRemoteExecution bool               `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+	usage               interface{}        `usage:"CF_NAME ssh APP_NAME [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [-R [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [-D [BIND_ADDRESS:]PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]\n   CF_NAME ssh APP_NAME --all-instances -c COMMAND... [--parallel N] [--skip-host-validation]"`
+	relatedCommands     interface{}        `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-config, ssh-enabled"`
+
+	UI                 command.UI
+	Config             command.Config
+	SharedActor        command.SharedActor
+	Actor              SSHActor
+	SecureShellFactory SecureShellFactory
+}
+
+func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if !cmd.AllInstances {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.SecureShellFactory = secureShellFactory{}
+
+	return nil
+}
+
+func (cmd SSHCommand) Execute(args []string) error {
+	if !cmd.AllInstances {
+		if cmd.Parallel.IsSet {
+			return translatableerror.RequiredFlagsError{Arg1: "--parallel", Arg2: "--all-instances"}
+		}
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.validateAllInstancesFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	instances, err := cmd.getRunningInstances(app)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Running command on {{.InstanceCount}} instances of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"InstanceCount": len(instances),
+		"AppName":       app.Name,
+		"OrgName":       cmd.Config.TargetedOrganization().Name,
+		"SpaceName":     cmd.Config.TargetedSpace().Name,
+		"Username":      user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	results := cmd.runOnInstances(app, instances)
+
+	return cmd.displayExitStatuses(instances, results)
+}
+
+func (cmd SSHCommand) validateAllInstancesFlags() error {
+	switch {
+	case len(cmd.Command) == 0:
+		return translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command"}
+	case cmd.AppInstanceIndex.IsSet:
+		return translatableerror.ArgumentCombinationError{Arg1: "--all-instances", Arg2: "--app-instance-index"}
+	case cmd.LocalPort != "":
+		return translatableerror.ArgumentCombinationError{Arg1: "--all-instances", Arg2: "-L"}
+	case cmd.RemotePort != "":
+		return translatableerror.ArgumentCombinationError{Arg1: "--all-instances", Arg2: "-R"}
+	case cmd.DynamicPort != "":
+		return translatableerror.ArgumentCombinationError{Arg1: "--all-instances", Arg2: "-D"}
+	case cmd.SkipRemoteExecution:
+		return translatableerror.ArgumentCombinationError{Arg1: "--all-instances", Arg2: "--skip-remote-execution"}
+	case cmd.DisablePseudoTTY:
+		return translatableerror.ArgumentCombinationError{Arg1: "--all-instances", Arg2: "--disable-pseudo-tty"}
+	case cmd.ForcePseudoTTY:
+		return translatableerror.ArgumentCombinationError{Arg1: "--all-instances", Arg2: "--force-pseudo-tty"}
+	case cmd.RemotePseudoTTY:
+		return translatableerror.ArgumentCombinationError{Arg1: "--all-instances", Arg2: "--request-pseudo-tty"}
+	}
 	return nil
 }
 
-func (SSHCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SSHCommand) getRunningInstances(app v2action.Application) ([]v2action.ApplicationInstanceWithStats, error) {
+	instances, warnings, err := cmd.Actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(v2action.ApplicationInstancesNotFoundError); ok {
+		return nil, translatableerror.NoRunningInstancesError{AppName: app.Name}
+	} else if err != nil {
+		return nil, shared.HandleError(err)
+	}
+
+	running := []v2action.ApplicationInstanceWithStats{}
+	for _, instance := range instances {
+		if instance.Running() {
+			running = append(running, instance)
+		}
+	}
+
+	if len(running) == 0 {
+		return nil, translatableerror.NoRunningInstancesError{AppName: app.Name}
+	}
+	return running, nil
+}
+
+// runOnInstances runs the command on each instance, at most --parallel at a
+// time, and returns the result of each in the order of the instances.
+func (cmd SSHCommand) runOnInstances(app v2action.Application, instances []v2action.ApplicationInstanceWithStats) []error {
+	parallel := 1
+	if cmd.Parallel.IsSet {
+		parallel = cmd.Parallel.Value
+	}
+
+	results := make([]error, len(instances))
+	slots := make(chan struct{}, parallel)
+	wg := &sync.WaitGroup{}
+
+	for i, instance := range instances {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, index int) {
+			defer wg.Done()
+			results[i] = cmd.runOnInstance(app, index)
+			<-slots
+		}(i, instance.ID)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (cmd SSHCommand) runOnInstance(app v2action.Application, index int) error {
+	authentication, err := cmd.Actor.GetSSHAuthentication()
+	if err != nil {
+		return err
+	}
+
+	secureShell := cmd.SecureShellFactory.NewSecureShell(app, authentication)
+	err = secureShell.Connect(&options.SSHOptions{
+		AppName:            app.Name,
+		Index:              uint(index),
+		Command:            cmd.Command,
+		SkipHostValidation: cmd.SkipHostValidation,
+	})
+	if err != nil {
+		return err
+	}
+	defer secureShell.Close()
+
+	stdout := newInstanceOutputWriter(func(line string) {
+		cmd.UI.DisplayText("[{{.Index}}] {{.Line}}", map[string]interface{}{"Index": index, "Line": line})
+	})
+	stderr := newInstanceOutputWriter(func(line string) {
+		cmd.UI.DisplayWarning("[{{.Index}}] {{.Line}}", map[string]interface{}{"Index": index, "Line": line})
+	})
+
+	err = secureShell.RunCommand(stdout, stderr)
+	stdout.Flush()
+	stderr.Flush()
+	return err
+}
+
+func (cmd SSHCommand) displayExitStatuses(instances []v2action.ApplicationInstanceWithStats, results []error) error {
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("exit status"),
+		},
+	}
+
+	failed := 0
+	for i, result := range results {
+		status := "0"
+		switch err := result.(type) {
+		case nil:
+		case exitStatusError:
+			status = strconv.Itoa(err.ExitStatus())
+		default:
+			status = cmd.UI.TranslateText("error: {{.Error}}", map[string]interface{}{"Error": err.Error()})
+		}
+		if result != nil {
+			failed++
+		}
+
+		table = append(table, []string{fmt.Sprintf("#%d", instances[i].ID), status})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if failed > 0 {
+		return translatableerror.SSHCommandFailedError{Failed: failed, Total: len(results)}
+	}
 	return nil
 }
+
+// exitStatusError is the error returned by a command that exits with a non
+// zero status, such as *ssh.ExitError.
+type exitStatusError interface {
+	error
+	ExitStatus() int
+}
+
+type secureShellFactory struct{}
+
+func (secureShellFactory) NewSecureShell(app v2action.Application, authentication v2action.SSHAuthentication) sshCmd.SecureShell {
+	return sshCmd.NewSecureShell(
+		sshCmd.DefaultSecureDialer(),
+		sshTerminal.DefaultHelper(),
+		sshCmd.DefaultListenerFactory(),
+		30*time.Second,
+		models.Application{
+			ApplicationFields: models.ApplicationFields{
+				GUID:  app.GUID,
+				Name:  app.Name,
+				State: string(app.State),
+				Diego: app.Diego,
+			},
+		},
+		authentication.HostKeyFingerprint,
+		authentication.Endpoint,
+		authentication.Passcode,
+	)
+}
+
+// instanceOutputWriter calls display with each complete line written to it.
+type instanceOutputWriter struct {
+	display func(line string)
+	buffer  bytes.Buffer
+}
+
+func newInstanceOutputWriter(display func(line string)) *instanceOutputWriter {
+	return &instanceOutputWriter{display: display}
+}
+
+func (w *instanceOutputWriter) Write(p []byte) (int, error) {
+	w.buffer.Write(p)
+	for {
+		i := bytes.IndexByte(w.buffer.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := w.buffer.Next(i + 1)
+		w.display(string(bytes.TrimRight(line, "\r\n")))
+	}
+}
+
+// Flush displays the last line when it does not end in a newline.
+func (w *instanceOutputWriter) Flush() {
+	if w.buffer.Len() > 0 {
+		w.display(w.buffer.String())
+		w.buffer.Reset()
+	}
+}
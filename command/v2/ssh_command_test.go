@@ -0,0 +1,298 @@
+package v2_test
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+type exitStatusError int
+
+func (e exitStatusError) Error() string   { return "Process exited with status" }
+func (e exitStatusError) ExitStatus() int { return int(e) }
+
+var _ = Describe("ssh Command", func() {
+	var (
+		cmd                    SSHCommand
+		testUI                 *ui.UI
+		fakeConfig             *commandfakes.FakeConfig
+		fakeSharedActor        *commandfakes.FakeSharedActor
+		fakeActor              *v2fakes.FakeSSHActor
+		fakeSecureShellFactory *v2fakes.FakeSecureShellFactory
+		secureShells           []*sshfakes.FakeSecureShell
+		secureShellsMutex      sync.Mutex
+		binaryName             string
+		executeErr             error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSSHActor)
+		fakeSecureShellFactory = new(v2fakes.FakeSecureShellFactory)
+
+		cmd = SSHCommand{
+			RequiredArgs:       flag.AppName{AppName: "some-app"},
+			AllInstances:       true,
+			Command:            []string{"uptime"},
+			UI:                 testUI,
+			Config:             fakeConfig,
+			SharedActor:        fakeSharedActor,
+			Actor:              fakeActor,
+			SecureShellFactory: fakeSecureShellFactory,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v2action.Warnings{"app-warning"},
+			nil)
+		fakeActor.GetApplicationInstancesWithStatsByApplicationReturns(
+			[]v2action.ApplicationInstanceWithStats{
+				{ID: 0, State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning)},
+				{ID: 1, State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceCrashed)},
+				{ID: 2, State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning)},
+			},
+			v2action.Warnings{"instances-warning"},
+			nil)
+		fakeActor.GetSSHAuthenticationReturns(v2action.SSHAuthentication{Passcode: "some-passcode"}, nil)
+
+		secureShells = nil
+		fakeSecureShellFactory.NewSecureShellStub = func(v2action.Application, v2action.SSHAuthentication) sshCmd.SecureShell {
+			secureShell := new(sshfakes.FakeSecureShell)
+			secureShell.ConnectStub = func(opts *options.SSHOptions) error {
+				secureShell.RunCommandStub = func(stdout io.Writer, stderr io.Writer) error {
+					_, _ = io.WriteString(stdout, "up on instance\n")
+					if opts.Index == 2 {
+						_, _ = io.WriteString(stderr, "warning from instance\npartial line")
+						return exitStatusError(3)
+					}
+					return nil
+				}
+				return nil
+			}
+			secureShellsMutex.Lock()
+			secureShells = append(secureShells, secureShell)
+			secureShellsMutex.Unlock()
+			return secureShell
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --parallel is provided without --all-instances", func() {
+		BeforeEach(func() {
+			cmd.AllInstances = false
+			cmd.Parallel = flag.Parallel{NullInt: types.NullInt{Value: 2, IsSet: true}}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--parallel",
+				Arg2: "--all-instances",
+			}))
+		})
+	})
+
+	Context("when --all-instances is provided without a command", func() {
+		BeforeEach(func() {
+			cmd.Command = nil
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--all-instances",
+				Arg2: "--command",
+			}))
+		})
+	})
+
+	Context("when --all-instances is provided with an instance index", func() {
+		BeforeEach(func() {
+			cmd.AppInstanceIndex = flag.InstanceIndex{NullInt: types.NullInt{IsSet: true, Value: 0}}
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Arg1: "--all-instances",
+				Arg2: "--app-instance-index",
+			}))
+		})
+	})
+
+	Context("when --all-instances is provided with port forwarding", func() {
+		BeforeEach(func() {
+			cmd.LocalPort = "8080:localhost:8080"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Arg1: "--all-instances",
+				Arg2: "-L",
+			}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+		})
+	})
+
+	Context("when the app has no running instances", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationInstancesWithStatsByApplicationReturns(
+				[]v2action.ApplicationInstanceWithStats{
+					{ID: 0, State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceStarting)},
+				},
+				nil,
+				nil)
+		})
+
+		It("returns a NoRunningInstancesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoRunningInstancesError{AppName: "some-app"}))
+			Expect(fakeSecureShellFactory.NewSecureShellCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app is stopped", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationInstancesWithStatsByApplicationReturns(nil, nil, v2action.ApplicationInstancesNotFoundError{ApplicationGUID: "some-app-guid"})
+		})
+
+		It("returns a NoRunningInstancesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoRunningInstancesError{AppName: "some-app"}))
+		})
+	})
+
+	Context("when the command is run on the running instances", func() {
+		It("runs the command over a separate session on each running instance", func() {
+			Expect(testUI.Out).To(Say(`Running command on 2 instances of app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("instances-warning"))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetApplicationInstancesWithStatsByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+
+			Expect(fakeActor.GetSSHAuthenticationCallCount()).To(Equal(2))
+			Expect(fakeSecureShellFactory.NewSecureShellCallCount()).To(Equal(2))
+			app, authentication := fakeSecureShellFactory.NewSecureShellArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(authentication.Passcode).To(Equal("some-passcode"))
+
+			Expect(secureShells).To(HaveLen(2))
+			Expect(secureShells[0].ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+				AppName: "some-app",
+				Index:   0,
+				Command: []string{"uptime"},
+			}))
+			Expect(secureShells[1].ConnectArgsForCall(0).Index).To(Equal(uint(2)))
+			for _, secureShell := range secureShells {
+				Expect(secureShell.RunCommandCallCount()).To(Equal(1))
+				Expect(secureShell.CloseCallCount()).To(Equal(1))
+			}
+		})
+
+		It("prefixes the output with the instance index", func() {
+			Expect(testUI.Out).To(Say(`\[0\] up on instance`))
+			Expect(testUI.Out).To(Say(`\[2\] up on instance`))
+			Expect(testUI.Err).To(Say(`\[2\] warning from instance`))
+			Expect(testUI.Err).To(Say(`\[2\] partial line`))
+		})
+
+		It("displays the exit status of each instance and returns an error when any failed", func() {
+			Expect(testUI.Out).To(Say(`exit status`))
+			Expect(testUI.Out).To(Say(`#0\s+0`))
+			Expect(testUI.Out).To(Say(`#2\s+3`))
+			Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{Failed: 1, Total: 2}))
+		})
+
+		Context("when connecting to an instance fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSSHAuthenticationReturnsOnCall(0, v2action.SSHAuthentication{}, errors.New("passcode denied"))
+			})
+
+			It("displays the error as that instance's status and continues", func() {
+				Expect(testUI.Out).To(Say(`#0\s+error: passcode denied`))
+				Expect(testUI.Out).To(Say(`#2\s+3`))
+				Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{Failed: 2, Total: 2}))
+			})
+		})
+
+		Context("when --parallel is provided", func() {
+			BeforeEach(func() {
+				cmd.Parallel = flag.Parallel{NullInt: types.NullInt{Value: 2, IsSet: true}}
+				cmd.SkipHostValidation = true
+			})
+
+			It("runs the command on every instance", func() {
+				Expect(fakeSecureShellFactory.NewSecureShellCallCount()).To(Equal(2))
+				Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{Failed: 1, Total: 2}))
+			})
+		})
+
+		Context("when the command succeeds on every instance", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationInstancesWithStatsByApplicationReturns(
+					[]v2action.ApplicationInstanceWithStats{
+						{ID: 0, State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning)},
+					},
+					nil,
+					nil)
+			})
+
+			It("does not return an error", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`#0\s+0`))
+			})
+		})
+	})
+})
@@ -0,0 +1,122 @@
+package v2
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . SSHConfigActor
+
+type SSHConfigActor interface {
+	AppSSHEndpoint() string
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+}
+
+type SSHConfigCommand struct {
+	RequiredArgs     flag.AppName `positional-args:"yes"`
+	AppInstanceIndex uint         `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	usage            interface{}  `usage:"CF_NAME ssh-config APP_NAME [-i INDEX]\n\nEXAMPLES:\n   CF_NAME ssh-config my-app >> ~/.ssh/config\n   ssh cf-my-app-0"`
+	relatedCommands  interface{}  `related_commands:"ssh, ssh-proxy"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SSHConfigActor
+}
+
+func (cmd *SSHConfigCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd SSHConfigCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	endpoint := cmd.Actor.AppSSHEndpoint()
+	if endpoint == "" {
+		return translatableerror.SSHEndpointNotFoundError{}
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		host, port = endpoint, ""
+	}
+
+	return sshConfigTemplate.Execute(cmd.UI.Writer(), sshConfigHost{
+		Alias:      fmt.Sprintf("cf-%s-%d", sshConfigAliasPattern.ReplaceAllString(app.Name, "-"), cmd.AppInstanceIndex),
+		HostName:   host,
+		Port:       port,
+		User:       fmt.Sprintf("cf:%s/%d", app.GUID, cmd.AppInstanceIndex),
+		BinaryName: cmd.Config.BinaryName(),
+		AppName:    shellQuote(app.Name),
+		Index:      cmd.AppInstanceIndex,
+	})
+}
+
+// sshConfigHost is a Host block of an SSH config file. ssh connects through
+// 'ssh-proxy', which authenticates with a new one time code and checks the
+// host key of the SSH endpoint itself, so ssh does not check host keys.
+type sshConfigHost struct {
+	Alias      string
+	HostName   string
+	Port       string
+	User       string
+	BinaryName string
+	AppName    string
+	Index      uint
+}
+
+var sshConfigTemplate = template.Must(template.New("ssh-config").Parse(`Host {{.Alias}}
+    HostName {{.HostName}}
+{{- if .Port}}
+    Port {{.Port}}
+{{- end}}
+    User {{.User}}
+    ProxyCommand {{.BinaryName}} ssh-proxy {{.AppName}} -i {{.Index}}
+    StrictHostKeyChecking no
+    UserKnownHostsFile /dev/null
+`))
+
+var (
+	// sshConfigAliasPattern matches the characters that are replaced in the
+	// app name to make the Host alias.
+	sshConfigAliasPattern = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+	shellSafePattern = regexp.MustCompile(`^[A-Za-z0-9._/-]+$`)
+)
+
+// shellQuote quotes s for a POSIX shell, which runs the ProxyCommand.
+func shellQuote(s string) string {
+	if shellSafePattern.MatchString(s) {
+		return s
+	}
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,145 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ssh-config Command", func() {
+	var (
+		cmd             SSHConfigCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSSHConfigActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSSHConfigActor)
+
+		cmd = SSHConfigCommand{
+			RequiredArgs:     flag.AppName{AppName: "some-app"},
+			AppInstanceIndex: 2,
+			UI:               testUI,
+			Config:           fakeConfig,
+			SharedActor:      fakeSharedActor,
+			Actor:            fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		fakeActor.AppSSHEndpointReturns("ssh.example.com:2222")
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v2action.Warnings{"app-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(
+				sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeTrue())
+			Expect(targetedSpaceRequired).To(BeTrue())
+		})
+	})
+
+	Context("when the API has no SSH endpoint", func() {
+		BeforeEach(func() {
+			fakeActor.AppSSHEndpointReturns("")
+		})
+
+		It("returns an SSHEndpointNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHEndpointNotFoundError{}))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{},
+				v2action.Warnings{"app-warning"},
+				v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+		})
+	})
+
+	It("displays a Host that connects through ssh-proxy", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(testUI.Out).To(Say(`Host cf-some-app-2
+    HostName ssh\.example\.com
+    Port 2222
+    User cf:some-app-guid/2
+    ProxyCommand faceman ssh-proxy some-app -i 2
+    StrictHostKeyChecking no
+    UserKnownHostsFile /dev/null
+`))
+		Expect(testUI.Err).To(Say("app-warning"))
+	})
+
+	Context("when the SSH endpoint has no port", func() {
+		BeforeEach(func() {
+			fakeActor.AppSSHEndpointReturns("ssh.example.com")
+		})
+
+		It("leaves the port out", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`HostName ssh\.example\.com
+    User`))
+		})
+	})
+
+	Context("when the app name has characters that are special to the shell", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{Name: "bob's app", GUID: "some-app-guid"},
+				nil,
+				nil)
+		})
+
+		It("replaces them in the Host and quotes the app name in the ProxyCommand", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Host cf-bob-s-app-2\n"))
+			Expect(testUI.Out).To(Say(`ProxyCommand faceman ssh-proxy 'bob'\\''s app' -i 2`))
+		})
+	})
+})
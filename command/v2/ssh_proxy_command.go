@@ -0,0 +1,89 @@
+package v2
+
+import (
+	"io"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . SSHProxyActor
+
+type SSHProxyActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetSSHAuthentication() (v2action.SSHAuthentication, error)
+}
+
+type SSHProxyCommand struct {
+	RequiredArgs     flag.AppName `positional-args:"yes"`
+	AppInstanceIndex uint         `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	usage            interface{}  `usage:"CF_NAME ssh-proxy APP_NAME [-i INDEX]\n\n   Relays an SSH client on standard input and output to the app instance. It is used\n   as the ProxyCommand of the Host written by 'CF_NAME ssh-config'."`
+	relatedCommands  interface{}  `related_commands:"ssh, ssh-config"`
+
+	UI                 command.UI
+	Config             command.Config
+	SharedActor        command.SharedActor
+	Actor              SSHProxyActor
+	SecureShellFactory SecureShellFactory
+	Stdin              io.Reader
+	Stdout             io.WriteCloser
+}
+
+func (cmd *SSHProxyCommand) Setup(config command.Config, commandUI command.UI) error {
+	// Standard output carries the SSH connection, so everything else,
+	// including request logs and FAILED, is displayed on standard error.
+	if terminalUI, ok := commandUI.(*ui.UI); ok {
+		terminalUI.Out = terminalUI.Err
+	}
+
+	cmd.UI = commandUI
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+	cmd.SecureShellFactory = secureShellFactory{}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+
+	ccClient, uaaClient, err := shared.NewClients(config, commandUI, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd SSHProxyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	authentication, err := cmd.Actor.GetSSHAuthentication()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	secureShell := cmd.SecureShellFactory.NewSecureShell(app, authentication)
+	err = secureShell.Connect(&options.SSHOptions{
+		AppName: app.Name,
+		Index:   cmd.AppInstanceIndex,
+	})
+	if err != nil {
+		return err
+	}
+	defer secureShell.Close()
+
+	return secureShell.Proxy(cmd.Stdin, cmd.Stdout)
+}
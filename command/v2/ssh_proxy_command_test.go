@@ -0,0 +1,167 @@
+package v2_test
+
+import (
+	"errors"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ssh-proxy Command", func() {
+	var (
+		cmd                    SSHProxyCommand
+		testUI                 *ui.UI
+		fakeConfig             *commandfakes.FakeConfig
+		fakeSharedActor        *commandfakes.FakeSharedActor
+		fakeActor              *v2fakes.FakeSSHProxyActor
+		fakeSecureShellFactory *v2fakes.FakeSecureShellFactory
+		fakeSecureShell        *sshfakes.FakeSecureShell
+		stdin                  *strings.Reader
+		stdout                 *Buffer
+		binaryName             string
+		executeErr             error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSSHProxyActor)
+		fakeSecureShellFactory = new(v2fakes.FakeSecureShellFactory)
+		fakeSecureShell = new(sshfakes.FakeSecureShell)
+		fakeSecureShellFactory.NewSecureShellReturns(fakeSecureShell)
+		stdin = strings.NewReader("SSH-2.0-OpenSSH")
+		stdout = NewBuffer()
+
+		cmd = SSHProxyCommand{
+			RequiredArgs:       flag.AppName{AppName: "some-app"},
+			AppInstanceIndex:   1,
+			UI:                 testUI,
+			Config:             fakeConfig,
+			SharedActor:        fakeSharedActor,
+			Actor:              fakeActor,
+			SecureShellFactory: fakeSecureShellFactory,
+			Stdin:              stdin,
+			Stdout:             stdout,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v2action.Warnings{"app-warning"},
+			nil)
+		fakeActor.GetSSHAuthenticationReturns(v2action.SSHAuthentication{
+			Endpoint:           "ssh.example.com:2222",
+			HostKeyFingerprint: "some-fingerprint",
+			Passcode:           "s3curep4ss",
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(
+				sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeSecureShellFactory.NewSecureShellCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{},
+				v2action.Warnings{"app-warning"},
+				v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+		})
+	})
+
+	Context("when getting the one time code fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSSHAuthenticationReturns(v2action.SSHAuthentication{}, errors.New("uaa is down"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("uaa is down"))
+			Expect(fakeSecureShellFactory.NewSecureShellCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when connecting fails", func() {
+		BeforeEach(func() {
+			fakeSecureShell.ConnectReturns(errors.New("host key mismatch"))
+		})
+
+		It("returns the error without proxying", func() {
+			Expect(executeErr).To(MatchError("host key mismatch"))
+			Expect(fakeSecureShell.ProxyCallCount()).To(Equal(0))
+		})
+	})
+
+	It("proxies standard input and output to the app instance", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeSecureShellFactory.NewSecureShellCallCount()).To(Equal(1))
+		app, authentication := fakeSecureShellFactory.NewSecureShellArgsForCall(0)
+		Expect(app.GUID).To(Equal("some-app-guid"))
+		Expect(authentication.Passcode).To(Equal("s3curep4ss"))
+
+		Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+		Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+			AppName: "some-app",
+			Index:   1,
+		}))
+
+		Expect(fakeSecureShell.ProxyCallCount()).To(Equal(1))
+		in, out := fakeSecureShell.ProxyArgsForCall(0)
+		Expect(in).To(Equal(stdin))
+		Expect(out).To(Equal(stdout))
+		Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+
+		Expect(testUI.Out).ToNot(Say("."))
+		Expect(testUI.Err).To(Say("app-warning"))
+	})
+
+	Context("when proxying fails", func() {
+		BeforeEach(func() {
+			fakeSecureShell.ProxyReturns(errors.New("connection reset"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("connection reset"))
+			Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+		})
+	})
+})
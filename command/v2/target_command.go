@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -19,10 +20,11 @@ type TargetActor interface {
 }
 
 type TargetCommand struct {
-	Organization    string      `short:"o" description:"Organization"`
-	Space           string      `short:"s" description:"Space"`
-	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
-	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
+	RequiredArgs    flag.OptionalTargetName `positional-args:"yes"`
+	Organization    string                  `short:"o" description:"Organization"`
+	Space           string                  `short:"s" description:"Space"`
+	usage           interface{}             `usage:"CF_NAME target [-o ORG] [-s SPACE]\n   CF_NAME target NAME [-o ORG] [-s SPACE]\n\nEXAMPLES:\n   CF_NAME target -o my-org -s my-space\n   CF_NAME target prod (switch to the target saved with 'CF_NAME save-target prod')"`
+	relatedCommands interface{}             `related_commands:"create-org, create-space, login, orgs, save-target, spaces, targets"`
 
 	UI          command.UI
 	Config      command.Config
@@ -35,6 +37,13 @@ func (cmd *TargetCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.SharedActor = sharedaction.NewActor()
 
+	if cmd.RequiredArgs.TargetName != "" {
+		err := config.SwitchTargetProfile(cmd.RequiredArgs.TargetName)
+		if err != nil {
+			return err
+		}
+	}
+
 	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
 	if err != nil {
 		return err
@@ -45,6 +54,13 @@ func (cmd *TargetCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd *TargetCommand) Execute(args []string) error {
+	if cmd.RequiredArgs.TargetName != "" {
+		cmd.UI.DisplayText("Switched to saved target {{.TargetName}}.", map[string]interface{}{
+			"TargetName": cmd.RequiredArgs.TargetName,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
 	err := command.WarnAPIVersionCheck(cmd.Config, cmd.UI)
 	if err != nil {
 		return err
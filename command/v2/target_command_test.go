@@ -148,6 +148,20 @@ var _ = Describe("target Command", func() {
 						nil)
 				})
 
+				Context("when a saved target name is provided", func() {
+					BeforeEach(func() {
+						cmd.RequiredArgs.TargetName = "some-saved-target"
+					})
+
+					It("displays the saved target that was switched to and the target information", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Switched to saved target some-saved-target."))
+						Expect(testUI.Out).To(Say("api endpoint:   some-api-target"))
+						Expect(testUI.Out).To(Say("user:           some-user"))
+					})
+				})
+
 				Context("when no arguments are provided", func() {
 					Context("when no org or space are targeted", func() {
 						It("displays how to target an org and space", func() {
@@ -555,4 +569,26 @@ var _ = Describe("target Command", func() {
 			})
 		})
 	})
+
+	Describe("Setup", func() {
+		Context("when a saved target name is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.TargetName = "some-saved-target"
+			})
+
+			Context("when switching to the saved target fails", func() {
+				BeforeEach(func() {
+					fakeConfig.SwitchTargetProfileReturns(translatableerror.TargetProfileNotFoundError{Name: "some-saved-target"})
+				})
+
+				It("returns the error", func() {
+					err := cmd.Setup(fakeConfig, testUI)
+					Expect(err).To(MatchError(translatableerror.TargetProfileNotFoundError{Name: "some-saved-target"}))
+
+					Expect(fakeConfig.SwitchTargetProfileCallCount()).To(Equal(1))
+					Expect(fakeConfig.SwitchTargetProfileArgsForCall(0)).To(Equal("some-saved-target"))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,63 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+)
+
+type TargetsCommand struct {
+	usage           interface{} `usage:"CF_NAME targets"`
+	relatedCommands interface{} `related_commands:"delete-target, save-target, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *TargetsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd TargetsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting saved targets...")
+	cmd.UI.DisplayNewline()
+
+	profiles, err := cmd.Config.TargetProfiles()
+	if err != nil {
+		return err
+	}
+
+	if len(profiles) == 0 {
+		cmd.UI.DisplayText("No saved targets found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+
+	currentName := cmd.Config.TargetProfileName()
+	for _, profile := range profiles {
+		current := ""
+		if profile.Name == currentName {
+			current = "*"
+		}
+
+		table = append(table, []string{
+			current,
+			profile.Name,
+			profile.Target,
+			profile.TargetedOrganization.Name,
+			profile.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
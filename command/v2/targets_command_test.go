@@ -0,0 +1,89 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("targets Command", func() {
+	var (
+		cmd        TargetsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = TargetsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when there are no saved targets", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetProfilesReturns([]configv3.TargetProfile{}, nil)
+		})
+
+		It("displays that there are no saved targets", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting saved targets..."))
+			Expect(testUI.Out).To(Say("No saved targets found."))
+		})
+	})
+
+	Context("when there are saved targets", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetProfilesReturns([]configv3.TargetProfile{
+				{
+					Name:                 "dev",
+					Target:               "https://api.dev.com",
+					TargetedOrganization: configv3.Organization{Name: "dev-org"},
+					TargetedSpace:        configv3.Space{Name: "dev-space"},
+				},
+				{
+					Name:   "prod",
+					Target: "https://api.prod.com",
+				},
+			}, nil)
+			fakeConfig.TargetProfileNameReturns("prod")
+		})
+
+		It("displays the saved targets and marks the current one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting saved targets..."))
+			Expect(testUI.Out).To(Say(`\s+name\s+api endpoint\s+org\s+space`))
+			Expect(testUI.Out).To(Say(`\s+dev\s+https://api.dev.com\s+dev-org\s+dev-space`))
+			Expect(testUI.Out).To(Say(`\*\s+prod\s+https://api.prod.com`))
+		})
+	})
+
+	Context("when listing the saved targets fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeConfig.TargetProfilesReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
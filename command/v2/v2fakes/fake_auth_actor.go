@@ -22,6 +22,19 @@ type FakeAuthActor struct {
 	authenticateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuthenticateClientStub        func(config v2action.Config, clientID string, clientSecret string) error
+	authenticateClientMutex       sync.RWMutex
+	authenticateClientArgsForCall []struct {
+		config       v2action.Config
+		clientID     string
+		clientSecret string
+	}
+	authenticateClientReturns struct {
+		result1 error
+	}
+	authenticateClientReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -76,11 +89,63 @@ func (fake *FakeAuthActor) AuthenticateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeAuthActor) AuthenticateClient(config v2action.Config, clientID string, clientSecret string) error {
+	fake.authenticateClientMutex.Lock()
+	ret, specificReturn := fake.authenticateClientReturnsOnCall[len(fake.authenticateClientArgsForCall)]
+	fake.authenticateClientArgsForCall = append(fake.authenticateClientArgsForCall, struct {
+		config       v2action.Config
+		clientID     string
+		clientSecret string
+	}{config, clientID, clientSecret})
+	fake.recordInvocation("AuthenticateClient", []interface{}{config, clientID, clientSecret})
+	fake.authenticateClientMutex.Unlock()
+	if fake.AuthenticateClientStub != nil {
+		return fake.AuthenticateClientStub(config, clientID, clientSecret)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.authenticateClientReturns.result1
+}
+
+func (fake *FakeAuthActor) AuthenticateClientCallCount() int {
+	fake.authenticateClientMutex.RLock()
+	defer fake.authenticateClientMutex.RUnlock()
+	return len(fake.authenticateClientArgsForCall)
+}
+
+func (fake *FakeAuthActor) AuthenticateClientArgsForCall(i int) (v2action.Config, string, string) {
+	fake.authenticateClientMutex.RLock()
+	defer fake.authenticateClientMutex.RUnlock()
+	return fake.authenticateClientArgsForCall[i].config, fake.authenticateClientArgsForCall[i].clientID, fake.authenticateClientArgsForCall[i].clientSecret
+}
+
+func (fake *FakeAuthActor) AuthenticateClientReturns(result1 error) {
+	fake.AuthenticateClientStub = nil
+	fake.authenticateClientReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuthActor) AuthenticateClientReturnsOnCall(i int, result1 error) {
+	fake.AuthenticateClientStub = nil
+	if fake.authenticateClientReturnsOnCall == nil {
+		fake.authenticateClientReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateClientReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeAuthActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateClientMutex.RLock()
+	defer fake.authenticateClientMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -9,13 +9,14 @@ import (
 )
 
 type FakeLogsActor struct {
-	GetRecentLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetRecentLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error)
 	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
 		appName   string
 		spaceGUID string
 		client    v2action.NOAAClient
 		config    v2action.Config
+		filter    v2action.LogMessageFilter
 	}
 	getRecentLogsForApplicationByNameAndSpaceReturns struct {
 		result1 []v2action.LogMessage
@@ -27,13 +28,51 @@ type FakeLogsActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
-	GetStreamingLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetRecentLogsForApplicationsByNameAndSpaceStub        func(appNames []string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error)
+	getRecentLogsForApplicationsByNameAndSpaceMutex       sync.RWMutex
+	getRecentLogsForApplicationsByNameAndSpaceArgsForCall []struct {
+		appNames  []string
+		spaceGUID string
+		client    v2action.NOAAClient
+		config    v2action.Config
+		filter    v2action.LogMessageFilter
+	}
+	getRecentLogsForApplicationsByNameAndSpaceReturns struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRecentLogsForApplicationsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRecentLogsForSpaceStub        func(spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error)
+	getRecentLogsForSpaceMutex       sync.RWMutex
+	getRecentLogsForSpaceArgsForCall []struct {
+		spaceGUID string
+		client    v2action.NOAAClient
+		config    v2action.Config
+		filter    v2action.LogMessageFilter
+	}
+	getRecentLogsForSpaceReturns struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRecentLogsForSpaceReturnsOnCall map[int]struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStreamingLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
 		appName   string
 		spaceGUID string
 		client    v2action.NOAAClient
 		config    v2action.Config
+		filter    v2action.LogMessageFilter
 	}
 	getStreamingLogsForApplicationByNameAndSpaceReturns struct {
 		result1 <-chan *v2action.LogMessage
@@ -47,11 +86,52 @@ type FakeLogsActor struct {
 		result3 v2action.Warnings
 		result4 error
 	}
+	GetStreamingLogsForApplicationsByNameAndSpaceStub        func(appNames []string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	getStreamingLogsForApplicationsByNameAndSpaceMutex       sync.RWMutex
+	getStreamingLogsForApplicationsByNameAndSpaceArgsForCall []struct {
+		appNames  []string
+		spaceGUID string
+		client    v2action.NOAAClient
+		config    v2action.Config
+		filter    v2action.LogMessageFilter
+	}
+	getStreamingLogsForApplicationsByNameAndSpaceReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	GetStreamingLogsForSpaceStub        func(spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	getStreamingLogsForSpaceMutex       sync.RWMutex
+	getStreamingLogsForSpaceArgsForCall []struct {
+		spaceGUID string
+		client    v2action.NOAAClient
+		config    v2action.Config
+		filter    v2action.LogMessageFilter
+	}
+	getStreamingLogsForSpaceReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	getStreamingLogsForSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error) {
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
 	fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
@@ -59,11 +139,12 @@ func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(appName str
 		spaceGUID string
 		client    v2action.NOAAClient
 		config    v2action.Config
-	}{appName, spaceGUID, client, config})
-	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{appName, spaceGUID, client, config})
+		filter    v2action.LogMessageFilter
+	}{appName, spaceGUID, client, config, filter})
+	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{appName, spaceGUID, client, config, filter})
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
 	if fake.GetRecentLogsForApplicationByNameAndSpaceStub != nil {
-		return fake.GetRecentLogsForApplicationByNameAndSpaceStub(appName, spaceGUID, client, config)
+		return fake.GetRecentLogsForApplicationByNameAndSpaceStub(appName, spaceGUID, client, config, filter)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -77,10 +158,10 @@ func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceCallCount()
 	return len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.NOAAClient, v2action.Config) {
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.NOAAClient, v2action.Config, v2action.LogMessageFilter) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
-	return fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].appName, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].spaceGUID, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].client, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].config
+	return fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].appName, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].spaceGUID, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].client, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].config, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].filter
 }
 
 func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceReturns(result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
@@ -108,7 +189,127 @@ func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCal
 	}{result1, result2, result3}
 }
 
-func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error) {
+	var appNamesCopy []string
+	if appNames != nil {
+		appNamesCopy = make([]string, len(appNames))
+		copy(appNamesCopy, appNames)
+	}
+	fake.getRecentLogsForApplicationsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationsByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationsByNameAndSpaceArgsForCall)]
+	fake.getRecentLogsForApplicationsByNameAndSpaceArgsForCall = append(fake.getRecentLogsForApplicationsByNameAndSpaceArgsForCall, struct {
+		appNames  []string
+		spaceGUID string
+		client    v2action.NOAAClient
+		config    v2action.Config
+		filter    v2action.LogMessageFilter
+	}{appNamesCopy, spaceGUID, client, config, filter})
+	fake.recordInvocation("GetRecentLogsForApplicationsByNameAndSpace", []interface{}{appNamesCopy, spaceGUID, client, config, filter})
+	fake.getRecentLogsForApplicationsByNameAndSpaceMutex.Unlock()
+	if fake.GetRecentLogsForApplicationsByNameAndSpaceStub != nil {
+		return fake.GetRecentLogsForApplicationsByNameAndSpaceStub(appNames, spaceGUID, client, config, filter)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRecentLogsForApplicationsByNameAndSpaceReturns.result1, fake.getRecentLogsForApplicationsByNameAndSpaceReturns.result2, fake.getRecentLogsForApplicationsByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsByNameAndSpaceCallCount() int {
+	fake.getRecentLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsByNameAndSpaceArgsForCall(i int) ([]string, string, v2action.NOAAClient, v2action.Config, v2action.LogMessageFilter) {
+	fake.getRecentLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	return fake.getRecentLogsForApplicationsByNameAndSpaceArgsForCall[i].appNames, fake.getRecentLogsForApplicationsByNameAndSpaceArgsForCall[i].spaceGUID, fake.getRecentLogsForApplicationsByNameAndSpaceArgsForCall[i].client, fake.getRecentLogsForApplicationsByNameAndSpaceArgsForCall[i].config, fake.getRecentLogsForApplicationsByNameAndSpaceArgsForCall[i].filter
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsByNameAndSpaceReturns(result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.GetRecentLogsForApplicationsByNameAndSpaceStub = nil
+	fake.getRecentLogsForApplicationsByNameAndSpaceReturns = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsByNameAndSpaceReturnsOnCall(i int, result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.GetRecentLogsForApplicationsByNameAndSpaceStub = nil
+	if fake.getRecentLogsForApplicationsByNameAndSpaceReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.LogMessage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentLogsForApplicationsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForSpace(spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) ([]v2action.LogMessage, v2action.Warnings, error) {
+	fake.getRecentLogsForSpaceMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForSpaceReturnsOnCall[len(fake.getRecentLogsForSpaceArgsForCall)]
+	fake.getRecentLogsForSpaceArgsForCall = append(fake.getRecentLogsForSpaceArgsForCall, struct {
+		spaceGUID string
+		client    v2action.NOAAClient
+		config    v2action.Config
+		filter    v2action.LogMessageFilter
+	}{spaceGUID, client, config, filter})
+	fake.recordInvocation("GetRecentLogsForSpace", []interface{}{spaceGUID, client, config, filter})
+	fake.getRecentLogsForSpaceMutex.Unlock()
+	if fake.GetRecentLogsForSpaceStub != nil {
+		return fake.GetRecentLogsForSpaceStub(spaceGUID, client, config, filter)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRecentLogsForSpaceReturns.result1, fake.getRecentLogsForSpaceReturns.result2, fake.getRecentLogsForSpaceReturns.result3
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForSpaceCallCount() int {
+	fake.getRecentLogsForSpaceMutex.RLock()
+	defer fake.getRecentLogsForSpaceMutex.RUnlock()
+	return len(fake.getRecentLogsForSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForSpaceArgsForCall(i int) (string, v2action.NOAAClient, v2action.Config, v2action.LogMessageFilter) {
+	fake.getRecentLogsForSpaceMutex.RLock()
+	defer fake.getRecentLogsForSpaceMutex.RUnlock()
+	return fake.getRecentLogsForSpaceArgsForCall[i].spaceGUID, fake.getRecentLogsForSpaceArgsForCall[i].client, fake.getRecentLogsForSpaceArgsForCall[i].config, fake.getRecentLogsForSpaceArgsForCall[i].filter
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForSpaceReturns(result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.GetRecentLogsForSpaceStub = nil
+	fake.getRecentLogsForSpaceReturns = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForSpaceReturnsOnCall(i int, result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.GetRecentLogsForSpaceStub = nil
+	if fake.getRecentLogsForSpaceReturnsOnCall == nil {
+		fake.getRecentLogsForSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.LogMessage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentLogsForSpaceReturnsOnCall[i] = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
 	fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall, struct {
@@ -116,11 +317,12 @@ func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpace(appName
 		spaceGUID string
 		client    v2action.NOAAClient
 		config    v2action.Config
-	}{appName, spaceGUID, client, config})
-	fake.recordInvocation("GetStreamingLogsForApplicationByNameAndSpace", []interface{}{appName, spaceGUID, client, config})
+		filter    v2action.LogMessageFilter
+	}{appName, spaceGUID, client, config, filter})
+	fake.recordInvocation("GetStreamingLogsForApplicationByNameAndSpace", []interface{}{appName, spaceGUID, client, config, filter})
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
 	if fake.GetStreamingLogsForApplicationByNameAndSpaceStub != nil {
-		return fake.GetStreamingLogsForApplicationByNameAndSpaceStub(appName, spaceGUID, client, config)
+		return fake.GetStreamingLogsForApplicationByNameAndSpaceStub(appName, spaceGUID, client, config, filter)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3, ret.result4
@@ -134,10 +336,10 @@ func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceCallCount
 	return len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.NOAAClient, v2action.Config) {
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.NOAAClient, v2action.Config, v2action.LogMessageFilter) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
-	return fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].appName, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].client, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].config
+	return fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].appName, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].client, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].config, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].filter
 }
 
 func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
@@ -168,13 +370,147 @@ func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOn
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	var appNamesCopy []string
+	if appNames != nil {
+		appNamesCopy = make([]string, len(appNames))
+		copy(appNamesCopy, appNames)
+	}
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall)]
+	fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall, struct {
+		appNames  []string
+		spaceGUID string
+		client    v2action.NOAAClient
+		config    v2action.Config
+		filter    v2action.LogMessageFilter
+	}{appNamesCopy, spaceGUID, client, config, filter})
+	fake.recordInvocation("GetStreamingLogsForApplicationsByNameAndSpace", []interface{}{appNamesCopy, spaceGUID, client, config, filter})
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForApplicationsByNameAndSpaceStub != nil {
+		return fake.GetStreamingLogsForApplicationsByNameAndSpaceStub(appNames, spaceGUID, client, config, filter)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result1, fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result2, fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result3, fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceCallCount() int {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceArgsForCall(i int) ([]string, string, v2action.NOAAClient, v2action.Config, v2action.LogMessageFilter) {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	return fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].appNames, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].client, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].config, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].filter
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForApplicationsByNameAndSpaceStub = nil
+	fake.getStreamingLogsForApplicationsByNameAndSpaceReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForApplicationsByNameAndSpaceStub = nil
+	if fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpace(spaceGUID string, client v2action.NOAAClient, config v2action.Config, filter v2action.LogMessageFilter) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	fake.getStreamingLogsForSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForSpaceReturnsOnCall[len(fake.getStreamingLogsForSpaceArgsForCall)]
+	fake.getStreamingLogsForSpaceArgsForCall = append(fake.getStreamingLogsForSpaceArgsForCall, struct {
+		spaceGUID string
+		client    v2action.NOAAClient
+		config    v2action.Config
+		filter    v2action.LogMessageFilter
+	}{spaceGUID, client, config, filter})
+	fake.recordInvocation("GetStreamingLogsForSpace", []interface{}{spaceGUID, client, config, filter})
+	fake.getStreamingLogsForSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForSpaceStub != nil {
+		return fake.GetStreamingLogsForSpaceStub(spaceGUID, client, config, filter)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.getStreamingLogsForSpaceReturns.result1, fake.getStreamingLogsForSpaceReturns.result2, fake.getStreamingLogsForSpaceReturns.result3, fake.getStreamingLogsForSpaceReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceCallCount() int {
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceArgsForCall(i int) (string, v2action.NOAAClient, v2action.Config, v2action.LogMessageFilter) {
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
+	return fake.getStreamingLogsForSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForSpaceArgsForCall[i].client, fake.getStreamingLogsForSpaceArgsForCall[i].config, fake.getStreamingLogsForSpaceArgsForCall[i].filter
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForSpaceStub = nil
+	fake.getStreamingLogsForSpaceReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForSpaceStub = nil
+	if fake.getStreamingLogsForSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	fake.getRecentLogsForSpaceMutex.RLock()
+	defer fake.getRecentLogsForSpaceMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
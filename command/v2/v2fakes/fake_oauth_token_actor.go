@@ -4,10 +4,24 @@ package v2fakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/v2"
 )
 
 type FakeOauthTokenActor struct {
+	DecodeAccessTokenStub        func(accessToken string) (v2action.AccessTokenClaims, error)
+	decodeAccessTokenMutex       sync.RWMutex
+	decodeAccessTokenArgsForCall []struct {
+		accessToken string
+	}
+	decodeAccessTokenReturns struct {
+		result1 v2action.AccessTokenClaims
+		result2 error
+	}
+	decodeAccessTokenReturnsOnCall map[int]struct {
+		result1 v2action.AccessTokenClaims
+		result2 error
+	}
 	RefreshAccessTokenStub        func(refreshToken string) (string, error)
 	refreshAccessTokenMutex       sync.RWMutex
 	refreshAccessTokenArgsForCall []struct {
@@ -25,6 +39,57 @@ type FakeOauthTokenActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeOauthTokenActor) DecodeAccessToken(accessToken string) (v2action.AccessTokenClaims, error) {
+	fake.decodeAccessTokenMutex.Lock()
+	ret, specificReturn := fake.decodeAccessTokenReturnsOnCall[len(fake.decodeAccessTokenArgsForCall)]
+	fake.decodeAccessTokenArgsForCall = append(fake.decodeAccessTokenArgsForCall, struct {
+		accessToken string
+	}{accessToken})
+	fake.recordInvocation("DecodeAccessToken", []interface{}{accessToken})
+	fake.decodeAccessTokenMutex.Unlock()
+	if fake.DecodeAccessTokenStub != nil {
+		return fake.DecodeAccessTokenStub(accessToken)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.decodeAccessTokenReturns.result1, fake.decodeAccessTokenReturns.result2
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenCallCount() int {
+	fake.decodeAccessTokenMutex.RLock()
+	defer fake.decodeAccessTokenMutex.RUnlock()
+	return len(fake.decodeAccessTokenArgsForCall)
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenArgsForCall(i int) string {
+	fake.decodeAccessTokenMutex.RLock()
+	defer fake.decodeAccessTokenMutex.RUnlock()
+	return fake.decodeAccessTokenArgsForCall[i].accessToken
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenReturns(result1 v2action.AccessTokenClaims, result2 error) {
+	fake.DecodeAccessTokenStub = nil
+	fake.decodeAccessTokenReturns = struct {
+		result1 v2action.AccessTokenClaims
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenReturnsOnCall(i int, result1 v2action.AccessTokenClaims, result2 error) {
+	fake.DecodeAccessTokenStub = nil
+	if fake.decodeAccessTokenReturnsOnCall == nil {
+		fake.decodeAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 v2action.AccessTokenClaims
+			result2 error
+		})
+	}
+	fake.decodeAccessTokenReturnsOnCall[i] = struct {
+		result1 v2action.AccessTokenClaims
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeOauthTokenActor) RefreshAccessToken(refreshToken string) (string, error) {
 	fake.refreshAccessTokenMutex.Lock()
 	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
@@ -79,6 +144,8 @@ func (fake *FakeOauthTokenActor) RefreshAccessTokenReturnsOnCall(i int, result1
 func (fake *FakeOauthTokenActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.decodeAccessTokenMutex.RLock()
+	defer fake.decodeAccessTokenMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
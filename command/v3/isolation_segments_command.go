@@ -7,8 +7,22 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+// isolationSegmentsOutput is the document displayed by isolation-segments
+// when --output is provided.
+type isolationSegmentsOutput struct {
+	IsolationSegments []isolationSegmentOutput `json:"isolation_segments" yaml:"isolation_segments"`
+}
+
+// isolationSegmentOutput describes a single isolation segment and the names of
+// the orgs entitled to it.
+type isolationSegmentOutput struct {
+	Name string   `json:"name" yaml:"name"`
+	Orgs []string `json:"orgs" yaml:"orgs"`
+}
+
 //go:generate counterfeiter . IsolationSegmentsActor
 
 type IsolationSegmentsActor interface {
@@ -56,15 +70,34 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting isolation segments as {{.CurrentUser}}...", map[string]interface{}{
-		"CurrentUser": user.Name,
-	})
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Getting isolation segments as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+	}
 
 	summaries, warnings, err := cmd.Actor.GetIsolationSegmentSummaries()
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
 	}
+
+	if outputFormat != configv3.OutputFormatText {
+		output := isolationSegmentsOutput{IsolationSegments: []isolationSegmentOutput{}}
+		for _, summary := range summaries {
+			orgs := summary.EntitledOrgs
+			if orgs == nil {
+				orgs = []string{}
+			}
+			output.IsolationSegments = append(output.IsolationSegments, isolationSegmentOutput{
+				Name: summary.Name,
+				Orgs: orgs,
+			})
+		}
+		return cmd.UI.DisplayStructuredOutput(outputFormat, output)
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
@@ -88,3 +121,5 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	cmd.UI.DisplayTableWithHeader("", table, 3)
 	return nil
 }
+
+func (IsolationSegmentsCommand) SupportsStructuredOutput() {}
@@ -121,6 +121,30 @@ var _ = Describe("isolation-segments Command", func() {
 
 					Expect(fakeActor.GetIsolationSegmentSummariesCallCount()).To(Equal(1))
 				})
+
+				Context("when the output format is json", func() {
+					BeforeEach(func() {
+						fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+					})
+
+					It("displays the isolation segment summaries as JSON", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`^{
+  "isolation_segments": \[
+    {
+      "name": "some-iso-1",
+      "orgs": \[\]
+    },
+    {
+      "name": "some-iso-2",
+      "orgs": \[
+        "some-org-1"
+      \]
+    },`))
+						Expect(testUI.Err).To(Say("warning-1"))
+					})
+				})
 			})
 
 			Context("when there are no isolation segments", func() {
@@ -18,6 +18,9 @@ type AppSummaryDisplayer struct {
 	Actor           V3AppSummaryActor
 	V2AppRouteActor V2AppRouteActor
 	AppName         string
+	// StateTracker highlights instances whose state changed since the last
+	// display when the app is being watched
+	StateTracker *sharedV2.InstanceStateTracker
 }
 
 //go:generate counterfeiter . V2AppRouteActor
@@ -38,13 +41,7 @@ func (display AppSummaryDisplayer) DisplayAppInfo() error {
 		return HandleError(err)
 	}
 
-	display.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   display.AppName,
-		"OrgName":   display.Config.TargetedOrganization().Name,
-		"SpaceName": display.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	display.UI.DisplayNewline()
+	display.displayAppInfoHeader(user.Name)
 
 	summary, warnings, err := display.Actor.GetApplicationSummaryByNameAndSpace(display.AppName, display.Config.TargetedSpace().GUID)
 	display.UI.DisplayWarnings(warnings)
@@ -52,14 +49,10 @@ func (display AppSummaryDisplayer) DisplayAppInfo() error {
 		return HandleError(err)
 	}
 
-	var routes v2action.Routes
-	if len(summary.ProcessSummaries) > 0 {
-		var routeWarnings v2action.Warnings
-		routes, routeWarnings, err = display.V2AppRouteActor.GetApplicationRoutes(summary.Application.GUID)
-		display.UI.DisplayWarnings(routeWarnings)
-		if err != nil {
-			return sharedV2.HandleError(err)
-		}
+	routes, routeWarnings, err := display.getAppRoutes(summary)
+	display.UI.DisplayWarnings(routeWarnings)
+	if err != nil {
+		return err
 	}
 
 	display.displayAppTable(summary, routes)
@@ -67,6 +60,58 @@ func (display AppSummaryDisplayer) DisplayAppInfo() error {
 	return nil
 }
 
+// FetchAppInfo gets the app's summary and returns a function that displays
+// it, so that a watched app is only redrawn once its summary has been
+// fetched. The warnings of a failed fetch are displayed right away.
+func (display AppSummaryDisplayer) FetchAppInfo() (func(), error) {
+	user, err := display.Config.CurrentUser()
+	if err != nil {
+		return nil, HandleError(err)
+	}
+
+	summary, warnings, err := display.Actor.GetApplicationSummaryByNameAndSpace(display.AppName, display.Config.TargetedSpace().GUID)
+	if err != nil {
+		display.UI.DisplayWarnings(warnings)
+		return nil, HandleError(err)
+	}
+
+	routes, routeWarnings, err := display.getAppRoutes(summary)
+	if err != nil {
+		display.UI.DisplayWarnings(append(warnings, routeWarnings...))
+		return nil, err
+	}
+
+	return func() {
+		display.displayAppInfoHeader(user.Name)
+		display.UI.DisplayWarnings(warnings)
+		display.UI.DisplayWarnings(routeWarnings)
+		display.displayAppTable(summary, routes)
+	}, nil
+}
+
+func (display AppSummaryDisplayer) displayAppInfoHeader(username string) {
+	display.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   display.AppName,
+		"OrgName":   display.Config.TargetedOrganization().Name,
+		"SpaceName": display.Config.TargetedSpace().Name,
+		"Username":  username,
+	})
+	display.UI.DisplayNewline()
+}
+
+// getAppRoutes gets the routes of an app that has processes.
+func (display AppSummaryDisplayer) getAppRoutes(summary v3action.ApplicationSummary) (v2action.Routes, v2action.Warnings, error) {
+	if len(summary.ProcessSummaries) == 0 {
+		return nil, nil, nil
+	}
+
+	routes, warnings, err := display.V2AppRouteActor.GetApplicationRoutes(summary.Application.GUID)
+	if err != nil {
+		return nil, warnings, sharedV2.HandleError(err)
+	}
+	return routes, warnings, nil
+}
+
 // Sort processes alphabetically and put web first.
 func (display AppSummaryDisplayer) displayAppTable(summary v3action.ApplicationSummary, routes v2action.Routes) {
 	summary.ProcessSummaries.Sort()
@@ -137,7 +182,7 @@ func (display AppSummaryDisplayer) DisplayAppInstancesTable(processSummary v3act
 	for _, instance := range processSummary.InstanceDetails {
 		table = append(table, []string{
 			fmt.Sprintf("#%d", instance.Index),
-			display.UI.TranslateText(strings.ToLower(string(instance.State))),
+			display.StateTracker.StateText(display.UI, fmt.Sprintf("%s#%d", processSummary.Type, instance.Index), instance.State),
 			display.appInstanceDate(instance.StartTime()),
 			fmt.Sprintf("%.1f%%", instance.CPU*100),
 			display.UI.TranslateText("{{.MemUsage}} of {{.MemQuota}}", map[string]interface{}{
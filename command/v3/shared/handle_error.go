@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 func HandleError(err error) error {
@@ -50,6 +51,13 @@ func HandleError(err error) error {
 		return translatableerror.StagingTimeoutError(e)
 	case v3action.TaskWorkersUnavailableError:
 		return translatableerror.RunTaskError{Message: "Task workers are unavailable."}
+
+	case ui.InvalidTableFilterError:
+		return translatableerror.InvalidTableFilterError(e)
+	case ui.InvalidTableSortError:
+		return translatableerror.InvalidTableSortError(e)
+	case ui.TableColumnNotFoundError:
+		return translatableerror.TableColumnNotFoundError(e)
 	}
 
 	return err
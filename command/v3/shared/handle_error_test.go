@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -97,6 +98,18 @@ var _ = Describe("HandleError", func() {
 			v3action.EmptyDirectoryError{Path: "some-path"},
 			translatableerror.EmptyDirectoryError{Path: "some-path"}),
 
+		Entry("ui.InvalidTableFilterError -> InvalidTableFilterError",
+			ui.InvalidTableFilterError{Filter: "some-filter"},
+			translatableerror.InvalidTableFilterError{Filter: "some-filter"}),
+
+		Entry("ui.InvalidTableSortError -> InvalidTableSortError",
+			ui.InvalidTableSortError{SortBy: "some-sort"},
+			translatableerror.InvalidTableSortError{SortBy: "some-sort"}),
+
+		Entry("ui.TableColumnNotFoundError -> TableColumnNotFoundError",
+			ui.TableColumnNotFoundError{Column: "some-column", Columns: []string{"name"}},
+			translatableerror.TableColumnNotFoundError{Column: "some-column", Columns: []string{"name"}}),
+
 		Entry("default case -> original error",
 			err,
 			err),
@@ -57,10 +57,10 @@ func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client,
 	client.SetDebugPrinter(&noaaDebugPrinter)
 
 	if verbose {
-		noaaDebugPrinter.addOutput(ui.RequestLoggerTerminalDisplay())
+		noaaDebugPrinter.addOutput(command.RequestLoggerTerminalDisplay(config, ui))
 	}
 	if location != nil {
-		noaaDebugPrinter.addOutput(ui.RequestLoggerFileWriter(location))
+		noaaDebugPrinter.addOutput(command.RequestLoggerFileWriter(config, ui, location))
 	}
 
 	return client
@@ -9,6 +9,8 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //These constants are only for filling in translations.
@@ -19,6 +21,22 @@ const (
 	succeededState = "SUCCEEDED"
 )
 
+// tasksOutput is the document displayed by tasks when --output is provided.
+type tasksOutput struct {
+	Tasks []taskOutput `json:"tasks" yaml:"tasks"`
+}
+
+// taskOutput describes a single task in tasksOutput. CreatedAt is in RFC3339
+// and Command is empty when the user is not allowed to see it.
+type taskOutput struct {
+	ID        int    `json:"id" yaml:"id"`
+	GUID      string `json:"guid" yaml:"guid"`
+	Name      string `json:"name" yaml:"name"`
+	State     string `json:"state" yaml:"state"`
+	CreatedAt string `json:"created_at" yaml:"created_at"`
+	Command   string `json:"command" yaml:"command"`
+}
+
 //go:generate counterfeiter . TasksActor
 
 type TasksActor interface {
@@ -27,10 +45,15 @@ type TasksActor interface {
 	CloudControllerAPIVersion() string
 }
 
+// tasksColumns are the untranslated columns of the tasks table, which the
+// --columns, --sort-by and --filter flags refer to.
+var tasksColumns = []string{"id", "name", "state", "start time", "command"}
+
 type TasksCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME tasks APP_NAME"`
+	usage           interface{}  `usage:"CF_NAME tasks APP_NAME [--columns COLUMN,...] [--sort-by COLUMN[:desc]] [--filter COLUMN=GLOB]..."`
 	relatedCommands interface{}  `related_commands:"apps, logs, run-task, terminate-task"`
+	ui.TableViewFlags
 
 	UI          command.UI
 	Config      command.Config
@@ -53,7 +76,12 @@ func (cmd *TasksCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd TasksCommand) Execute(args []string) error {
-	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionRunTaskV3)
+	view, err := cmd.TableView(tasksColumns)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionRunTaskV3)
 	if err != nil {
 		return err
 	}
@@ -76,12 +104,15 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting tasks for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"AppName":     cmd.RequiredArgs.AppName,
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"SpaceName":   space.Name,
-		"CurrentUser": user.Name,
-	})
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Getting tasks for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   space.Name,
+			"CurrentUser": user.Name,
+		})
+	}
 
 	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending)
 	cmd.UI.DisplayWarnings(warnings)
@@ -89,18 +120,25 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if outputFormat != configv3.OutputFormatText {
+		output := tasksOutput{Tasks: []taskOutput{}}
+		for _, task := range tasks {
+			output.Tasks = append(output.Tasks, taskOutput{
+				ID:        task.SequenceID,
+				GUID:      task.GUID,
+				Name:      task.Name,
+				State:     task.State,
+				CreatedAt: task.CreatedAt,
+				Command:   task.Command,
+			})
+		}
+		return cmd.UI.DisplayStructuredOutput(outputFormat, output)
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("id"),
-			cmd.UI.TranslateText("name"),
-			cmd.UI.TranslateText("state"),
-			cmd.UI.TranslateText("start time"),
-			cmd.UI.TranslateText("command"),
-		},
-	}
+	table := [][]string{tasksColumns}
 	for _, task := range tasks {
 		t, err := time.Parse(time.RFC3339, task.CreatedAt)
 		if err != nil {
@@ -120,7 +158,12 @@ func (cmd TasksCommand) Execute(args []string) error {
 		})
 	}
 
-	cmd.UI.DisplayTableWithHeader("", table, 3)
+	err = cmd.UI.DisplayTableWithView("", table, 3, view)
+	if err != nil {
+		return shared.HandleError(err)
+	}
 
 	return nil
 }
+
+func (TasksCommand) SupportsStructuredOutput() {}
@@ -66,6 +66,32 @@ var _ = Describe("tasks Command", func() {
 		})
 	})
 
+	Context("when a filter is not of the form COLUMN=GLOB", func() {
+		BeforeEach(func() {
+			cmd.Filter = []string{"state"}
+		})
+
+		It("returns an InvalidTableFilterError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidTableFilterError{Filter: "state"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the sort order names a column the table does not have", func() {
+		BeforeEach(func() {
+			cmd.SortBy = "memory"
+		})
+
+		It("returns a TableColumnNotFoundError without displaying anything", func() {
+			Expect(executeErr).To(MatchError(translatableerror.TableColumnNotFoundError{
+				Column:  "memory",
+				Columns: []string{"id", "name", "state", "start-time", "command"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -180,6 +206,49 @@ get-application-warning-2
 get-tasks-warning-1`))
 				})
 
+				Context("when columns, a sort order and filters are provided", func() {
+					BeforeEach(func() {
+						cmd.Columns = "id,state"
+						cmd.SortBy = "id"
+						cmd.Filter = []string{"state=*ed"}
+					})
+
+					It("displays the selected columns of the matching tasks in that order", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`OK
+
+id   state
+1    SUCCEEDED
+2    FAILED
+`,
+						))
+						Expect(testUI.Out).ToNot(Say("RUNNING"))
+					})
+				})
+
+				Context("when the output format is yaml", func() {
+					BeforeEach(func() {
+						fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+					})
+
+					It("outputs the tasks as YAML without flavor text", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`^tasks:
+- id: 3
+  guid: task-3-guid
+  name: task-3
+  state: RUNNING
+  created_at: 2016-11-08T22:26:02Z
+  command: some-command
+- id: 2
+`))
+						Expect(testUI.Out).ToNot(Say("OK"))
+						Expect(testUI.Err).To(Say("get-tasks-warning-1"))
+					})
+				})
+
 				Context("when the tasks' command fields are returned as empty strings", func() {
 					BeforeEach(func() {
 						fakeActor.GetApplicationTasksReturns(
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 )
@@ -18,9 +19,10 @@ type V3AppActor interface {
 }
 
 type V3AppCommand struct {
-	RequiredArgs flag.AppName `positional-args:"yes"`
-	GUID         bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage        interface{}  `usage:"CF_NAME v3-app APP_NAME [--guid]"`
+	RequiredArgs flag.AppName       `positional-args:"yes"`
+	GUID         bool               `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
+	Watch        flag.WatchInterval `long:"watch" optional:"true" optional-value:"3s" description:"Redisplay the app's health and status every INTERVAL (seconds or a duration such as 10s, default 3s) until interrupted, highlighting instance state changes"`
+	usage        interface{}        `usage:"CF_NAME v3-app APP_NAME [--guid] [--watch[=INTERVAL]]"`
 
 	UI                  command.UI
 	Config              command.Config
@@ -58,6 +60,10 @@ func (cmd *V3AppCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd V3AppCommand) Execute(args []string) error {
+	if cmd.GUID && cmd.Watch.IsSet {
+		return translatableerror.ArgumentCombinationError{Arg1: "--guid", Arg2: "--watch"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -67,6 +73,12 @@ func (cmd V3AppCommand) Execute(args []string) error {
 		return cmd.displayAppGUID()
 	}
 
+	if cmd.Watch.IsSet {
+		tracker := sharedV2.NewInstanceStateTracker(sharedV2.CrashCountWindow)
+		cmd.AppSummaryDisplayer.StateTracker = tracker
+		return sharedV2.WatchApp(cmd.UI, cmd.Watch.Value, tracker, cmd.AppSummaryDisplayer.FetchAppInfo)
+	}
+
 	return cmd.AppSummaryDisplayer.DisplayAppInfo()
 }
 
@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared/sharedfakes"
@@ -511,4 +512,71 @@ var _ = Describe("v3-app Command", func() {
 			})
 		})
 	})
+
+	Context("when the --watch flag is provided", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			cmd.Watch = flag.WatchInterval{Value: time.Millisecond, IsSet: true}
+
+			processStates := [][]string{
+				{"STARTING", "RUNNING"},
+				{"RUNNING", "CRASHED"},
+			}
+			for i, states := range processStates {
+				summary := v3action.ApplicationSummary{
+					Application: v3action.Application{
+						Name:  "some-app",
+						State: "STARTED",
+					},
+					ProcessSummaries: []v3action.ProcessSummary{
+						{
+							Process:         v3action.Process{Type: "web"},
+							InstanceDetails: []v3action.Instance{{Index: 0, State: states[0]}},
+						},
+						{
+							Process:         v3action.Process{Type: "worker"},
+							InstanceDetails: []v3action.Instance{{Index: 0, State: states[1]}},
+						},
+					},
+				}
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturnsOnCall(i, summary, v3action.Warnings{"warning-1"}, nil)
+			}
+
+			expectedErr = errors.New("get app summary error")
+			fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, nil, expectedErr)
+		})
+
+		It("redisplays the app summary, highlighting state changes in every process and counting crashes, until refreshing keeps failing", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(2 + sharedV2.MaxWatchFailures))
+
+			Expect(testUI.Out).To(Say("web:0/1"))
+			Expect(testUI.Out).To(Say("#0\\s+starting\\s+"))
+			Expect(testUI.Out).To(Say("worker:1/1"))
+			Expect(testUI.Out).To(Say("#0\\s+running\\s+"))
+			Expect(testUI.Out).To(Say("Crashes in the last 5 minutes: 0"))
+			Expect(testUI.Out).To(Say("Refreshing every 1ms, press Ctrl-C to stop."))
+
+			Expect(testUI.Out).To(Say("web:1/1"))
+			Expect(testUI.Out).To(Say("#0\\s+starting → running\\s+"))
+			Expect(testUI.Out).To(Say("worker:0/1"))
+			Expect(testUI.Out).To(Say("#0\\s+running → crashed\\s+"))
+			Expect(testUI.Out).To(Say("Crashes in the last 5 minutes: 1"))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("Refreshing failed, retrying in 1ms: get app summary error"))
+		})
+
+		Context("when the --guid flag is also provided", func() {
+			BeforeEach(func() {
+				cmd.GUID = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Arg1: "--guid", Arg2: "--watch"}))
+				Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -9,6 +9,8 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . V3AppsActor
@@ -17,8 +19,37 @@ type V3AppsActor interface {
 	GetApplicationSummariesBySpace(spaceGUID string) ([]v3action.ApplicationSummary, v3action.Warnings, error)
 }
 
+// v3AppsOutput is the document displayed by v3-apps when --output is
+// provided.
+type v3AppsOutput struct {
+	Apps []v3AppsAppOutput `json:"apps" yaml:"apps"`
+}
+
+// v3AppsAppOutput describes a single app in v3AppsOutput. State is the
+// lowercased requested state and Routes are formatted as host.domain/path or
+// domain:port.
+type v3AppsAppOutput struct {
+	Name      string                `json:"name" yaml:"name"`
+	GUID      string                `json:"guid" yaml:"guid"`
+	State     string                `json:"state" yaml:"state"`
+	Processes []v3AppsProcessOutput `json:"processes" yaml:"processes"`
+	Routes    []string              `json:"routes" yaml:"routes"`
+}
+
+// v3AppsProcessOutput describes the instance counts of a single process type.
+type v3AppsProcessOutput struct {
+	Type             string `json:"type" yaml:"type"`
+	RunningInstances int    `json:"running_instances" yaml:"running_instances"`
+	TotalInstances   int    `json:"total_instances" yaml:"total_instances"`
+}
+
+// v3AppsColumns are the untranslated columns of the v3-apps table, which the
+// --columns, --sort-by and --filter flags refer to.
+var v3AppsColumns = []string{"name", "requested state", "processes", "routes"}
+
 type V3AppsCommand struct {
-	usage interface{} `usage:"CF_NAME v3-apps"`
+	ui.TableViewFlags
+	usage interface{} `usage:"CF_NAME v3-apps [--columns COLUMN,...] [--sort-by COLUMN[:desc]] [--filter COLUMN=GLOB]..."`
 
 	UI              command.UI
 	Config          command.Config
@@ -49,7 +80,12 @@ func (cmd *V3AppsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd V3AppsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	view, err := cmd.TableView(v3AppsColumns)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
 	}
@@ -59,12 +95,15 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	summaries, warnings, err := cmd.Actor.GetApplicationSummariesBySpace(cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -72,19 +111,16 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if outputFormat != configv3.OutputFormatText {
+		return cmd.displayStructuredOutput(outputFormat, summaries)
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
 	}
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("name"),
-			cmd.UI.TranslateText("requested state"),
-			cmd.UI.TranslateText("processes"),
-			cmd.UI.TranslateText("routes"),
-		},
-	}
+	table := [][]string{v3AppsColumns}
 
 	for _, summary := range summaries {
 		var routesList string
@@ -105,7 +141,50 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		})
 	}
 
-	cmd.UI.DisplayTableWithHeader("", table, 3)
+	err = cmd.UI.DisplayTableWithView("", table, 3, view)
+	if err != nil {
+		return shared.HandleError(err)
+	}
 
 	return nil
 }
+
+func (V3AppsCommand) SupportsStructuredOutput() {}
+
+func (cmd V3AppsCommand) displayStructuredOutput(outputFormat configv3.OutputFormat, summaries []v3action.ApplicationSummary) error {
+	output := v3AppsOutput{Apps: []v3AppsAppOutput{}}
+
+	for _, summary := range summaries {
+		app := v3AppsAppOutput{
+			Name:      summary.Name,
+			GUID:      summary.GUID,
+			State:     strings.ToLower(string(summary.State)),
+			Processes: []v3AppsProcessOutput{},
+			Routes:    []string{},
+		}
+
+		summary.ProcessSummaries.Sort()
+		for _, process := range summary.ProcessSummaries {
+			app.Processes = append(app.Processes, v3AppsProcessOutput{
+				Type:             process.Type,
+				RunningInstances: process.HealthyInstanceCount(),
+				TotalInstances:   process.TotalInstanceCount(),
+			})
+		}
+
+		if len(summary.ProcessSummaries) > 0 {
+			routes, warnings, err := cmd.V2AppRouteActor.GetApplicationRoutes(summary.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+			for _, route := range routes {
+				app.Routes = append(app.Routes, route.String())
+			}
+		}
+
+		output.Apps = append(output.Apps, app)
+	}
+
+	return cmd.UI.DisplayStructuredOutput(outputFormat, output)
+}
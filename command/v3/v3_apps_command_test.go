@@ -65,6 +65,17 @@ var _ = Describe("v3-apps Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when the sort order is not of the form COLUMN[:asc|:desc]", func() {
+		BeforeEach(func() {
+			cmd.SortBy = "name:up"
+		})
+
+		It("returns an InvalidTableSortError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidTableSortError{SortBy: "name:up"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
@@ -273,6 +284,21 @@ var _ = Describe("v3-apps Command", func() {
 				appGUID = fakeV2Actor.GetApplicationRoutesArgsForCall(1)
 				Expect(appGUID).To(Equal("app-guid-2"))
 			})
+			Context("when columns, a sort order and filters are provided", func() {
+				BeforeEach(func() {
+					cmd.Columns = "requested-state,name"
+					cmd.SortBy = "name:desc"
+					cmd.Filter = []string{"routes=*some-domain*"}
+				})
+
+				It("displays the selected columns of the matching apps in that order", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("requested state\\s+name\\n"))
+					Expect(testUI.Out).To(Say("stopped\\s+some-app-2\\n"))
+					Expect(testUI.Out).To(Say("started\\s+some-app-1\\n"))
+				})
+			})
 		})
 
 		Context("when app does not have processes", func() {
@@ -307,6 +333,49 @@ var _ = Describe("v3-apps Command", func() {
 			})
 		})
 
+		Context("when an output format is provided", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+				fakeActor.GetApplicationSummariesBySpaceReturns([]v3action.ApplicationSummary{
+					{
+						Application: v3action.Application{
+							GUID:  "app-guid-1",
+							Name:  "some-app-1",
+							State: "STARTED",
+						},
+						ProcessSummaries: []v3action.ProcessSummary{
+							{
+								Process: v3action.Process{Type: "worker"},
+							},
+							{
+								Process: v3action.Process{Type: "web"},
+								InstanceDetails: []v3action.Instance{
+									{Index: 0, State: "RUNNING"},
+									{Index: 1, State: "DOWN"},
+								},
+							},
+						},
+					},
+				}, v3action.Warnings{"warning-1"}, nil)
+			})
+
+			It("displays the apps as structured output without flavor text", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting apps"))
+				Expect(testUI.Out).To(Say(`"apps": \[`))
+				Expect(testUI.Out).To(Say(`"name": "some-app-1",`))
+				Expect(testUI.Out).To(Say(`"guid": "app-guid-1",`))
+				Expect(testUI.Out).To(Say(`"state": "started",`))
+				Expect(testUI.Out).To(Say(`"type": "web",\s+"running_instances": 1,\s+"total_instances": 2`))
+				Expect(testUI.Out).To(Say(`"type": "worker",\s+"running_instances": 0,\s+"total_instances": 0`))
+				Expect(testUI.Out).To(Say(`"routes": \[\s+"some-app-1.some-other-domain",\s+"some-app-1.some-domain"\s+\]`))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("route-warning-1"))
+			})
+		})
+
 		Context("with no apps", func() {
 			BeforeEach(func() {
 				fakeActor.GetApplicationSummariesBySpaceReturns([]v3action.ApplicationSummary{}, v3action.Warnings{"warning-1", "warning-2"}, nil)
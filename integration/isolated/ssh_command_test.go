@@ -87,10 +87,10 @@ applications:
 
 		Context("when the app index is specified", func() {
 			Context("when it is negative", func() {
-				It("throws an error and informs the user that the app instance index cannot be negative", func() {
-					session := helpers.CF("ssh", appName, "-i", "-1")
-					Eventually(session.Out).Should(Say("FAILED"))
-					Eventually(session.Out).Should(Say("The application instance index cannot be negative"))
+				It("outputs an error message to the user, provides help text, and exits 1", func() {
+					session := helpers.CF("ssh", appName, "-i=-1")
+					Eventually(session.Err).Should(Say("Incorrect Usage: invalid argument for instance index \\(expected int >= 0\\)"))
+					Eventually(session.Out).Should(Say("cf ssh APP_NAME")) // help
 					Eventually(session).Should(Exit(1))
 				})
 			})
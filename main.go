@@ -28,7 +28,16 @@ type DisplayUsage interface {
 	DisplayUsage()
 }
 
-var ErrFailed = errors.New("command failed")
+// FailedError is returned when a command fails. The CLI exits with its
+// ExitStatus.
+type FailedError struct {
+	ExitStatus translatableerror.ExitStatus
+}
+
+func (FailedError) Error() string {
+	return "command failed"
+}
+
 var ParseErr = errors.New("incorrect type for arg")
 
 func main() {
@@ -38,6 +47,10 @@ func main() {
 
 func parse(args []string) {
 	parser := flags.NewParser(&common.Commands, flags.HelpFlag)
+	if len(args) > 0 && args[0] == "__complete" {
+		// the words being completed follow a -- and must not be parsed as flags
+		parser.Options |= flags.PassDoubleDash
+	}
 	parser.CommandHandler = executionWrapper
 	extraArgs, err := parser.ParseArgs(args)
 	if err == nil {
@@ -115,8 +128,8 @@ func parse(args []string) {
 		default:
 			fmt.Fprintf(os.Stderr, "Unexpected flag error\ntype: %s\nmessage: %s\n", flagErr.Type, flagErr.Error())
 		}
-	} else if err == ErrFailed {
-		os.Exit(1)
+	} else if failedErr, ok := err.(FailedError); ok {
+		os.Exit(int(failedErr.ExitStatus))
 	} else if err == ParseErr {
 		fmt.Println()
 		parse([]string{"help", args[0]})
@@ -143,7 +156,8 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		OutputFormat: common.Commands.OutputFormat,
+		Verbose:      common.Commands.VerboseOrVersion,
 	})
 	if configErr != nil {
 		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
@@ -181,6 +195,10 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 	// }
 
 	defer func() {
+		if _, ok := cmd.(command.ConfigReadOnlyCommander); ok {
+			return
+		}
+
 		configWriteErr := configv3.WriteConfig(cfConfig)
 		if configWriteErr != nil {
 			fmt.Fprintf(os.Stderr, "Error writing config: %s", configWriteErr.Error())
@@ -191,6 +209,10 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
 
+		if _, ok := cmd.(command.StructuredOutputCommander); !ok && cfConfig.OutputFormat() != configv3.OutputFormatText {
+			return handleError(translatableerror.StructuredOutputNotSupportedError{}, commandUI)
+		}
+
 		err = extendedCmd.Setup(cfConfig, commandUI)
 		if err != nil {
 			return handleError(err, commandUI)
@@ -212,5 +234,5 @@ func handleError(err error, commandUI UI) error {
 		return ParseErr
 	}
 
-	return ErrFailed
+	return FailedError{ExitStatus: translatableerror.ExitStatusFor(err)}
 }
@@ -381,3 +381,65 @@ func (c *cliConnection) GetService(serviceInstance string) (plugin_models.GetSer
 
 	return result, err
 }
+
+func (c *cliConnection) CfCurl(method string, path string, body string) (plugin_models.CfCurl_Model, error) {
+	var result plugin_models.CfCurl_Model
+
+	cmdArgs := []string{method, path, body}
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.CfCurl", cmdArgs, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Apps() ([]plugin_models.GetV3Apps_Model, error) {
+	var result []plugin_models.GetV3Apps_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3Apps", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3AppProcesses(appName string) ([]plugin_models.GetV3AppProcesses_Model, error) {
+	var result []plugin_models.GetV3AppProcesses_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3AppProcesses", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3AppTasks(appName string) ([]plugin_models.GetV3AppTasks_Model, error) {
+	var result []plugin_models.GetV3AppTasks_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3AppTasks", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3AppDroplets(appName string) ([]plugin_models.GetV3AppDroplets_Model, error) {
+	var result []plugin_models.GetV3AppDroplets_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3AppDroplets", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetIsolationSegments() ([]plugin_models.GetIsolationSegments_Model, error) {
+	var result []plugin_models.GetIsolationSegments_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetIsolationSegments", "", &result)
+	})
+
+	return result, err
+}
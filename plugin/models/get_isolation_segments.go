@@ -0,0 +1,6 @@
+package plugin_models
+
+type GetIsolationSegments_Model struct {
+	Name         string
+	EntitledOrgs []string
+}
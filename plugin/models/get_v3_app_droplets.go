@@ -0,0 +1,9 @@
+package plugin_models
+
+type GetV3AppDroplets_Model struct {
+	Guid       string
+	State      string
+	CreatedAt  string
+	Stack      string
+	Buildpacks []string
+}
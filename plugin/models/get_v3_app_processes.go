@@ -0,0 +1,23 @@
+package plugin_models
+
+type GetV3AppProcesses_Model struct {
+	Guid                string
+	Type                string
+	Instances           int
+	MemoryInMB          uint64
+	DiskInMB            uint64
+	HealthCheckType     string
+	HealthCheckEndpoint string
+	InstanceDetails     []GetV3AppProcesses_Instance
+}
+
+type GetV3AppProcesses_Instance struct {
+	Index     int
+	State     string
+	Uptime    int     // in seconds
+	CpuUsage  float64 // percentage
+	MemUsage  uint64  // in bytes
+	MemQuota  uint64
+	DiskUsage uint64
+	DiskQuota uint64
+}
@@ -0,0 +1,12 @@
+package plugin_models
+
+type GetV3AppTasks_Model struct {
+	Guid       string
+	SequenceId int
+	Name       string
+	Command    string
+	State      string
+	CreatedAt  string
+	MemoryInMB uint64
+	DiskInMB   uint64
+}
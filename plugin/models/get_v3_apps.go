@@ -0,0 +1,8 @@
+package plugin_models
+
+type GetV3Apps_Model struct {
+	Guid       string
+	Name       string
+	State      string
+	Buildpacks []string
+}
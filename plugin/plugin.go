@@ -42,6 +42,12 @@ type CliConnection interface {
 	GetService(string) (plugin_models.GetService_Model, error)
 	GetOrg(string) (plugin_models.GetOrg_Model, error)
 	GetSpace(string) (plugin_models.GetSpace_Model, error)
+	CfCurl(method string, path string, body string) (plugin_models.CfCurl_Model, error)
+	GetV3Apps() ([]plugin_models.GetV3Apps_Model, error)
+	GetV3AppProcesses(string) ([]plugin_models.GetV3AppProcesses_Model, error)
+	GetV3AppTasks(string) ([]plugin_models.GetV3AppTasks_Model, error)
+	GetV3AppDroplets(string) ([]plugin_models.GetV3AppDroplets_Model, error)
+	GetIsolationSegments() ([]plugin_models.GetIsolationSegments_Model, error)
 }
 
 type VersionType struct {
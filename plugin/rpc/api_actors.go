@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	v2shared "code.cloudfoundry.org/cli/command/v2/shared"
+	v3shared "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . CurlActor
+
+// CurlActor makes raw Cloud Controller requests on behalf of plugins.
+type CurlActor interface {
+	Curl(method string, path string, body []byte) (v2action.CurlResponse, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . V3Actor
+
+// V3Actor looks up the V3 resources that are exposed to plugins.
+type V3Actor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
+	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	GetApplicationDroplets(appName string, spaceGUID string) ([]v3action.Droplet, v3action.Warnings, error)
+	GetIsolationSegmentSummaries() ([]v3action.IsolationSegmentSummary, v3action.Warnings, error)
+}
+
+// NewCurlActor returns a CurlActor whose requests go through the same
+// connection wrappers as the commands' requests, so they are retried, have
+// their token refreshed and are traced with CF_TRACE.
+func NewCurlActor(config *configv3.Config, commandUI *ui.UI) (CurlActor, error) {
+	ccClient, uaaClient, err := v2shared.NewClients(config, commandUI, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return v2action.NewActor(ccClient, uaaClient, config), nil
+}
+
+// NewV3Actor returns a V3Actor that uses the same connection wrappers as the
+// V3 commands.
+func NewV3Actor(config *configv3.Config, commandUI *ui.UI) (V3Actor, error) {
+	ccClient, _, err := v3shared.NewClients(config, commandUI, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return v3action.NewActor(ccClient, config), nil
+}
+
+// LoadActorConfig loads the config the actors are built from and the UI that
+// displays their warnings.
+func LoadActorConfig() (*configv3.Config, *ui.UI, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return config, commandUI, nil
+}
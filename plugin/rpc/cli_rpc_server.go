@@ -4,12 +4,16 @@ import (
 	"os"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/plugin"
 	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 
@@ -47,6 +51,14 @@ type CliRpcCmd struct {
 	outputBucket         *bytes.Buffer
 	logger               trace.Printer
 	stdout               io.Writer
+	LoadActorConfig      func() (*configv3.Config, *ui.UI, error)
+	NewCurlActor         func(config *configv3.Config, commandUI *ui.UI) (CurlActor, error)
+	NewV3Actor           func(config *configv3.Config, commandUI *ui.UI) (V3Actor, error)
+	actorsMutex          *sync.Mutex
+	actorConfig          *configv3.Config
+	actorUI              *ui.UI
+	curlActor            CurlActor
+	v3Actor              V3Actor
 }
 
 //go:generate counterfeiter . TerminalOutputSwitch
@@ -84,6 +96,10 @@ func NewRpcService(
 			logger:               logger,
 			outputBucket:         &bytes.Buffer{},
 			stdout:               w,
+			LoadActorConfig:      LoadActorConfig,
+			NewCurlActor:         NewCurlActor,
+			NewV3Actor:           NewV3Actor,
+			actorsMutex:          &sync.Mutex{},
 		},
 	}
 
@@ -444,3 +460,297 @@ func (cmd *CliRpcCmd) GetService(serviceInstance string, retVal *plugin_models.G
 
 	return cmd.newCmdRunner.Command([]string{"service", serviceInstance}, deps, true)
 }
+
+func (cmd *CliRpcCmd) CfCurl(args []string, retVal *plugin_models.CfCurl_Model) error {
+	if len(args) != 3 {
+		return fmt.Errorf("CfCurl expects a method, a path and a body, got %d arguments", len(args))
+	}
+
+	actor, release, err := cmd.getCurlActor()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	response, warnings, err := actor.Curl(args[0], args[1], []byte(args[2]))
+	cmd.actorUI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	retVal.StatusCode = response.StatusCode
+	retVal.Headers = response.Header
+	retVal.Body = string(response.Body)
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetV3Apps(_ string, retVal *[]plugin_models.GetV3Apps_Model) error {
+	err := cmd.checkTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	actor, release, err := cmd.getV3Actor()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	apps, warnings, err := actor.GetApplicationsBySpace(cmd.cliConfig.SpaceFields().GUID)
+	cmd.actorUI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []plugin_models.GetV3Apps_Model{}
+	for _, app := range apps {
+		*retVal = append(*retVal, plugin_models.GetV3Apps_Model{
+			Guid:       app.GUID,
+			Name:       app.Name,
+			State:      app.State,
+			Buildpacks: app.Buildpacks,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetV3AppProcesses(appName string, retVal *[]plugin_models.GetV3AppProcesses_Model) error {
+	err := cmd.checkTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	actor, release, err := cmd.getV3Actor()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	summary, warnings, err := actor.GetApplicationSummaryByNameAndSpace(appName, cmd.cliConfig.SpaceFields().GUID)
+	cmd.actorUI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []plugin_models.GetV3AppProcesses_Model{}
+	for _, process := range summary.ProcessSummaries {
+		model := plugin_models.GetV3AppProcesses_Model{
+			Guid:                process.GUID,
+			Type:                process.Type,
+			Instances:           process.Instances.Value,
+			MemoryInMB:          process.MemoryInMB.Value,
+			DiskInMB:            process.DiskInMB.Value,
+			HealthCheckType:     process.HealthCheck.Type,
+			HealthCheckEndpoint: process.HealthCheck.Data.Endpoint,
+			InstanceDetails:     []plugin_models.GetV3AppProcesses_Instance{},
+		}
+		for _, instance := range process.InstanceDetails {
+			model.InstanceDetails = append(model.InstanceDetails, plugin_models.GetV3AppProcesses_Instance{
+				Index:     instance.Index,
+				State:     instance.State,
+				Uptime:    instance.Uptime,
+				CpuUsage:  instance.CPU,
+				MemUsage:  instance.MemoryUsage,
+				MemQuota:  instance.MemoryQuota,
+				DiskUsage: instance.DiskUsage,
+				DiskQuota: instance.DiskQuota,
+			})
+		}
+		*retVal = append(*retVal, model)
+	}
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetV3AppTasks(appName string, retVal *[]plugin_models.GetV3AppTasks_Model) error {
+	err := cmd.checkTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	actor, release, err := cmd.getV3Actor()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, cmd.cliConfig.SpaceFields().GUID)
+	cmd.actorUI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	tasks, warnings, err := actor.GetApplicationTasks(app.GUID, v3action.Ascending)
+	cmd.actorUI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []plugin_models.GetV3AppTasks_Model{}
+	for _, task := range tasks {
+		*retVal = append(*retVal, plugin_models.GetV3AppTasks_Model{
+			Guid:       task.GUID,
+			SequenceId: task.SequenceID,
+			Name:       task.Name,
+			Command:    task.Command,
+			State:      task.State,
+			CreatedAt:  task.CreatedAt,
+			MemoryInMB: task.MemoryInMB,
+			DiskInMB:   task.DiskInMB,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetV3AppDroplets(appName string, retVal *[]plugin_models.GetV3AppDroplets_Model) error {
+	err := cmd.checkTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	actor, release, err := cmd.getV3Actor()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	droplets, warnings, err := actor.GetApplicationDroplets(appName, cmd.cliConfig.SpaceFields().GUID)
+	cmd.actorUI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []plugin_models.GetV3AppDroplets_Model{}
+	for _, droplet := range droplets {
+		buildpacks := []string{}
+		for _, buildpack := range droplet.Buildpacks {
+			buildpacks = append(buildpacks, buildpack.Name)
+		}
+
+		*retVal = append(*retVal, plugin_models.GetV3AppDroplets_Model{
+			Guid:       droplet.GUID,
+			State:      string(droplet.State),
+			CreatedAt:  droplet.CreatedAt,
+			Stack:      droplet.Stack,
+			Buildpacks: buildpacks,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetIsolationSegments(_ string, retVal *[]plugin_models.GetIsolationSegments_Model) error {
+	actor, release, err := cmd.getV3Actor()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	isolationSegments, warnings, err := actor.GetIsolationSegmentSummaries()
+	cmd.actorUI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []plugin_models.GetIsolationSegments_Model{}
+	for _, isolationSegment := range isolationSegments {
+		*retVal = append(*retVal, plugin_models.GetIsolationSegments_Model{
+			Name:         isolationSegment.Name,
+			EntitledOrgs: isolationSegment.EntitledOrgs,
+		})
+	}
+	return nil
+}
+
+// checkTargetedSpace returns the error the legacy commands return when no org
+// or space is targeted, instead of querying the API with an empty space GUID.
+func (cmd *CliRpcCmd) checkTargetedSpace() error {
+	return requirements.NewTargetedSpaceRequirement(cmd.cliConfig).Execute()
+}
+
+// getCurlActor and getV3Actor create their actor on first use, so that the
+// CLI does not target the API for plugins that never call these methods. The
+// returned function must be called when the actor call is done.
+func (cmd *CliRpcCmd) getCurlActor() (CurlActor, func(), error) {
+	release, err := cmd.startActorCall()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cmd.curlActor == nil {
+		actor, err := cmd.NewCurlActor(cmd.actorConfig, cmd.actorUI)
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
+		cmd.curlActor = actor
+	}
+	return cmd.curlActor, release, nil
+}
+
+func (cmd *CliRpcCmd) getV3Actor() (V3Actor, func(), error) {
+	release, err := cmd.startActorCall()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cmd.v3Actor == nil {
+		actor, err := cmd.NewV3Actor(cmd.actorConfig, cmd.actorUI)
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
+		cmd.v3Actor = actor
+	}
+	return cmd.v3Actor, release, nil
+}
+
+// startActorCall loads the actor config on first use and copies the target
+// and tokens of the running config, which core commands called by the plugin
+// may have changed, to it. The actors are recreated when the API target
+// changes, since their clients are bound to the old target. The returned
+// function writes the tokens refreshed during the actor call back to the
+// running config, which persists them.
+func (cmd *CliRpcCmd) startActorCall() (func(), error) {
+	cmd.actorsMutex.Lock()
+
+	if cmd.actorConfig == nil {
+		config, commandUI, err := cmd.LoadActorConfig()
+		if err != nil {
+			cmd.actorsMutex.Unlock()
+			return nil, err
+		}
+		cmd.actorConfig = config
+		cmd.actorUI = commandUI
+	}
+
+	if cmd.actorConfig.Target() != cmd.cliConfig.APIEndpoint() || cmd.actorConfig.SkipSSLValidation() != cmd.cliConfig.IsSSLDisabled() {
+		cmd.curlActor = nil
+		cmd.v3Actor = nil
+	}
+
+	cmd.actorConfig.SetTargetInformation(
+		cmd.cliConfig.APIEndpoint(),
+		cmd.cliConfig.APIVersion(),
+		cmd.cliConfig.AuthenticationEndpoint(),
+		cmd.cliConfig.MinCLIVersion(),
+		cmd.cliConfig.DopplerEndpoint(),
+		cmd.cliConfig.RoutingAPIEndpoint(),
+		cmd.cliConfig.IsSSLDisabled(),
+	)
+	cmd.actorConfig.SetUAAEndpoint(cmd.cliConfig.UaaEndpoint())
+	org := cmd.cliConfig.OrganizationFields()
+	cmd.actorConfig.SetOrganizationInformation(org.GUID, org.Name)
+	space := cmd.cliConfig.SpaceFields()
+	cmd.actorConfig.SetSpaceInformation(space.GUID, space.Name, space.AllowSSH)
+	cmd.actorConfig.SetAccessToken(cmd.cliConfig.AccessToken())
+	cmd.actorConfig.SetRefreshToken(cmd.cliConfig.RefreshToken())
+
+	return func() {
+		if accessToken := cmd.actorConfig.AccessToken(); accessToken != cmd.cliConfig.AccessToken() {
+			cmd.cliConfig.SetAccessToken(accessToken)
+		}
+		if refreshToken := cmd.actorConfig.RefreshToken(); refreshToken != cmd.cliConfig.RefreshToken() {
+			cmd.cliConfig.SetRefreshToken(refreshToken)
+		}
+		cmd.actorsMutex.Unlock()
+	}, nil
+}
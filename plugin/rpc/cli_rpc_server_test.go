@@ -3,10 +3,14 @@ package rpc_test
 import (
 	"errors"
 	"net"
+	"net/http"
 	"net/rpc"
 	"os"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -18,9 +22,13 @@ import (
 	cmdRunner "code.cloudfoundry.org/cli/plugin/rpc"
 	. "code.cloudfoundry.org/cli/plugin/rpc/fakecommand"
 	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("Server", func() {
@@ -420,6 +428,303 @@ var _ = Describe("Server", func() {
 
 	})
 
+	Describe("V3 and raw API methods", func() {
+		var (
+			fakeCurlActor *rpcfakes.FakeCurlActor
+			fakeV3Actor   *rpcfakes.FakeV3Actor
+			cliConfig     coreconfig.Repository
+			actorConfig   *configv3.Config
+			actorUI       *ui.UI
+		)
+
+		BeforeEach(func() {
+			fakeCurlActor = new(rpcfakes.FakeCurlActor)
+			fakeV3Actor = new(rpcfakes.FakeV3Actor)
+			cliConfig = testconfig.NewRepositoryWithDefaults()
+			actorConfig = new(configv3.Config)
+			actorUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+
+			rpcService, err = NewRpcService(nil, nil, cliConfig, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+			Expect(err).ToNot(HaveOccurred())
+
+			rpcService.RpcCmd.LoadActorConfig = func() (*configv3.Config, *ui.UI, error) { return actorConfig, actorUI, nil }
+			rpcService.RpcCmd.NewCurlActor = func(*configv3.Config, *ui.UI) (CurlActor, error) { return fakeCurlActor, nil }
+			rpcService.RpcCmd.NewV3Actor = func(*configv3.Config, *ui.UI) (V3Actor, error) { return fakeV3Actor, nil }
+
+			err := rpcService.Start()
+			Expect(err).ToNot(HaveOccurred())
+
+			pingCli(rpcService.Port())
+
+			client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			rpcService.Stop()
+
+			//give time for server to stop
+			time.Sleep(50 * time.Millisecond)
+		})
+
+		Context(".CfCurl", func() {
+			It("makes the request and returns the raw response", func() {
+				fakeCurlActor.CurlReturns(v2action.CurlResponse{
+					StatusCode: http.StatusCreated,
+					Header:     http.Header{"Location": {"/v3/apps/some-app-guid"}},
+					Body:       []byte(`{"guid":"some-app-guid"}`),
+				}, nil, nil)
+
+				result := plugin_models.CfCurl_Model{}
+				err = client.Call("CliRpcCmd.CfCurl", []string{"POST", "/v3/apps", `{"name":"some-app"}`}, &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal(plugin_models.CfCurl_Model{
+					StatusCode: http.StatusCreated,
+					Headers:    map[string][]string{"Location": {"/v3/apps/some-app-guid"}},
+					Body:       `{"guid":"some-app-guid"}`,
+				}))
+
+				Expect(fakeCurlActor.CurlCallCount()).To(Equal(1))
+				method, path, body := fakeCurlActor.CurlArgsForCall(0)
+				Expect(method).To(Equal("POST"))
+				Expect(path).To(Equal("/v3/apps"))
+				Expect(body).To(Equal([]byte(`{"name":"some-app"}`)))
+			})
+
+			It("creates the actor only once", func() {
+				factoryCalls := 0
+				rpcService.RpcCmd.NewCurlActor = func(*configv3.Config, *ui.UI) (CurlActor, error) {
+					factoryCalls++
+					return fakeCurlActor, nil
+				}
+
+				result := plugin_models.CfCurl_Model{}
+				Expect(client.Call("CliRpcCmd.CfCurl", []string{"GET", "/v2/info", ""}, &result)).To(Succeed())
+				Expect(client.Call("CliRpcCmd.CfCurl", []string{"GET", "/v2/info", ""}, &result)).To(Succeed())
+
+				Expect(factoryCalls).To(Equal(1))
+				Expect(fakeCurlActor.CurlCallCount()).To(Equal(2))
+			})
+
+			Context("when the plugin changes the target between calls", func() {
+				var factoryConfigs []*configv3.Config
+
+				BeforeEach(func() {
+					factoryConfigs = nil
+					rpcService.RpcCmd.NewCurlActor = func(config *configv3.Config, _ *ui.UI) (CurlActor, error) {
+						factoryConfigs = append(factoryConfigs, config)
+						return fakeCurlActor, nil
+					}
+				})
+
+				It("copies the new target to the actor config", func() {
+					result := plugin_models.CfCurl_Model{}
+					Expect(client.Call("CliRpcCmd.CfCurl", []string{"GET", "/v2/info", ""}, &result)).To(Succeed())
+
+					cliConfig.SetOrganizationFields(models.OrganizationFields{GUID: "other-org-guid", Name: "other-org"})
+					cliConfig.SetSpaceFields(models.SpaceFields{GUID: "other-space-guid", Name: "other-space"})
+					Expect(client.Call("CliRpcCmd.CfCurl", []string{"GET", "/v2/info", ""}, &result)).To(Succeed())
+
+					Expect(actorConfig.TargetedOrganization().GUID).To(Equal("other-org-guid"))
+					Expect(actorConfig.TargetedSpace().GUID).To(Equal("other-space-guid"))
+					Expect(factoryConfigs).To(HaveLen(1))
+				})
+
+				It("recreates the actor when the API changes", func() {
+					result := plugin_models.CfCurl_Model{}
+					Expect(client.Call("CliRpcCmd.CfCurl", []string{"GET", "/v2/info", ""}, &result)).To(Succeed())
+
+					cliConfig.SetAPIEndpoint("https://api.other.example.com")
+					Expect(client.Call("CliRpcCmd.CfCurl", []string{"GET", "/v2/info", ""}, &result)).To(Succeed())
+
+					Expect(factoryConfigs).To(HaveLen(2))
+					Expect(factoryConfigs[1].Target()).To(Equal("https://api.other.example.com"))
+				})
+			})
+
+			It("returns an error when the actor cannot be created", func() {
+				rpcService.RpcCmd.NewCurlActor = func(*configv3.Config, *ui.UI) (CurlActor, error) { return nil, errors.New("no api set") }
+
+				result := plugin_models.CfCurl_Model{}
+				err = client.Call("CliRpcCmd.CfCurl", []string{"GET", "/v2/info", ""}, &result)
+				Expect(err).To(MatchError("no api set"))
+			})
+
+			It("returns an error when the request cannot be made", func() {
+				fakeCurlActor.CurlReturns(v2action.CurlResponse{}, nil, errors.New("connection refused"))
+
+				result := plugin_models.CfCurl_Model{}
+				err = client.Call("CliRpcCmd.CfCurl", []string{"GET", "/v2/info", ""}, &result)
+				Expect(err).To(MatchError("connection refused"))
+			})
+		})
+
+		Context(".GetV3Apps", func() {
+			It("returns the apps in the targeted space", func() {
+				fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{
+					{GUID: "app-guid-1", Name: "app-1", State: "STARTED", Buildpacks: []string{"ruby_buildpack"}},
+					{GUID: "app-guid-2", Name: "app-2", State: "STOPPED"},
+				}, nil, nil)
+
+				result := []plugin_models.GetV3Apps_Model{}
+				err = client.Call("CliRpcCmd.GetV3Apps", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal([]plugin_models.GetV3Apps_Model{
+					{Guid: "app-guid-1", Name: "app-1", State: "STARTED", Buildpacks: []string{"ruby_buildpack"}},
+					{Guid: "app-guid-2", Name: "app-2", State: "STOPPED"},
+				}))
+				Expect(fakeV3Actor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("my-space-guid"))
+			})
+
+			It("displays the warnings", func() {
+				fakeV3Actor.GetApplicationsBySpaceReturns(nil, v3action.Warnings{"some-warning"}, nil)
+
+				result := []plugin_models.GetV3Apps_Model{}
+				Expect(client.Call("CliRpcCmd.GetV3Apps", "", &result)).To(Succeed())
+				Expect(actorUI.Err).To(Say("some-warning"))
+			})
+
+			It("writes tokens refreshed during the call back to the CLI config", func() {
+				fakeV3Actor.GetApplicationsBySpaceStub = func(string) ([]v3action.Application, v3action.Warnings, error) {
+					Expect(actorConfig.AccessToken()).To(Equal(cliConfig.AccessToken()))
+					actorConfig.SetAccessToken("bearer refreshed-access-token")
+					actorConfig.SetRefreshToken("refreshed-refresh-token")
+					return nil, nil, nil
+				}
+
+				result := []plugin_models.GetV3Apps_Model{}
+				Expect(client.Call("CliRpcCmd.GetV3Apps", "", &result)).To(Succeed())
+				Expect(cliConfig.AccessToken()).To(Equal("bearer refreshed-access-token"))
+				Expect(cliConfig.RefreshToken()).To(Equal("refreshed-refresh-token"))
+			})
+
+			Context("when no space is targeted", func() {
+				BeforeEach(func() {
+					cliConfig.SetSpaceFields(models.SpaceFields{})
+				})
+
+				It("returns the not targeted error without querying the API", func() {
+					result := []plugin_models.GetV3Apps_Model{}
+					err = client.Call("CliRpcCmd.GetV3Apps", "", &result)
+					Expect(err).To(MatchError(ContainSubstring("No space targeted")))
+					Expect(fakeV3Actor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context(".GetV3AppProcesses", func() {
+			It("returns the processes of the app with their instances", func() {
+				fakeV3Actor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{
+					ProcessSummaries: v3action.ProcessSummaries{
+						{
+							Process: v3action.Process{
+								GUID:        "process-guid",
+								Type:        "web",
+								Instances:   types.NullInt{Value: 1, IsSet: true},
+								MemoryInMB:  types.NullUint64{Value: 256, IsSet: true},
+								DiskInMB:    types.NullUint64{Value: 1024, IsSet: true},
+								HealthCheck: ccv3.ProcessHealthCheck{Type: "http", Data: ccv3.ProcessHealthCheckData{Endpoint: "/health"}},
+							},
+							InstanceDetails: []v3action.Instance{
+								{Index: 0, State: "RUNNING", Uptime: 42, CPU: 0.5, MemoryUsage: 100, MemoryQuota: 200, DiskUsage: 300, DiskQuota: 400},
+							},
+						},
+					},
+				}, nil, nil)
+
+				result := []plugin_models.GetV3AppProcesses_Model{}
+				err = client.Call("CliRpcCmd.GetV3AppProcesses", "some-app", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal([]plugin_models.GetV3AppProcesses_Model{
+					{
+						Guid:                "process-guid",
+						Type:                "web",
+						Instances:           1,
+						MemoryInMB:          256,
+						DiskInMB:            1024,
+						HealthCheckType:     "http",
+						HealthCheckEndpoint: "/health",
+						InstanceDetails: []plugin_models.GetV3AppProcesses_Instance{
+							{Index: 0, State: "RUNNING", Uptime: 42, CpuUsage: 0.5, MemUsage: 100, MemQuota: 200, DiskUsage: 300, DiskQuota: 400},
+						},
+					},
+				}))
+
+				appName, spaceGUID := fakeV3Actor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("my-space-guid"))
+			})
+
+			It("returns an error when the app cannot be found", func() {
+				fakeV3Actor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+
+				result := []plugin_models.GetV3AppProcesses_Model{}
+				err = client.Call("CliRpcCmd.GetV3AppProcesses", "some-app", &result)
+				Expect(err).To(MatchError("Application 'some-app' not found."))
+			})
+		})
+
+		Context(".GetV3AppTasks", func() {
+			It("returns the tasks of the app in ascending order", func() {
+				fakeV3Actor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, nil, nil)
+				fakeV3Actor.GetApplicationTasksReturns([]v3action.Task{
+					{GUID: "task-guid", SequenceID: 1, Name: "migrate", Command: "rake db:migrate", State: "SUCCEEDED", CreatedAt: "2017-01-01T00:00:00Z", MemoryInMB: 512, DiskInMB: 1024},
+				}, nil, nil)
+
+				result := []plugin_models.GetV3AppTasks_Model{}
+				err = client.Call("CliRpcCmd.GetV3AppTasks", "some-app", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal([]plugin_models.GetV3AppTasks_Model{
+					{Guid: "task-guid", SequenceId: 1, Name: "migrate", Command: "rake db:migrate", State: "SUCCEEDED", CreatedAt: "2017-01-01T00:00:00Z", MemoryInMB: 512, DiskInMB: 1024},
+				}))
+
+				appGUID, sortOrder := fakeV3Actor.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(sortOrder).To(Equal(v3action.Ascending))
+			})
+		})
+
+		Context(".GetV3AppDroplets", func() {
+			It("returns the droplets of the app", func() {
+				fakeV3Actor.GetApplicationDropletsReturns([]v3action.Droplet{
+					{GUID: "droplet-guid", State: v3action.DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z", Stack: "cflinuxfs2", Buildpacks: []v3action.Buildpack{{Name: "ruby_buildpack"}}},
+				}, nil, nil)
+
+				result := []plugin_models.GetV3AppDroplets_Model{}
+				err = client.Call("CliRpcCmd.GetV3AppDroplets", "some-app", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal([]plugin_models.GetV3AppDroplets_Model{
+					{Guid: "droplet-guid", State: "STAGED", CreatedAt: "2017-01-01T00:00:00Z", Stack: "cflinuxfs2", Buildpacks: []string{"ruby_buildpack"}},
+				}))
+
+				appName, spaceGUID := fakeV3Actor.GetApplicationDropletsArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("my-space-guid"))
+			})
+		})
+
+		Context(".GetIsolationSegments", func() {
+			It("returns the isolation segments with their entitled orgs", func() {
+				fakeV3Actor.GetIsolationSegmentSummariesReturns([]v3action.IsolationSegmentSummary{
+					{Name: "segment-1", EntitledOrgs: []string{"org-1", "org-2"}},
+				}, nil, nil)
+
+				result := []plugin_models.GetIsolationSegments_Model{}
+				err = client.Call("CliRpcCmd.GetIsolationSegments", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal([]plugin_models.GetIsolationSegments_Model{
+					{Name: "segment-1", EntitledOrgs: []string{"org-1", "org-2"}},
+				}))
+			})
+		})
+	})
+
 	Describe(".CallCoreCommand", func() {
 		var runner *rpcfakes.FakeCommandRunner
 
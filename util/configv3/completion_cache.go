@@ -0,0 +1,70 @@
+package configv3
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CompletionCacheDuration is how long resource names suggested by shell
+// completion are reused before they are requested again.
+const CompletionCacheDuration = time.Minute
+
+type completionCacheEntry struct {
+	CachedAt time.Time `json:"CachedAt"`
+	Names    []string  `json:"Names"`
+}
+
+// CompletionCache returns the names stored under key by SetCompletionCache
+// when they were stored less than CompletionCacheDuration ago.
+func (*Config) CompletionCache(key string) ([]string, bool) {
+	entries := readCompletionCache()
+
+	entry, ok := entries[key]
+	if !ok || time.Since(entry.CachedAt) >= CompletionCacheDuration {
+		return nil, false
+	}
+	return entry.Names, true
+}
+
+// SetCompletionCache stores names under key, dropping any expired entries.
+func (*Config) SetCompletionCache(key string, names []string) error {
+	entries := readCompletionCache()
+	for cachedKey, entry := range entries {
+		if time.Since(entry.CachedAt) >= CompletionCacheDuration {
+			delete(entries, cachedKey)
+		}
+	}
+	entries[key] = completionCacheEntry{CachedAt: time.Now(), Names: names}
+
+	rawCache, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(configDirectory(), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(completionCachePath(), rawCache, 0600)
+}
+
+func completionCachePath() string {
+	return filepath.Join(configDirectory(), "completion_cache.json")
+}
+
+func readCompletionCache() map[string]completionCacheEntry {
+	entries := map[string]completionCacheEntry{}
+
+	rawCache, err := ioutil.ReadFile(completionCachePath())
+	if err != nil {
+		return entries
+	}
+
+	// a corrupt cache is treated as empty and overwritten on the next write
+	_ = json.Unmarshal(rawCache, &entries)
+	return entries
+}
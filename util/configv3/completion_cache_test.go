@@ -0,0 +1,75 @@
+package configv3_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Completion Cache", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		config = new(Config)
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Context("when nothing is cached under the key", func() {
+		It("returns false", func() {
+			_, found := config.CompletionCache("some-key")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when names were cached recently", func() {
+		BeforeEach(func() {
+			Expect(config.SetCompletionCache("some-key", []string{"name-1", "name-2"})).To(Succeed())
+		})
+
+		It("returns the cached names", func() {
+			names, found := config.CompletionCache("some-key")
+			Expect(found).To(BeTrue())
+			Expect(names).To(Equal([]string{"name-1", "name-2"}))
+
+			_, found = config.CompletionCache("other-key")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when the cached names have expired", func() {
+		BeforeEach(func() {
+			expired := time.Now().Add(-CompletionCacheDuration).Format(time.RFC3339)
+			rawCache := fmt.Sprintf(`{"some-key":{"CachedAt":"%s","Names":["name-1"]}}`, expired)
+			Expect(os.MkdirAll(filepath.Join(homeDir, ".cf"), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(homeDir, ".cf", "completion_cache.json"), []byte(rawCache), 0600)).To(Succeed())
+		})
+
+		It("returns false", func() {
+			_, found := config.CompletionCache("some-key")
+			Expect(found).To(BeFalse())
+		})
+
+		It("drops the expired names on the next write", func() {
+			Expect(config.SetCompletionCache("other-key", []string{"name-2"})).To(Succeed())
+
+			rawCache, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "completion_cache.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawCache)).ToNot(ContainSubstring("some-key"))
+			Expect(string(rawCache)).To(ContainSubstring("other-key"))
+		})
+	})
+})
@@ -16,6 +16,7 @@ import (
 	"golang.org/x/crypto/ssh/terminal"
 
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -40,6 +41,10 @@ const (
 	DefaultStartupTimeout = 5 * time.Minute
 	// DefaultPingerThrottle = 5 * time.Second
 
+	// DefaultTokenRefreshSkew is how long before the access token expires the
+	// CLI will refresh it.
+	DefaultTokenRefreshSkew = 1 * time.Minute
+
 	// DefaultTarget is the default CFConfig value for Target.
 	DefaultTarget = ""
 
@@ -112,6 +117,13 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		}
 	}
 
+	if profile := os.Getenv("CF_PROFILE"); profile != "" {
+		err = config.selectTargetProfile(profile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if config.ConfigFile.SSHOAuthClient == "" {
 		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
 	}
@@ -122,20 +134,28 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
+		BinaryName:         filepath.Base(os.Args[0]),
+		CFColor:            os.Getenv("CF_COLOR"),
+		CFCredentialHelper: os.Getenv("CF_CREDENTIAL_HELPER"),
+		CFDialTimeout:      os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLogLevel:         os.Getenv("CF_LOG_LEVEL"),
+		CFPluginHome:       os.Getenv("CF_PLUGIN_HOME"),
+		CFStagingTimeout:   os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:   os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTokenRefreshSkew: os.Getenv("CF_TOKEN_REFRESH_SKEW"),
+		CFTrace:            os.Getenv("CF_TRACE"),
+		CFTraceFormat:      os.Getenv("CF_TRACE_FORMAT"),
+		DockerPassword:     os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:       os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:           os.Getenv("FORCE_TTY"),
+		HTTPSProxy:         os.Getenv("https_proxy"),
+		Lang:               os.Getenv("LANG"),
+		LCAll:              os.Getenv("LC_ALL"),
+	}
+
+	err = config.loadCredentials()
+	if err != nil {
+		return nil, err
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -209,9 +229,20 @@ func removeOldTempConfigFiles() error {
 
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
-// directory.
+// directory. When a credential helper is configured, the tokens are saved
+// with the helper instead of in the config.json.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	err := c.storeCredentials()
+	if err != nil {
+		return err
+	}
+
+	configFile, err := c.writeSelectedTargetProfile()
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(configFile, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -275,6 +306,16 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	// storedCredentials are the tokens last loaded from or saved to the
+	// credential helper.
+	storedCredentials credentialhelper.Credentials
+
+	// selectedTargetProfile is the target profile selected with CF_PROFILE,
+	// and defaultTarget the target information in the config file that it
+	// replaces for this invocation.
+	selectedTargetProfile string
+	defaultTarget         CFConfig
 }
 
 // CFConfig represents .cf/config.json
@@ -290,6 +331,8 @@ type CFConfig struct {
 	SSHOAuthClient           string             `json:"SSHOAuthClient"`
 	UAAOAuthClient           string             `json:"UAAOAuthClient"`
 	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string             `json:"UAAGrantType"`
+	CredentialHelper         string             `json:"CredentialHelper"`
 	RefreshToken             string             `json:"RefreshToken"`
 	TargetedOrganization     Organization       `json:"OrganizationFields"`
 	TargetedSpace            Space              `json:"SpaceFields"`
@@ -299,8 +342,10 @@ type CFConfig struct {
 	ColorEnabled             string             `json:"ColorEnabled"`
 	Locale                   string             `json:"Locale"`
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
+	PluginTrustedKeys        []PluginTrustedKey `json:"PluginTrustedKeys"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	TargetProfile            string             `json:"TargetProfile"`
 }
 
 // Organization contains basic information about the targeted organization
@@ -332,26 +377,30 @@ type Space struct {
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName         string
+	CFColor            string
+	CFCredentialHelper string
+	CFDialTimeout      string
+	CFHome             string
+	CFLogLevel         string
+	CFPluginHome       string
+	CFStagingTimeout   string
+	CFStartupTimeout   string
+	CFTokenRefreshSkew string
+	CFTrace            string
+	CFTraceFormat      string
+	DockerPassword     string
+	Experimental       string
+	ForceTTY           string
+	HTTPSProxy         string
+	Lang               string
+	LCAll              string
 }
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	OutputFormat string
+	Verbose      bool
 }
 
 // detectedSettings are automatically detected settings determined by the CLI.
@@ -414,6 +463,12 @@ func (config *Config) UAAOAuthClientSecret() string {
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
+// UAAGrantType returns the grant type of the currently logged in user or
+// client. It is empty unless a client credentials grant was used.
+func (config *Config) UAAGrantType() string {
+	return config.ConfigFile.UAAGrantType
+}
+
 // APIVersion returns the CC API Version
 func (config *Config) APIVersion() string {
 	return config.ConfigFile.APIVersion
@@ -601,6 +656,21 @@ func (config *Config) DialTimeout() time.Duration {
 	return DefaultDialTimeout
 }
 
+// TokenRefreshSkew returns how long before the access token expires the CLI
+// will refresh it. This is based off of:
+//   1. The $CF_TOKEN_REFRESH_SKEW environment variable if set, in seconds
+//   2. Defaults to 1 minute
+func (config *Config) TokenRefreshSkew() time.Duration {
+	if config.ENV.CFTokenRefreshSkew != "" {
+		envVal, err := strconv.ParseInt(config.ENV.CFTokenRefreshSkew, 10, 64)
+		if err == nil && envVal >= 0 {
+			return time.Duration(envVal) * time.Second
+		}
+	}
+
+	return DefaultTokenRefreshSkew
+}
+
 func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
@@ -666,6 +736,18 @@ func (config *Config) SetRefreshToken(refreshToken string) {
 	config.ConfigFile.RefreshToken = refreshToken
 }
 
+// SetUAAClientCredentials sets the client ID and secret the CLI uses to
+// authenticate with UAA
+func (config *Config) SetUAAClientCredentials(client string, clientSecret string) {
+	config.ConfigFile.UAAOAuthClient = client
+	config.ConfigFile.UAAOAuthClientSecret = clientSecret
+}
+
+// SetUAAGrantType sets the grant type used to obtain the current access token
+func (config *Config) SetUAAGrantType(uaaGrantType string) {
+	config.ConfigFile.UAAGrantType = uaaGrantType
+}
+
 // SetUAAEndpoint sets the UAA endpoint that is obtained from hitting
 // <AuthorizationEndpoint>/login
 func (config *Config) SetUAAEndpoint(uaaEndpoint string) {
@@ -458,6 +458,24 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("UAAGrantType", func() {
+			var config *Config
+
+			BeforeEach(func() {
+				rawConfig := `{ "UAAGrantType":"client_credentials" }`
+				setConfig(homeDir, rawConfig)
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+			})
+
+			It("returns the grant type", func() {
+				Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+			})
+		})
+
 		DescribeTable("Experimental",
 			func(envVal string, expected bool) {
 				setConfig(homeDir, `{}`)
@@ -621,6 +639,19 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		DescribeTable("TokenRefreshSkew",
+			func(envVal string, expectedSkew time.Duration) {
+				config := Config{ENV: EnvOverride{CFTokenRefreshSkew: envVal}}
+				Expect(config.TokenRefreshSkew()).To(Equal(expectedSkew))
+			},
+
+			Entry("defaults to 1 minute", "", DefaultTokenRefreshSkew),
+			Entry("uses the number of seconds provided", "90", 90*time.Second),
+			Entry("allows disabling the skew", "0", time.Duration(0)),
+			Entry("ignores invalid values", "banana", DefaultTokenRefreshSkew),
+			Entry("ignores negative values", "-5", DefaultTokenRefreshSkew),
+		)
+
 		Describe("BinaryVersion", func() {
 			It("returns back version.BinaryVersion", func() {
 				conf := Config{}
@@ -770,6 +801,23 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetUAAClientCredentials", func() {
+			It("sets the UAA client ID and secret", func() {
+				var config Config
+				config.SetUAAClientCredentials("some-client", "some-secret")
+				Expect(config.ConfigFile.UAAOAuthClient).To(Equal("some-client"))
+				Expect(config.ConfigFile.UAAOAuthClientSecret).To(Equal("some-secret"))
+			})
+		})
+
+		Describe("SetUAAGrantType", func() {
+			It("sets the UAA grant type", func() {
+				var config Config
+				config.SetUAAGrantType("client_credentials")
+				Expect(config.ConfigFile.UAAGrantType).To(Equal("client_credentials"))
+			})
+		})
+
 		Describe("SetUAAEndpoint", func() {
 			It("sets the UAA endpoint", func() {
 				var config Config
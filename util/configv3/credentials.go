@@ -0,0 +1,99 @@
+package configv3
+
+import "code.cloudfoundry.org/cli/util/credentialhelper"
+
+// CredentialHelper returns the executable used to store the access and
+// refresh tokens outside of the config file. CF_CREDENTIAL_HELPER takes
+// precedence over the config file setting. An empty string means the tokens
+// are kept in the config file.
+func (config *Config) CredentialHelper() string {
+	if config.ENV.CFCredentialHelper != "" {
+		return config.ENV.CFCredentialHelper
+	}
+	return config.ConfigFile.CredentialHelper
+}
+
+// loadCredentials reads the tokens for the current target from the
+// credential helper. Tokens still present in the config file, such as those
+// written before a helper was configured, are kept so that the next write
+// moves them to the helper.
+func (config *Config) loadCredentials() error {
+	config.storedCredentials = credentialhelper.Credentials{ServerURL: config.credentialsKey()}
+
+	helper := config.CredentialHelper()
+	if helper == "" || config.ConfigFile.Target == "" {
+		return nil
+	}
+
+	if config.ConfigFile.AccessToken != "" || config.ConfigFile.RefreshToken != "" {
+		return nil
+	}
+
+	credentials, err := credentialhelper.NewHelper(helper).Get(config.credentialsKey())
+	if err == credentialhelper.ErrCredentialsNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	config.ConfigFile.AccessToken = credentials.AccessToken
+	config.ConfigFile.RefreshToken = credentials.RefreshToken
+	config.storedCredentials = credentials
+	return nil
+}
+
+// storeCredentials saves the tokens for the current target with the
+// credential helper, or erases them when they have been cleared, such as on
+// logout or when a new API is targeted. The tokens of a previous target are
+// left in the helper, since another target profile may still use them. The
+// helper is only called when the tokens changed since they were loaded.
+func (config *Config) storeCredentials() error {
+	helper := config.CredentialHelper()
+	if helper == "" || config.ConfigFile.Target == "" {
+		return nil
+	}
+
+	credentials := credentialhelper.Credentials{
+		ServerURL:    config.credentialsKey(),
+		AccessToken:  config.ConfigFile.AccessToken,
+		RefreshToken: config.ConfigFile.RefreshToken,
+	}
+	if credentials == config.storedCredentials {
+		return nil
+	}
+
+	var err error
+	if credentials.AccessToken != "" || credentials.RefreshToken != "" {
+		err = credentialhelper.NewHelper(helper).Store(credentials)
+	} else {
+		err = credentialhelper.NewHelper(helper).Erase(credentials.ServerURL)
+	}
+	if err != nil {
+		return err
+	}
+
+	config.storedCredentials = credentials
+	return nil
+}
+
+// credentialsKey returns the server URL the tokens for the current target are
+// stored under in the credential helper. The URL of a target profile's API is
+// followed by '#' and the profile name, so that profiles targeting the same
+// API keep their own tokens.
+func (config *Config) credentialsKey() string {
+	if config.ConfigFile.TargetProfile == "" {
+		return config.ConfigFile.Target
+	}
+	return config.ConfigFile.Target + "#" + config.ConfigFile.TargetProfile
+}
+
+// configFileWithoutCredentials returns the config file contents to write to
+// disk, leaving the tokens empty when they are kept by a credential helper.
+func (config *Config) configFileWithoutCredentials() CFConfig {
+	configFile := config.ConfigFile
+	if config.CredentialHelper() != "" {
+		configFile.AccessToken = ""
+		configFile.RefreshToken = ""
+	}
+	return configFile
+}
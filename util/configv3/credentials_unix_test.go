@@ -0,0 +1,256 @@
+// +build !windows
+
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
+	fakehelper "code.cloudfoundry.org/cli/util/testhelpers/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credential Helper", func() {
+	var (
+		homeDir    string
+		helperDir  string
+		executable string
+		helper     credentialhelper.Helper
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		var err error
+		helperDir, err = ioutil.TempDir("", "cli-credential-helper-tests")
+		Expect(err).ToNot(HaveOccurred())
+
+		executable, err = fakehelper.WriteFakeHelper(helperDir)
+		Expect(err).ToNot(HaveOccurred())
+		helper = credentialhelper.NewHelper(executable)
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	readConfigFile := func() CFConfig {
+		rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var configFile CFConfig
+		Expect(json.Unmarshal(rawConfig, &configFile)).To(Succeed())
+		return configFile
+	}
+
+	Describe("CredentialHelper", func() {
+		It("returns the config file setting", func() {
+			config := Config{ConfigFile: CFConfig{CredentialHelper: "some-helper"}}
+			Expect(config.CredentialHelper()).To(Equal("some-helper"))
+		})
+
+		It("prefers CF_CREDENTIAL_HELPER", func() {
+			config := Config{
+				ConfigFile: CFConfig{CredentialHelper: "some-helper"},
+				ENV:        EnvOverride{CFCredentialHelper: "env-helper"},
+			}
+			Expect(config.CredentialHelper()).To(Equal("env-helper"))
+		})
+	})
+
+	Context("when a credential helper is configured in the config file", func() {
+		BeforeEach(func() {
+			rawConfig, err := json.Marshal(CFConfig{
+				ConfigVersion:    3,
+				Target:           "https://api.foo.com",
+				CredentialHelper: executable,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			setConfig(homeDir, string(rawConfig))
+		})
+
+		Context("when the helper has tokens for the target", func() {
+			BeforeEach(func() {
+				Expect(helper.Store(credentialhelper.Credentials{
+					ServerURL:    "https://api.foo.com",
+					AccessToken:  "stored-access-token",
+					RefreshToken: "stored-refresh-token",
+				})).To(Succeed())
+			})
+
+			It("loads the tokens from the helper", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("stored-access-token"))
+				Expect(config.RefreshToken()).To(Equal("stored-refresh-token"))
+			})
+
+			It("stores changed tokens with the helper and leaves them out of the config file", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				config.SetAccessToken("new-access-token")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				credentials, err := helper.Get("https://api.foo.com")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credentials.AccessToken).To(Equal("new-access-token"))
+				Expect(credentials.RefreshToken).To(Equal("stored-refresh-token"))
+
+				configFile := readConfigFile()
+				Expect(configFile.AccessToken).To(BeEmpty())
+				Expect(configFile.RefreshToken).To(BeEmpty())
+				Expect(configFile.Target).To(Equal("https://api.foo.com"))
+			})
+
+			It("erases the tokens from the helper when they are cleared", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				config.SetTokenInformation("", "", "")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				_, err = helper.Get("https://api.foo.com")
+				Expect(err).To(MatchError(credentialhelper.ErrCredentialsNotFound))
+			})
+
+			It("does not log back in when the previous API is targeted again", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				config.SetTargetInformation("https://api.bar.com", "", "", "", "", "", false)
+				config.SetTokenInformation("", "", "")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				config.SetTargetInformation("https://api.foo.com", "", "", "", "", "", false)
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(BeEmpty())
+				Expect(config.RefreshToken()).To(BeEmpty())
+
+				_, err = helper.Get("https://api.foo.com")
+				Expect(err).To(MatchError(credentialhelper.ErrCredentialsNotFound))
+			})
+
+			It("keeps the tokens of the previous API when another API is targeted", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				config.SetTargetInformation("https://api.bar.com", "", "", "", "", "", false)
+				config.SetTokenInformation("", "", "")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				credentials, err := helper.Get("https://api.foo.com")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credentials.AccessToken).To(Equal("stored-access-token"))
+			})
+
+			It("keeps the tokens of saved targets on the same API apart", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.SaveTargetProfile("one", false)).To(Succeed())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config.SetAccessToken("two-access-token")
+				Expect(config.SaveTargetProfile("two", false)).To(Succeed())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				credentials, err := helper.Get("https://api.foo.com#one")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credentials.AccessToken).To(Equal("stored-access-token"))
+
+				Expect(os.Setenv("CF_PROFILE", "one")).To(Succeed())
+				defer os.Unsetenv("CF_PROFILE")
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("stored-access-token"))
+
+				Expect(os.Setenv("CF_PROFILE", "two")).To(Succeed())
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("two-access-token"))
+			})
+
+			It("does not write the tokens to saved targets", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.SaveTargetProfile("foo", false)).To(Succeed())
+
+				rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "targets", "foo.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(rawConfig)).ToNot(ContainSubstring("stored-access-token"))
+				Expect(string(rawConfig)).ToNot(ContainSubstring("stored-refresh-token"))
+			})
+		})
+
+		Context("when the helper has no tokens for the target", func() {
+			It("loads the config without tokens", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(BeEmpty())
+			})
+		})
+
+		Context("when the helper fails", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(executable, []byte("#!/bin/sh\necho 'keychain is locked' >&2\nexit 1\n"), 0700)).To(Succeed())
+			})
+
+			It("returns the helper error", func() {
+				_, err := LoadConfig()
+				Expect(err).To(MatchError(credentialhelper.HelperError{
+					Executable: executable,
+					Action:     "get",
+					Message:    "keychain is locked",
+				}))
+			})
+		})
+	})
+
+	Context("when the config file contains tokens and a helper is configured with CF_CREDENTIAL_HELPER", func() {
+		BeforeEach(func() {
+			rawConfig, err := json.Marshal(CFConfig{
+				ConfigVersion: 3,
+				Target:        "https://api.foo.com",
+				AccessToken:   "plaintext-access-token",
+				RefreshToken:  "plaintext-refresh-token",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			setConfig(homeDir, string(rawConfig))
+
+			Expect(os.Setenv("CF_CREDENTIAL_HELPER", executable)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_CREDENTIAL_HELPER")).To(Succeed())
+		})
+
+		It("moves the tokens to the helper on the next write", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("plaintext-access-token"))
+
+			Expect(WriteConfig(config)).To(Succeed())
+
+			credentials, err := helper.Get("https://api.foo.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials.AccessToken).To(Equal("plaintext-access-token"))
+			Expect(credentials.RefreshToken).To(Equal("plaintext-refresh-token"))
+
+			configFile := readConfigFile()
+			Expect(configFile.AccessToken).To(BeEmpty())
+			Expect(configFile.RefreshToken).To(BeEmpty())
+		})
+	})
+})
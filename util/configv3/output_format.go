@@ -0,0 +1,21 @@
+package configv3
+
+const (
+	// OutputFormatText displays human readable tables and messages.
+	OutputFormatText OutputFormat = ""
+
+	// OutputFormatJSON displays the command's data as a JSON document.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML displays the command's data as a YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat is the format in which commands display their data.
+type OutputFormat string
+
+// OutputFormat returns the format requested with the global --output flag.
+// Defaults to OutputFormatText.
+func (config *Config) OutputFormat() OutputFormat {
+	return OutputFormat(config.Flags.OutputFormat)
+}
@@ -0,0 +1,43 @@
+package configv3
+
+import (
+	"sort"
+	"strings"
+)
+
+// PluginTrustedKey is a saved public key that plugin signatures are verified
+// with.
+type PluginTrustedKey struct {
+	Name      string `json:"Name"`
+	PublicKey string `json:"PublicKey"`
+}
+
+// PluginTrustedKeys returns the trusted plugin signing keys from the
+// .cf/config.json, sorted by name.
+func (config *Config) PluginTrustedKeys() []PluginTrustedKey {
+	keys := make([]PluginTrustedKey, len(config.ConfigFile.PluginTrustedKeys))
+	copy(keys, config.ConfigFile.PluginTrustedKeys)
+	sort.Slice(keys, func(i, j int) bool {
+		return strings.ToLower(keys[i].Name) < strings.ToLower(keys[j].Name)
+	})
+	return keys
+}
+
+// AddPluginTrustedKey adds a key to the trusted plugin signing keys. It does
+// not check for duplicates.
+func (config *Config) AddPluginTrustedKey(name string, publicKey string) {
+	config.ConfigFile.PluginTrustedKeys = append(config.ConfigFile.PluginTrustedKeys,
+		PluginTrustedKey{Name: name, PublicKey: publicKey})
+}
+
+// RemovePluginTrustedKey removes the trusted plugin signing key with the
+// given name, ignoring case.
+func (config *Config) RemovePluginTrustedKey(name string) {
+	keys := []PluginTrustedKey{}
+	for _, key := range config.ConfigFile.PluginTrustedKeys {
+		if !strings.EqualFold(key.Name, name) {
+			keys = append(keys, key)
+		}
+	}
+	config.ConfigFile.PluginTrustedKeys = keys
+}
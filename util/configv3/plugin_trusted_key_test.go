@@ -0,0 +1,56 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PluginTrustedKey", func() {
+	var config Config
+
+	BeforeEach(func() {
+		config = Config{
+			ConfigFile: CFConfig{
+				PluginTrustedKeys: []PluginTrustedKey{
+					{Name: "S-key", PublicKey: "S-public-key"},
+					{Name: "key-2", PublicKey: "public-key-2"},
+					{Name: "key-1", PublicKey: "public-key-1"},
+				},
+			},
+		}
+	})
+
+	Describe("PluginTrustedKeys", func() {
+		It("returns sorted trusted keys", func() {
+			Expect(config.PluginTrustedKeys()).To(Equal([]PluginTrustedKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+				{Name: "key-2", PublicKey: "public-key-2"},
+				{Name: "S-key", PublicKey: "S-public-key"},
+			}))
+		})
+
+		It("does not reorder the keys in the config", func() {
+			config.PluginTrustedKeys()
+			Expect(config.ConfigFile.PluginTrustedKeys[0].Name).To(Equal("S-key"))
+		})
+	})
+
+	Describe("AddPluginTrustedKey", func() {
+		It("adds the key to the list of trusted keys", func() {
+			config.AddPluginTrustedKey("some-key", "some-public-key")
+			Expect(config.PluginTrustedKeys()).To(ContainElement(PluginTrustedKey{Name: "some-key", PublicKey: "some-public-key"}))
+		})
+	})
+
+	Describe("RemovePluginTrustedKey", func() {
+		It("removes the key with the name, ignoring case", func() {
+			config.RemovePluginTrustedKey("KEY-2")
+			Expect(config.PluginTrustedKeys()).To(Equal([]PluginTrustedKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+				{Name: "S-key", PublicKey: "S-public-key"},
+			}))
+		})
+	})
+})
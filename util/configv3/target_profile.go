@@ -0,0 +1,256 @@
+package configv3
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+var targetProfileNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// TargetProfile is a named copy of the target information in the config. Each
+// profile is stored as a separate file in the 'targets' directory of the '.cf'
+// directory. Only the API endpoints, tokens, SSL settings and targeted org and
+// space are stored in a profile; all other settings are shared by every
+// profile and kept in the config file.
+type TargetProfile struct {
+	Name                 string
+	Target               string
+	SkipSSLValidation    bool
+	TargetedOrganization Organization
+	TargetedSpace        Space
+}
+
+// TargetProfileName returns the name of the target profile selected with
+// CF_PROFILE, or else the target profile that was last saved or switched to.
+func (config *Config) TargetProfileName() string {
+	return config.ConfigFile.TargetProfile
+}
+
+// TargetProfiles returns the saved target profiles sorted by name.
+func (config *Config) TargetProfiles() ([]TargetProfile, error) {
+	paths, err := filepath.Glob(filepath.Join(targetProfileDirectory(), "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := []TargetProfile{}
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		profileConfig, err := readTargetProfile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		profiles = append(profiles, TargetProfile{
+			Name:                 name,
+			Target:               profileConfig.Target,
+			SkipSSLValidation:    profileConfig.SkipSSLValidation,
+			TargetedOrganization: profileConfig.TargetedOrganization,
+			TargetedSpace:        profileConfig.TargetedSpace,
+		})
+	}
+
+	sort.Slice(profiles, func(i int, j int) bool {
+		return strings.ToLower(profiles[i].Name) < strings.ToLower(profiles[j].Name)
+	})
+	return profiles, nil
+}
+
+// SaveTargetProfile saves the current target information as a target profile
+// with the provided name. An existing profile is only overwritten if force is
+// true.
+func (config *Config) SaveTargetProfile(name string, force bool) error {
+	if !targetProfileNameRegexp.MatchString(name) {
+		return translatableerror.InvalidTargetProfileNameError{Name: name}
+	}
+
+	if _, err := os.Stat(TargetProfilePath(name)); err == nil && !force {
+		return translatableerror.TargetProfileAlreadyExistsError{Name: name}
+	}
+
+	config.ConfigFile.TargetProfile = name
+	return writeTargetProfile(name, config.configFileWithoutCredentials())
+}
+
+// SwitchTargetProfile replaces the current target information with the
+// target information of the named profile. Before switching, the target
+// information of the current profile, such as refreshed tokens, is saved back
+// to that profile if it still targets the same API. When a credential helper
+// is configured, the tokens for the new target are read from the helper. The
+// new target is written to the config file, even when a profile was selected
+// with CF_PROFILE.
+func (config *Config) SwitchTargetProfile(name string) error {
+	_, err := readTargetProfile(name)
+	if err != nil {
+		return err
+	}
+
+	err = config.storeCredentials()
+	if err != nil {
+		return err
+	}
+
+	currentName := config.ConfigFile.TargetProfile
+	if currentName != "" {
+		currentConfig, readErr := readTargetProfile(currentName)
+		if readErr == nil && currentConfig.Target == config.ConfigFile.Target {
+			err = writeTargetProfile(currentName, config.configFileWithoutCredentials())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	profileConfig, err := readTargetProfile(name)
+	if err != nil {
+		return err
+	}
+
+	copyTargetInformation(&config.ConfigFile, profileConfig)
+	config.ConfigFile.TargetProfile = name
+	config.selectedTargetProfile = ""
+	return config.loadCredentials()
+}
+
+// DeleteTargetProfile deletes the named target profile. The current target
+// information is left unchanged, unless the profile was selected with
+// CF_PROFILE, in which case the target information in the config file is used
+// again.
+func (config *Config) DeleteTargetProfile(name string) error {
+	if _, err := readTargetProfile(name); err != nil {
+		return err
+	}
+
+	err := os.Remove(TargetProfilePath(name))
+	if err != nil {
+		return err
+	}
+
+	if config.selectedTargetProfile == name {
+		copyTargetInformation(&config.ConfigFile, config.defaultTarget)
+		config.ConfigFile.TargetProfile = config.defaultTarget.TargetProfile
+		config.selectedTargetProfile = ""
+		err = config.loadCredentials()
+		if err != nil {
+			return err
+		}
+	}
+
+	if config.ConfigFile.TargetProfile == name {
+		config.ConfigFile.TargetProfile = ""
+	}
+	return nil
+}
+
+// TargetProfilePath returns the path of the config file for the named target
+// profile.
+func TargetProfilePath(name string) string {
+	return filepath.Join(targetProfileDirectory(), filepath.Base(name)+".json")
+}
+
+// selectTargetProfile replaces the target information read from the config
+// file with that of the named profile for this invocation. It returns an
+// error when the profile does not exist.
+func (config *Config) selectTargetProfile(name string) error {
+	profileConfig, err := readTargetProfile(name)
+	if err != nil {
+		return err
+	}
+
+	copyTargetInformation(&config.defaultTarget, config.ConfigFile)
+	config.defaultTarget.TargetProfile = config.ConfigFile.TargetProfile
+
+	copyTargetInformation(&config.ConfigFile, profileConfig)
+	config.ConfigFile.TargetProfile = name
+	config.selectedTargetProfile = name
+	return nil
+}
+
+// writeSelectedTargetProfile saves the target information back to the
+// profile selected with CF_PROFILE, if any, and returns the contents of the
+// config file with the target information it had when it was loaded.
+func (config *Config) writeSelectedTargetProfile() (CFConfig, error) {
+	configFile := config.configFileWithoutCredentials()
+	if config.selectedTargetProfile == "" {
+		return configFile, nil
+	}
+
+	err := writeTargetProfile(config.selectedTargetProfile, configFile)
+	if err != nil {
+		return CFConfig{}, err
+	}
+
+	copyTargetInformation(&configFile, config.defaultTarget)
+	configFile.TargetProfile = config.defaultTarget.TargetProfile
+	return configFile, nil
+}
+
+func targetProfileDirectory() string {
+	return filepath.Join(configDirectory(), "targets")
+}
+
+func readTargetProfile(name string) (CFConfig, error) {
+	if !targetProfileNameRegexp.MatchString(name) {
+		return CFConfig{}, translatableerror.InvalidTargetProfileNameError{Name: name}
+	}
+
+	rawConfig, err := ioutil.ReadFile(TargetProfilePath(name))
+	if os.IsNotExist(err) {
+		return CFConfig{}, translatableerror.TargetProfileNotFoundError{Name: name}
+	} else if err != nil {
+		return CFConfig{}, err
+	}
+
+	var profileConfig CFConfig
+	err = json.Unmarshal(rawConfig, &profileConfig)
+	if err != nil {
+		return CFConfig{}, err
+	}
+	return profileConfig, nil
+}
+
+func writeTargetProfile(name string, config CFConfig) error {
+	profileConfig := CFConfig{ConfigVersion: 3}
+	copyTargetInformation(&profileConfig, config)
+
+	rawConfig, err := json.MarshalIndent(profileConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(targetProfileDirectory(), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(TargetProfilePath(name), rawConfig, 0600)
+}
+
+// copyTargetInformation copies the API endpoints, tokens, SSL settings and
+// targeted org and space from one config to another.
+func copyTargetInformation(dst *CFConfig, src CFConfig) {
+	dst.Target = src.Target
+	dst.APIVersion = src.APIVersion
+	dst.AuthorizationEndpoint = src.AuthorizationEndpoint
+	dst.DopplerEndpoint = src.DopplerEndpoint
+	dst.UAAEndpoint = src.UAAEndpoint
+	dst.RoutingEndpoint = src.RoutingEndpoint
+	dst.AccessToken = src.AccessToken
+	dst.RefreshToken = src.RefreshToken
+	dst.SSHOAuthClient = src.SSHOAuthClient
+	dst.UAAOAuthClient = src.UAAOAuthClient
+	dst.UAAOAuthClientSecret = src.UAAOAuthClientSecret
+	dst.UAAGrantType = src.UAAGrantType
+	dst.TargetedOrganization = src.TargetedOrganization
+	dst.TargetedSpace = src.TargetedSpace
+	dst.SkipSSLValidation = src.SkipSSLValidation
+	dst.MinCLIVersion = src.MinCLIVersion
+	dst.MinRecommendedCLIVersion = src.MinRecommendedCLIVersion
+}
@@ -0,0 +1,294 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Target Profiles", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		config = &Config{
+			ConfigFile: CFConfig{
+				Target:            "https://api.foo.com",
+				AccessToken:       "foo-access-token",
+				RefreshToken:      "foo-refresh-token",
+				SkipSSLValidation: true,
+				TargetedOrganization: Organization{
+					GUID: "foo-org-guid",
+					Name: "foo-org",
+				},
+				TargetedSpace: Space{
+					GUID: "foo-space-guid",
+					Name: "foo-space",
+				},
+				ColorEnabled: "true",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readProfile := func(name string) CFConfig {
+		rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "targets", name+".json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var profileConfig CFConfig
+		Expect(json.Unmarshal(rawConfig, &profileConfig)).To(Succeed())
+		return profileConfig
+	}
+
+	Describe("SaveTargetProfile", func() {
+		It("writes the config to the targets directory and marks it as current", func() {
+			Expect(config.SaveTargetProfile("foo", false)).To(Succeed())
+
+			profileConfig := readProfile("foo")
+			Expect(profileConfig.Target).To(Equal("https://api.foo.com"))
+			Expect(profileConfig.AccessToken).To(Equal("foo-access-token"))
+			Expect(profileConfig.TargetedSpace.Name).To(Equal("foo-space"))
+			Expect(profileConfig.ColorEnabled).To(BeEmpty())
+			Expect(config.TargetProfileName()).To(Equal("foo"))
+		})
+
+		Context("when the profile already exists", func() {
+			BeforeEach(func() {
+				Expect(config.SaveTargetProfile("foo", false)).To(Succeed())
+				config.ConfigFile.Target = "https://api.bar.com"
+			})
+
+			It("returns a TargetProfileAlreadyExistsError", func() {
+				err := config.SaveTargetProfile("foo", false)
+				Expect(err).To(MatchError(translatableerror.TargetProfileAlreadyExistsError{Name: "foo"}))
+				Expect(readProfile("foo").Target).To(Equal("https://api.foo.com"))
+			})
+
+			Context("when force is true", func() {
+				It("overwrites the profile", func() {
+					Expect(config.SaveTargetProfile("foo", true)).To(Succeed())
+					Expect(readProfile("foo").Target).To(Equal("https://api.bar.com"))
+				})
+			})
+		})
+
+		Context("when the name is invalid", func() {
+			It("returns an InvalidTargetProfileNameError", func() {
+				err := config.SaveTargetProfile("../foo", false)
+				Expect(err).To(MatchError(translatableerror.InvalidTargetProfileNameError{Name: "../foo"}))
+			})
+		})
+	})
+
+	Describe("TargetProfiles", func() {
+		Context("when there are no profiles", func() {
+			It("returns an empty list", func() {
+				profiles, err := config.TargetProfiles()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(profiles).To(BeEmpty())
+			})
+		})
+
+		Context("when there are profiles", func() {
+			BeforeEach(func() {
+				Expect(config.SaveTargetProfile("foo", false)).To(Succeed())
+				config.ConfigFile.Target = "https://api.bar.com"
+				config.ConfigFile.SkipSSLValidation = false
+				config.ConfigFile.TargetedSpace = Space{}
+				Expect(config.SaveTargetProfile("Bar", false)).To(Succeed())
+			})
+
+			It("returns the profiles sorted by name", func() {
+				profiles, err := config.TargetProfiles()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(profiles).To(Equal([]TargetProfile{
+					{
+						Name:                 "Bar",
+						Target:               "https://api.bar.com",
+						TargetedOrganization: Organization{GUID: "foo-org-guid", Name: "foo-org"},
+					},
+					{
+						Name:                 "foo",
+						Target:               "https://api.foo.com",
+						SkipSSLValidation:    true,
+						TargetedOrganization: Organization{GUID: "foo-org-guid", Name: "foo-org"},
+						TargetedSpace:        Space{GUID: "foo-space-guid", Name: "foo-space"},
+					},
+				}))
+			})
+		})
+	})
+
+	Describe("SwitchTargetProfile", func() {
+		BeforeEach(func() {
+			Expect(config.SaveTargetProfile("foo", false)).To(Succeed())
+
+			barConfig := Config{
+				ConfigFile: CFConfig{
+					Target:       "https://api.bar.com",
+					AccessToken:  "bar-access-token",
+					RefreshToken: "bar-refresh-token",
+					TargetedOrganization: Organization{
+						GUID: "bar-org-guid",
+						Name: "bar-org",
+					},
+				},
+			}
+			Expect(barConfig.SaveTargetProfile("bar", false)).To(Succeed())
+		})
+
+		It("replaces the target information and leaves the other settings", func() {
+			Expect(config.SwitchTargetProfile("bar")).To(Succeed())
+
+			Expect(config.Target()).To(Equal("https://api.bar.com"))
+			Expect(config.AccessToken()).To(Equal("bar-access-token"))
+			Expect(config.RefreshToken()).To(Equal("bar-refresh-token"))
+			Expect(config.SkipSSLValidation()).To(BeFalse())
+			Expect(config.TargetedOrganization().Name).To(Equal("bar-org"))
+			Expect(config.HasTargetedSpace()).To(BeFalse())
+			Expect(config.ConfigFile.ColorEnabled).To(Equal("true"))
+			Expect(config.TargetProfileName()).To(Equal("bar"))
+		})
+
+		It("saves the current target information back to the current profile", func() {
+			config.SetAccessToken("refreshed-foo-access-token")
+			Expect(config.SwitchTargetProfile("bar")).To(Succeed())
+
+			Expect(readProfile("foo").AccessToken).To(Equal("refreshed-foo-access-token"))
+		})
+
+		Context("when the current target no longer matches the current profile", func() {
+			It("does not modify the current profile", func() {
+				config.ConfigFile.Target = "https://api.baz.com"
+				Expect(config.SwitchTargetProfile("bar")).To(Succeed())
+
+				Expect(readProfile("foo").Target).To(Equal("https://api.foo.com"))
+			})
+		})
+
+		Context("when the profile does not exist", func() {
+			It("returns a TargetProfileNotFoundError and leaves the target unchanged", func() {
+				err := config.SwitchTargetProfile("baz")
+				Expect(err).To(MatchError(translatableerror.TargetProfileNotFoundError{Name: "baz"}))
+				Expect(config.Target()).To(Equal("https://api.foo.com"))
+			})
+		})
+	})
+
+	Describe("DeleteTargetProfile", func() {
+		BeforeEach(func() {
+			Expect(config.SaveTargetProfile("foo", false)).To(Succeed())
+		})
+
+		It("removes the profile and unsets the current profile name", func() {
+			Expect(config.DeleteTargetProfile("foo")).To(Succeed())
+
+			_, err := os.Stat(filepath.Join(homeDir, ".cf", "targets", "foo.json"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			Expect(config.TargetProfileName()).To(BeEmpty())
+			Expect(config.Target()).To(Equal("https://api.foo.com"))
+		})
+
+		Context("when the profile does not exist", func() {
+			It("returns a TargetProfileNotFoundError", func() {
+				err := config.DeleteTargetProfile("bar")
+				Expect(err).To(MatchError(translatableerror.TargetProfileNotFoundError{Name: "bar"}))
+			})
+		})
+	})
+
+	Context("when CF_PROFILE is set", func() {
+		BeforeEach(func() {
+			config.ConfigFile.PluginTrustedKeys = []PluginTrustedKey{{Name: "some-key", PublicKey: "some-public-key"}}
+			Expect(WriteConfig(config)).To(Succeed())
+
+			profile := Config{
+				ConfigFile: CFConfig{
+					Target:       "https://api.bar.com",
+					AccessToken:  "bar-access-token",
+					RefreshToken: "bar-refresh-token",
+				},
+			}
+			Expect(profile.SaveTargetProfile("bar", false)).To(Succeed())
+
+			Expect(os.Setenv("CF_PROFILE", "bar")).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+		})
+
+		It("reads the target from the profile and the other settings from the config file", func() {
+			loadedConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(loadedConfig.Target()).To(Equal("https://api.bar.com"))
+			Expect(loadedConfig.AccessToken()).To(Equal("bar-access-token"))
+			Expect(loadedConfig.HasTargetedOrganization()).To(BeFalse())
+			Expect(loadedConfig.TargetProfileName()).To(Equal("bar"))
+			Expect(loadedConfig.ConfigFile.ColorEnabled).To(Equal("true"))
+			Expect(loadedConfig.PluginTrustedKeys()).To(HaveLen(1))
+		})
+
+		It("writes the target to the profile and the other settings to the config file", func() {
+			loadedConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			loadedConfig.SetAccessToken("refreshed-bar-access-token")
+			loadedConfig.SetOrganizationInformation("bar-org-guid", "bar-org")
+			loadedConfig.ConfigFile.ColorEnabled = "false"
+			Expect(WriteConfig(loadedConfig)).To(Succeed())
+
+			profileConfig := readProfile("bar")
+			Expect(profileConfig.AccessToken).To(Equal("refreshed-bar-access-token"))
+			Expect(profileConfig.TargetedOrganization.Name).To(Equal("bar-org"))
+			Expect(profileConfig.ColorEnabled).To(BeEmpty())
+
+			Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+			defaultConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(defaultConfig.Target()).To(Equal("https://api.foo.com"))
+			Expect(defaultConfig.AccessToken()).To(Equal("foo-access-token"))
+			Expect(defaultConfig.TargetedOrganization().Name).To(Equal("foo-org"))
+			Expect(defaultConfig.ConfigFile.ColorEnabled).To(Equal("false"))
+			Expect(defaultConfig.TargetProfileName()).To(BeEmpty())
+		})
+
+		Context("when the profile is deleted", func() {
+			It("uses the target in the config file again", func() {
+				loadedConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loadedConfig.DeleteTargetProfile("bar")).To(Succeed())
+
+				Expect(loadedConfig.Target()).To(Equal("https://api.foo.com"))
+				Expect(loadedConfig.TargetProfileName()).To(BeEmpty())
+				Expect(WriteConfig(loadedConfig)).To(Succeed())
+
+				_, err = os.Stat(filepath.Join(homeDir, ".cf", "targets", "bar.json"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the profile does not exist", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_PROFILE", "baz")).To(Succeed())
+			})
+
+			It("returns a TargetProfileNotFoundError", func() {
+				_, err := LoadConfig()
+				Expect(err).To(MatchError(translatableerror.TargetProfileNotFoundError{Name: "baz"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package configv3
+
+import "strings"
+
+const (
+	// TraceFormatText writes requests and responses as human readable dumps.
+	TraceFormatText TraceFormat = "text"
+
+	// TraceFormatJSON writes one JSON object per request and per response.
+	TraceFormatJSON TraceFormat = "json"
+)
+
+// TraceFormat is the format in which CF_TRACE output is written.
+type TraceFormat string
+
+// TraceFormat returns the trace format based off:
+//   1. The $CF_TRACE_FORMAT environment variable if set (text/json)
+//   2. Defaults to TraceFormatText
+func (config *Config) TraceFormat() TraceFormat {
+	if strings.ToLower(config.ENV.CFTraceFormat) == string(TraceFormatJSON) {
+		return TraceFormatJSON
+	}
+
+	return TraceFormatText
+}
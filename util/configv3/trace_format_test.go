@@ -0,0 +1,44 @@
+package configv3_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("TraceFormat",
+		func(envVal string, expected TraceFormat) {
+			defer os.Unsetenv("CF_TRACE_FORMAT")
+			if envVal == "" {
+				os.Unsetenv("CF_TRACE_FORMAT")
+			} else {
+				os.Setenv("CF_TRACE_FORMAT", envVal)
+			}
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.TraceFormat()).To(Equal(expected))
+		},
+		Entry("env=json", "json", TraceFormatJSON),
+		Entry("env=JSON", "JSON", TraceFormatJSON),
+		Entry("env=text", "text", TraceFormatText),
+		Entry("env=unknown falls back to text", "xml", TraceFormatText),
+		Entry("env=unset falls back to text", "", TraceFormatText),
+	)
+})
@@ -24,7 +24,15 @@ func decodeUserFromJWT(accessToken string) (User, error) {
 	}
 
 	claims := token.Claims()
+
+	// Tokens granted to clients rather than users have no user name, so the
+	// client ID is used in its place.
+	name, ok := claims.Get("user_name").(string)
+	if !ok {
+		name, _ = claims.Get("client_id").(string)
+	}
+
 	return User{
-		Name: claims.Get("user_name").(string),
+		Name: name,
 	}, nil
 }
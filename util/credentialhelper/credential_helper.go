@@ -0,0 +1,151 @@
+// Package credentialhelper stores and retrieves CF access and refresh tokens
+// using an external credential helper executable.
+//
+// The helper is invoked with a single argument, 'get', 'store' or 'erase', and
+// speaks the same stdin/stdout protocol as Docker credential helpers, so any
+// docker-credential-* helper can be used:
+//   get:   reads the API URL from stdin and writes the credentials JSON to
+//          stdout
+//   store: reads the credentials JSON from stdin
+//   erase: reads the API URL from stdin
+//
+// The credentials JSON has the form {"ServerURL": "...", "Username": "cf",
+// "Secret": "..."} where the secret holds both tokens.
+package credentialhelper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Username is the user name stored alongside the tokens.
+const Username = "cf"
+
+// ErrCredentialsNotFound is returned when the helper has no credentials for
+// the requested API URL.
+var ErrCredentialsNotFound = errors.New("credentials not found")
+
+// Credentials are the tokens for a single API URL.
+type Credentials struct {
+	ServerURL    string
+	AccessToken  string
+	RefreshToken string
+}
+
+// HelperError is returned when the helper executable fails.
+type HelperError struct {
+	Executable string
+	Action     string
+	Message    string
+}
+
+func (e HelperError) Error() string {
+	return fmt.Sprintf("credential helper '%s' failed to %s credentials: %s", e.Executable, e.Action, e.Message)
+}
+
+// Helper runs a credential helper executable.
+type Helper struct {
+	Executable string
+}
+
+// NewHelper returns a Helper for the provided executable name or path.
+func NewHelper(executable string) Helper {
+	return Helper{Executable: executable}
+}
+
+type message struct {
+	ServerURL string
+	Username  string
+	Secret    string
+}
+
+type secret struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// Get returns the credentials stored for the provided API URL.
+func (helper Helper) Get(serverURL string) (Credentials, error) {
+	output, err := helper.run("get", []byte(serverURL))
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var msg message
+	err = json.Unmarshal(output, &msg)
+	if err != nil {
+		return Credentials{}, HelperError{Executable: helper.Executable, Action: "get", Message: err.Error()}
+	}
+
+	var tokens secret
+	err = json.Unmarshal([]byte(msg.Secret), &tokens)
+	if err != nil {
+		return Credentials{}, HelperError{Executable: helper.Executable, Action: "get", Message: err.Error()}
+	}
+
+	return Credentials{
+		ServerURL:    serverURL,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+// Store saves the credentials for their API URL, replacing any existing
+// credentials.
+func (helper Helper) Store(credentials Credentials) error {
+	tokens, err := json.Marshal(secret{
+		AccessToken:  credentials.AccessToken,
+		RefreshToken: credentials.RefreshToken,
+	})
+	if err != nil {
+		return err
+	}
+
+	input, err := json.Marshal(message{
+		ServerURL: credentials.ServerURL,
+		Username:  Username,
+		Secret:    string(tokens),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = helper.run("store", input)
+	return err
+}
+
+// Erase removes the credentials for the provided API URL. Erasing credentials
+// that do not exist is not an error.
+func (helper Helper) Erase(serverURL string) error {
+	_, err := helper.run("erase", []byte(serverURL))
+	if err == ErrCredentialsNotFound {
+		return nil
+	}
+	return err
+}
+
+func (helper Helper) run(action string, input []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(helper.Executable, action)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stdout.String() + stderr.String())
+		if strings.Contains(message, ErrCredentialsNotFound.Error()) {
+			return nil, ErrCredentialsNotFound
+		}
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, HelperError{Executable: helper.Executable, Action: action, Message: message}
+	}
+
+	return stdout.Bytes(), nil
+}
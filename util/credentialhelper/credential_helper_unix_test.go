@@ -0,0 +1,141 @@
+// +build !windows
+
+package credentialhelper_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/credentialhelper"
+	fakehelper "code.cloudfoundry.org/cli/util/testhelpers/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Helper", func() {
+	var (
+		helperDir string
+		helper    Helper
+	)
+
+	BeforeEach(func() {
+		var err error
+		helperDir, err = ioutil.TempDir("", "cli-credential-helper-tests")
+		Expect(err).ToNot(HaveOccurred())
+
+		executable, err := fakehelper.WriteFakeHelper(helperDir)
+		Expect(err).ToNot(HaveOccurred())
+		helper = NewHelper(executable)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	Describe("Store", func() {
+		It("sends the tokens in the secret of a Docker credential helper message", func() {
+			err := helper.Store(Credentials{
+				ServerURL:    "https://api.foo.com",
+				AccessToken:  "some-access-token",
+				RefreshToken: "some-refresh-token",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			stored, err := fakehelper.StoredCredentials(helperDir, "https://api.foo.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored).To(MatchJSON(`{
+				"ServerURL": "https://api.foo.com",
+				"Username": "cf",
+				"Secret": "{\"AccessToken\":\"some-access-token\",\"RefreshToken\":\"some-refresh-token\"}"
+			}`))
+		})
+	})
+
+	Describe("Get", func() {
+		Context("when credentials are stored for the API URL", func() {
+			BeforeEach(func() {
+				Expect(helper.Store(Credentials{
+					ServerURL:    "https://api.foo.com",
+					AccessToken:  "some-access-token",
+					RefreshToken: "some-refresh-token",
+				})).To(Succeed())
+			})
+
+			It("returns the credentials", func() {
+				credentials, err := helper.Get("https://api.foo.com")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credentials).To(Equal(Credentials{
+					ServerURL:    "https://api.foo.com",
+					AccessToken:  "some-access-token",
+					RefreshToken: "some-refresh-token",
+				}))
+			})
+		})
+
+		Context("when no credentials are stored for the API URL", func() {
+			It("returns ErrCredentialsNotFound", func() {
+				_, err := helper.Get("https://api.bar.com")
+				Expect(err).To(MatchError(ErrCredentialsNotFound))
+			})
+		})
+
+		Context("when the helper returns an invalid message", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(helperDir, "store-https___api_foo_com"), []byte("not json"), 0600)).To(Succeed())
+			})
+
+			It("returns a HelperError", func() {
+				_, err := helper.Get("https://api.foo.com")
+				Expect(err).To(BeAssignableToTypeOf(HelperError{}))
+				Expect(err.Error()).To(ContainSubstring("failed to get credentials"))
+			})
+		})
+	})
+
+	Describe("Erase", func() {
+		It("removes the stored credentials", func() {
+			Expect(helper.Store(Credentials{ServerURL: "https://api.foo.com", AccessToken: "some-access-token"})).To(Succeed())
+			Expect(helper.Erase("https://api.foo.com")).To(Succeed())
+
+			_, err := helper.Get("https://api.foo.com")
+			Expect(err).To(MatchError(ErrCredentialsNotFound))
+		})
+
+		Context("when no credentials are stored for the API URL", func() {
+			It("does not return an error", func() {
+				Expect(helper.Erase("https://api.bar.com")).To(Succeed())
+			})
+		})
+	})
+
+	Context("when the helper executable does not exist", func() {
+		BeforeEach(func() {
+			helper = NewHelper(filepath.Join(helperDir, "does-not-exist"))
+		})
+
+		It("returns a HelperError", func() {
+			_, err := helper.Get("https://api.foo.com")
+			Expect(err).To(MatchError(HelperError{
+				Executable: filepath.Join(helperDir, "does-not-exist"),
+				Action:     "get",
+				Message:    "fork/exec " + filepath.Join(helperDir, "does-not-exist") + ": no such file or directory",
+			}))
+		})
+	})
+
+	Context("when the helper fails", func() {
+		It("returns a HelperError with the helper's output", func() {
+			err := ioutil.WriteFile(filepath.Join(helperDir, "failing-helper"), []byte("#!/bin/sh\necho 'keychain is locked' >&2\nexit 1\n"), 0700)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = NewHelper(filepath.Join(helperDir, "failing-helper")).Store(Credentials{ServerURL: "https://api.foo.com"})
+			Expect(err).To(MatchError(HelperError{
+				Executable: filepath.Join(helperDir, "failing-helper"),
+				Action:     "store",
+				Message:    "keychain is locked",
+			}))
+		})
+	})
+})
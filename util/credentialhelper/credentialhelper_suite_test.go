@@ -0,0 +1,13 @@
+package credentialhelper_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestCredentialHelper(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Credential Helper Suite")
+}
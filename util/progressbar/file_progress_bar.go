@@ -0,0 +1,40 @@
+package progressbar
+
+import (
+	"io"
+
+	pb "gopkg.in/cheggaaa/pb.v1"
+)
+
+// FileProgressBar displays the progress of copying several files, one bar
+// per file.
+type FileProgressBar struct {
+	output io.Writer
+	bar    *pb.ProgressBar
+}
+
+// NewFileProgressBar returns a FileProgressBar that displays its bars on
+// output.
+func NewFileProgressBar(output io.Writer) *FileProgressBar {
+	return &FileProgressBar{
+		output: output,
+	}
+}
+
+// Start displays a new bar for the file with the given name and size, and
+// returns a reader that advances the bar as reader is read.
+func (p *FileProgressBar) Start(name string, size int64, reader io.Reader) io.Reader {
+	p.bar = pb.New64(size).SetUnits(pb.U_BYTES).Prefix(name + " ")
+	p.bar.Output = p.output
+	p.bar.ShowTimeLeft = false
+	p.bar.Start()
+	return p.bar.NewProxyReader(reader)
+}
+
+// Finish completes the bar of the current file.
+func (p *FileProgressBar) Finish() {
+	if p.bar != nil {
+		p.bar.Finish()
+		p.bar = nil
+	}
+}
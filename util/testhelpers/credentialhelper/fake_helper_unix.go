@@ -0,0 +1,71 @@
+// +build !windows
+
+package credentialhelper
+
+import (
+	"io/ioutil"
+	"path/filepath"
+)
+
+const fakeHelperScript = `#!/bin/sh
+dir=$(dirname "$0")
+key() {
+	echo "$1" | tr -c 'a-zA-Z0-9\n' '_'
+}
+case "$1" in
+get)
+	url=$(cat)
+	file="$dir/store-$(key "$url")"
+	if [ -f "$file" ]; then
+		cat "$file"
+	else
+		echo "credentials not found in native keychain"
+		exit 1
+	fi
+	;;
+store)
+	input=$(cat)
+	url=$(echo "$input" | sed -n 's/.*"ServerURL":"\([^"]*\)".*/\1/p')
+	echo "$input" > "$dir/store-$(key "$url")"
+	;;
+erase)
+	url=$(cat)
+	file="$dir/store-$(key "$url")"
+	if [ -f "$file" ]; then
+		rm "$file"
+	else
+		echo "credentials not found in native keychain"
+		exit 1
+	fi
+	;;
+*)
+	echo "unknown action: $1" >&2
+	exit 1
+	;;
+esac
+`
+
+// WriteFakeHelper writes a credential helper script to dir that keeps
+// credentials in files next to the script, and returns the script's path.
+func WriteFakeHelper(dir string) (string, error) {
+	path := filepath.Join(dir, "fake-credential-helper")
+	err := ioutil.WriteFile(path, []byte(fakeHelperScript), 0700)
+	return path, err
+}
+
+// StoredCredentials returns the raw message stored by the fake helper in dir
+// for the provided API URL, or an error if there is none.
+func StoredCredentials(dir string, serverURL string) (string, error) {
+	raw, err := ioutil.ReadFile(filepath.Join(dir, "store-"+key(serverURL)))
+	return string(raw), err
+}
+
+func key(serverURL string) string {
+	key := []byte(serverURL)
+	for i, c := range key {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			key[i] = '_'
+		}
+	}
+	return string(key)
+}
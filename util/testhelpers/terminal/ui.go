@@ -19,7 +19,10 @@ type FakeUI struct {
 	WarnOutputs                   []string
 	Prompts                       []string
 	PasswordPrompts               []string
+	SelectionPrompts              []string
+	SelectionOptions              [][]string
 	Inputs                        []string
+	Interactive                   bool
 	FailedWithUsage               bool
 	FailedWithUsageCommandName    string
 	ShowConfigurationCalled       bool
@@ -127,6 +130,23 @@ func (ui *FakeUI) AskForPassword(prompt string) string {
 	return answer
 }
 
+func (ui *FakeUI) AskForSelection(prompt string, options []string) string {
+	ui.SelectionPrompts = append(ui.SelectionPrompts, prompt)
+	ui.SelectionOptions = append(ui.SelectionOptions, options)
+
+	if len(ui.Inputs) == 0 {
+		panic("No input provided to Fake UI for prompt: " + prompt)
+	}
+
+	answer := ui.Inputs[0]
+	ui.Inputs = ui.Inputs[1:]
+	return answer
+}
+
+func (ui *FakeUI) IsInteractive() bool {
+	return ui.Interactive
+}
+
 func (ui *FakeUI) Ok() {
 	ui.Say("OK")
 }
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// LogFormatJSON is the --format that displays each log message as a JSON
+// object on its own line.
+const LogFormatJSON = "json"
+
+// LogFormat describes how DisplayLogMessageWithFormat displays log messages.
+// The zero value displays them like DisplayLogMessage, prefixed with the app
+// name of an AppLogMessage.
+type LogFormat struct {
+	// JSON displays each message as a JSON object on its own line.
+	JSON bool
+	// Template displays each message by executing the template with a
+	// LogFields.
+	Template *template.Template
+	// UTC displays timestamps in UTC instead of UI.TimezoneLocation.
+	UTC bool
+}
+
+// AppLogMessage is a LogMessage that names the app it is from. When the logs of
+// several apps are displayed together each message is prefixed with its app
+// name.
+type AppLogMessage interface {
+	LogMessage
+	AppName() string
+}
+
+// logAppNameColors are the colors app names are displayed in, chosen by the
+// app name so that each app keeps its color.
+var logAppNameColors = []color.Attribute{
+	color.FgCyan,
+	color.FgMagenta,
+	color.FgYellow,
+	color.FgGreen,
+	color.FgBlue,
+}
+
+func logAppNameColor(appName string) color.Attribute {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(appName))
+	return logAppNameColors[hash.Sum32()%uint32(len(logAppNameColors))]
+}
+
+// LogFields are the fields of a log message that are displayed in JSON or
+// are available to a --format template.
+type LogFields struct {
+	AppName        string    `json:"app_name,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+	SourceType     string    `json:"source_type"`
+	SourceInstance string    `json:"source_instance"`
+	Stream         string    `json:"stream"`
+	Message        string    `json:"message"`
+}
+
+// InvalidLogFormatError is returned when a log format is neither json nor a
+// valid Go template.
+type InvalidLogFormatError struct {
+	Format string
+	Err    error
+}
+
+func (e InvalidLogFormatError) Error() string {
+	return fmt.Sprintf("'%s' is not a valid log format, expected json or a Go template: %s", e.Format, e.Err)
+}
+
+// NewLogFormat returns the log format described by the --format and --utc
+// flags. format is empty for the default layout, json, or a Go template.
+func NewLogFormat(format string, utc bool) (LogFormat, error) {
+	logFormat := LogFormat{UTC: utc}
+
+	switch format {
+	case "":
+	case LogFormatJSON:
+		logFormat.JSON = true
+	default:
+		logTemplate, err := template.New("log format").Option("missingkey=error").Parse(format)
+		if err != nil {
+			return LogFormat{}, InvalidLogFormatError{Format: format, Err: err}
+		}
+		logFormat.Template = logTemplate
+	}
+
+	return logFormat, nil
+}
+
+// DisplayLogMessageWithFormat outputs the log message to UI.Out in the given
+// format. JSON and template output is neither indented nor colored, so that it
+// can be parsed by other tools.
+func (ui *UI) DisplayLogMessageWithFormat(message LogMessage, format LogFormat) error {
+	location := ui.TimezoneLocation
+	if format.UTC {
+		location = time.UTC
+	}
+
+	var appName string
+	if appMessage, ok := message.(AppLogMessage); ok {
+		appName = appMessage.AppName()
+	}
+
+	if !format.JSON && format.Template == nil {
+		ui.displayLogMessage(message, true, location, appName)
+		return nil
+	}
+
+	stream := "stdout"
+	if message.Type() == "ERR" {
+		stream = "stderr"
+	}
+
+	fields := LogFields{
+		AppName:        appName,
+		Timestamp:      message.Timestamp().In(location),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Stream:         stream,
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	}
+
+	var output string
+	if format.JSON {
+		// time.Time marshals as RFC3339 with nanoseconds
+		line, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		output = string(line)
+	} else {
+		var line bytes.Buffer
+		err := format.Template.Execute(&line, fields)
+		if err != nil {
+			return err
+		}
+		output = line.String()
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err := fmt.Fprintln(ui.Out, output)
+	return err
+}
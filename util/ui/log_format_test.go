@@ -0,0 +1,134 @@
+package ui_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+type appLogMessage struct {
+	*uifakes.FakeLogMessage
+	appName string
+}
+
+func (message appLogMessage) AppName() string {
+	return message.appName
+}
+
+var _ = Describe("LogFormat", func() {
+	Describe("NewLogFormat", func() {
+		It("returns the default format when no format is given", func() {
+			format, err := NewLogFormat("", false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(format).To(Equal(LogFormat{}))
+		})
+
+		It("returns the JSON format for json", func() {
+			format, err := NewLogFormat("json", true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(format).To(Equal(LogFormat{JSON: true, UTC: true}))
+		})
+
+		It("parses anything else as a template", func() {
+			format, err := NewLogFormat("{{.Message}}", false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(format.Template).NotTo(BeNil())
+		})
+
+		It("returns an InvalidLogFormatError when the template does not parse", func() {
+			_, err := NewLogFormat("{{.Message", false)
+			Expect(err).To(BeAssignableToTypeOf(InvalidLogFormatError{}))
+			Expect(err.(InvalidLogFormatError).Format).To(Equal("{{.Message"))
+		})
+	})
+
+	Describe("DisplayLogMessageWithFormat", func() {
+		var (
+			ui      *UI
+			message *uifakes.FakeLogMessage
+		)
+
+		BeforeEach(func() {
+			ui = NewTestUI(nil, NewBuffer(), NewBuffer())
+
+			var err error
+			ui.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a \"log\" message\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 0)) // "2016-07-19T16:08:12-07:00"
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		Context("with the default format", func() {
+			It("displays the message like DisplayLogMessage", func() {
+				Expect(ui.DisplayLogMessageWithFormat(message, LogFormat{})).To(Succeed())
+				Expect(ui.Out).To(Say(`   2016-07-19T16:08:12.00-0700 \[APP/PROC/WEB/12\] ERR This is a "log" message\n`))
+			})
+
+			It("displays the timestamp in UTC with --utc", func() {
+				Expect(ui.DisplayLogMessageWithFormat(message, LogFormat{UTC: true})).To(Succeed())
+				Expect(ui.Out).To(Say(`   2016-07-19T23:08:12.00\+0000 \[APP/PROC/WEB/12\] ERR This is a "log" message\n`))
+			})
+		})
+
+		Context("with the JSON format", func() {
+			It("displays the message as a JSON object on one line", func() {
+				Expect(ui.DisplayLogMessageWithFormat(message, LogFormat{JSON: true, UTC: true})).To(Succeed())
+				Expect(string(ui.Out.(*Buffer).Contents())).To(Equal(
+					`{"timestamp":"2016-07-19T23:08:12Z","source_type":"APP/PROC/WEB","source_instance":"12","stream":"stderr","message":"This is a \"log\" message"}` + "\n",
+				))
+			})
+		})
+
+		Context("when the message names its app", func() {
+			var namedMessage appLogMessage
+
+			BeforeEach(func() {
+				namedMessage = appLogMessage{FakeLogMessage: message, appName: "some-app"}
+			})
+
+			It("prefixes the message with the app name", func() {
+				Expect(ui.DisplayLogMessageWithFormat(namedMessage, LogFormat{})).To(Succeed())
+				Expect(ui.Out).To(Say(`   some-app 2016-07-19T16:08:12.00-0700 \[APP/PROC/WEB/12\] ERR This is a "log" message\n`))
+			})
+
+			It("includes the app name in JSON", func() {
+				Expect(ui.DisplayLogMessageWithFormat(namedMessage, LogFormat{JSON: true})).To(Succeed())
+				Expect(ui.Out).To(Say(`\{"app_name":"some-app","timestamp":`))
+			})
+
+			It("makes the app name available to templates", func() {
+				format, err := NewLogFormat(`{{.AppName}}: {{.Message}}`, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(ui.DisplayLogMessageWithFormat(namedMessage, format)).To(Succeed())
+				Expect(ui.Out).To(Say(`some-app: This is a "log" message\n`))
+			})
+		})
+
+		Context("with a template", func() {
+			It("displays the message with the template", func() {
+				format, err := NewLogFormat(`{{.Timestamp.Format "15:04:05"}} {{.SourceType}}/{{.SourceInstance}} {{.Stream}}: {{.Message}}`, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(ui.DisplayLogMessageWithFormat(message, format)).To(Succeed())
+				Expect(ui.Out).To(Say(`16:08:12 APP/PROC/WEB/12 stderr: This is a "log" message\n`))
+			})
+
+			It("returns an error when the template cannot be executed", func() {
+				format, err := NewLogFormat(`{{.Nope}}`, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(ui.DisplayLogMessageWithFormat(message, format)).NotTo(Succeed())
+			})
+		})
+	})
+})
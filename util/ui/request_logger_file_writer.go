@@ -29,6 +29,10 @@ func newRequestLoggerFileWriter(ui *UI, lock *sync.Mutex, filePaths []string) *R
 	}
 }
 
+func (display *RequestLoggerFileWriter) DisplayAttempt(int) error {
+	return nil
+}
+
 func (display *RequestLoggerFileWriter) DisplayBody([]byte) error {
 	for _, logFile := range display.logFiles {
 		_, err := logFile.WriteString(RedactedValue)
@@ -39,6 +43,10 @@ func (display *RequestLoggerFileWriter) DisplayBody([]byte) error {
 	return nil
 }
 
+func (display *RequestLoggerFileWriter) DisplayBodySize(int64) error {
+	return nil
+}
+
 func (display *RequestLoggerFileWriter) DisplayDump(dump string) error {
 	sanitized := display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
 	for _, logFile := range display.logFiles {
@@ -50,6 +58,10 @@ func (display *RequestLoggerFileWriter) DisplayDump(dump string) error {
 	return nil
 }
 
+func (display *RequestLoggerFileWriter) DisplayElapsedTime(time.Duration) error {
+	return nil
+}
+
 func (display *RequestLoggerFileWriter) DisplayHeader(name string, value string) error {
 	return display.DisplayMessage(fmt.Sprintf("%s: %s", name, value))
 }
@@ -108,6 +120,10 @@ func (display *RequestLoggerFileWriter) DisplayType(name string, requestDate tim
 	return display.DisplayMessage(fmt.Sprintf("%s: [%s]", name, requestDate.Format(time.RFC3339)))
 }
 
+func (display *RequestLoggerFileWriter) DisplayURL(string) error {
+	return nil
+}
+
 func (display *RequestLoggerFileWriter) HandleInternalError(err error) {
 	display.ui.DisplayWarning(err.Error())
 }
@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestLoggerJSONWriter writes every traced request and response as a
+// single line of JSON, either to the terminal or to a list of files. Bodies
+// are never written.
+type RequestLoggerJSONWriter struct {
+	ui            *UI
+	lock          *sync.Mutex
+	filePaths     []string
+	dumpSanitizer *regexp.Regexp
+
+	record     requestLoggerJSONRecord
+	lastMethod string
+	lastURL    string
+}
+
+type requestLoggerJSONRecord struct {
+	Type      string   `json:"type"`
+	Timestamp string   `json:"timestamp"`
+	Method    string   `json:"method,omitempty"`
+	URL       string   `json:"url,omitempty"`
+	Status    int      `json:"status,omitempty"`
+	RequestID string   `json:"request_id,omitempty"`
+	LatencyMS *float64 `json:"latency_ms,omitempty"`
+	Attempt   int      `json:"attempt,omitempty"`
+	Bytes     *int64   `json:"bytes,omitempty"`
+	Dump      string   `json:"dump,omitempty"`
+}
+
+func newRequestLoggerJSONWriter(ui *UI, lock *sync.Mutex, filePaths []string) *RequestLoggerJSONWriter {
+	return &RequestLoggerJSONWriter{
+		ui:            ui,
+		lock:          lock,
+		filePaths:     filePaths,
+		dumpSanitizer: regexp.MustCompile(tokenRegexp),
+	}
+}
+
+func (display *RequestLoggerJSONWriter) DisplayAttempt(attempt int) error {
+	display.record.Attempt = attempt
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayBody([]byte) error {
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayBodySize(size int64) error {
+	if size >= 0 {
+		display.record.Bytes = &size
+	}
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayDump(dump string) error {
+	display.record.Dump = display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayElapsedTime(elapsed time.Duration) error {
+	latency := float64(elapsed) / float64(time.Millisecond)
+	display.record.LatencyMS = &latency
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayHeader(name string, value string) error {
+	if strings.EqualFold(name, "X-Vcap-Request-Id") {
+		display.record.RequestID = value
+	}
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayHost(string) error {
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayJSONBody([]byte) error {
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayMessage(string) error {
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
+	display.record.Method = method
+	display.lastMethod = method
+	return nil
+}
+
+// DisplayResponseHeader records the status code of the response. The method
+// and URL are taken from the last request displayed by this writer.
+func (display *RequestLoggerJSONWriter) DisplayResponseHeader(httpProtocol string, status string) error {
+	display.record.Method = display.lastMethod
+	display.record.URL = display.lastURL
+
+	fields := strings.Fields(status)
+	if len(fields) > 0 {
+		code, err := strconv.Atoi(fields[0])
+		if err == nil {
+			display.record.Status = code
+		}
+	}
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) DisplayType(name string, requestDate time.Time) error {
+	display.record.Type = name
+	display.record.Timestamp = requestDate.Format(time.RFC3339Nano)
+	return nil
+}
+
+// DisplayURL records the full URL of the request, including its scheme.
+func (display *RequestLoggerJSONWriter) DisplayURL(url string) error {
+	display.record.URL = url
+	display.lastURL = url
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) HandleInternalError(err error) {
+	display.ui.DisplayWarning(err.Error())
+}
+
+func (display *RequestLoggerJSONWriter) Start() error {
+	display.lock.Lock()
+	display.record = requestLoggerJSONRecord{}
+	return nil
+}
+
+func (display *RequestLoggerJSONWriter) Stop() error {
+	defer display.lock.Unlock()
+
+	buff := new(bytes.Buffer)
+	encoder := json.NewEncoder(buff)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(display.record)
+	if err != nil {
+		return err
+	}
+
+	if display.filePaths == nil {
+		_, err = display.ui.Out.Write(buff.Bytes())
+		return err
+	}
+
+	for _, filePath := range display.filePaths {
+		err = os.MkdirAll(filepath.Dir(filePath), os.ModeDir|os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		logFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return err
+		}
+
+		_, writeErr := logFile.Write(buff.Bytes())
+		closeErr := logFile.Close()
+		switch {
+		case writeErr != nil:
+			return writeErr
+		case closeErr != nil:
+			return closeErr
+		}
+	}
+	return nil
+}
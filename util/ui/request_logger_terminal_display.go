@@ -25,17 +25,29 @@ func newRequestLoggerTerminalDisplay(ui *UI, lock *sync.Mutex) *RequestLoggerTer
 	}
 }
 
+func (display *RequestLoggerTerminalDisplay) DisplayAttempt(int) error {
+	return nil
+}
+
 func (display *RequestLoggerTerminalDisplay) DisplayBody([]byte) error {
 	fmt.Fprintf(display.ui.Out, "%s\n", RedactedValue)
 	return nil
 }
 
+func (display *RequestLoggerTerminalDisplay) DisplayBodySize(int64) error {
+	return nil
+}
+
 func (display *RequestLoggerTerminalDisplay) DisplayDump(dump string) error {
 	sanitized := display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
 	fmt.Fprintf(display.ui.Out, "%s\n", sanitized)
 	return nil
 }
 
+func (display *RequestLoggerTerminalDisplay) DisplayElapsedTime(time.Duration) error {
+	return nil
+}
+
 func (display *RequestLoggerTerminalDisplay) DisplayHeader(name string, value string) error {
 	fmt.Fprintf(display.ui.Out, "%s: %s\n", display.ui.TranslateText(name), value)
 	return nil
@@ -91,6 +103,10 @@ func (display *RequestLoggerTerminalDisplay) DisplayType(name string, requestDat
 	return nil
 }
 
+func (display *RequestLoggerTerminalDisplay) DisplayURL(string) error {
+	return nil
+}
+
 func (display *RequestLoggerTerminalDisplay) HandleInternalError(err error) {
 	fmt.Fprintf(display.ui.Err, "%s\n", display.ui.TranslateText(err.Error()))
 }
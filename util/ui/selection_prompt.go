@@ -0,0 +1,298 @@
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// DefaultSelectionPageSize is the number of options SelectionPrompt displays
+// at once when PageSize is not set.
+const DefaultSelectionPageSize = 10
+
+// SelectionPrompt lets the user pick one of a list of options on a terminal.
+// Typing filters the options, the arrow keys move through the matches and
+// page up/page down (or left/right) move a page at a time.
+type SelectionPrompt struct {
+	// In is the terminal input. When it is a terminal it is switched to raw
+	// mode while the prompt is displayed.
+	In io.Reader
+	// Out is the terminal output
+	Out io.Writer
+
+	// Prompt is displayed above the options
+	Prompt string
+	// Hint is displayed below the options to explain the keys
+	Hint string
+	// NoMatches is displayed in place of the options when none match the filter
+	NoMatches string
+	// PageSize is the number of options displayed at once
+	PageSize int
+}
+
+type selectionKey int
+
+const (
+	selectionKeyNone selectionKey = iota
+	selectionKeyRune
+	selectionKeyBackspace
+	selectionKeyClear
+	selectionKeyUp
+	selectionKeyDown
+	selectionKeyPageUp
+	selectionKeyPageDown
+	selectionKeyEnter
+	selectionKeyCancel
+)
+
+type selectionState struct {
+	options  []string
+	filter   []rune
+	matches  []string
+	cursor   int
+	pageSize int
+}
+
+// Select displays the prompt and returns the chosen option. Nothing is
+// highlighted until the user types or moves, so pressing enter straight away
+// (or ctrl-c/ctrl-d at any time) skips the prompt and returns "".
+func (prompt SelectionPrompt) Select(options []string) (string, error) {
+	if file, ok := prompt.In.(*os.File); ok && terminal.IsTerminal(int(file.Fd())) {
+		state, err := terminal.MakeRaw(int(file.Fd()))
+		if err != nil {
+			return "", err
+		}
+		defer terminal.Restore(int(file.Fd()), state)
+	}
+
+	pageSize := prompt.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultSelectionPageSize
+	}
+
+	state := &selectionState{
+		options:  options,
+		matches:  options,
+		cursor:   -1,
+		pageSize: pageSize,
+	}
+
+	reader := bufio.NewReader(prompt.In)
+	renderedLines := 0
+	for {
+		renderedLines = prompt.render(state, renderedLines)
+
+		key, r, err := readSelectionKey(reader)
+		if err == io.EOF {
+			key = selectionKeyCancel
+		} else if err != nil {
+			return "", err
+		}
+
+		switch key {
+		case selectionKeyEnter:
+			selected := state.selected()
+			prompt.finish(renderedLines, selected)
+			return selected, nil
+		case selectionKeyCancel:
+			prompt.finish(renderedLines, "")
+			return "", nil
+		default:
+			state.handle(key, r)
+		}
+	}
+}
+
+func (state *selectionState) handle(key selectionKey, r rune) {
+	switch key {
+	case selectionKeyRune:
+		state.filter = append(state.filter, r)
+		state.applyFilter()
+	case selectionKeyBackspace:
+		if len(state.filter) > 0 {
+			state.filter = state.filter[:len(state.filter)-1]
+			state.applyFilter()
+		}
+	case selectionKeyClear:
+		state.filter = nil
+		state.applyFilter()
+	case selectionKeyUp:
+		state.moveTo(state.cursor - 1)
+	case selectionKeyDown:
+		state.moveTo(state.cursor + 1)
+	case selectionKeyPageUp:
+		state.moveTo(state.cursor - state.pageSize)
+	case selectionKeyPageDown:
+		if state.cursor < 0 {
+			state.cursor = 0
+		}
+		state.moveTo(state.cursor + state.pageSize)
+	}
+}
+
+func (state *selectionState) applyFilter() {
+	if len(state.filter) == 0 {
+		state.matches = state.options
+		state.cursor = -1
+		return
+	}
+
+	filter := strings.ToLower(string(state.filter))
+	state.matches = nil
+	for _, option := range state.options {
+		if strings.Contains(strings.ToLower(option), filter) {
+			state.matches = append(state.matches, option)
+		}
+	}
+
+	state.cursor = 0
+	if len(state.matches) == 0 {
+		state.cursor = -1
+	}
+}
+
+func (state *selectionState) moveTo(cursor int) {
+	if len(state.matches) == 0 {
+		return
+	}
+	if cursor < 0 {
+		cursor = 0
+	}
+	if cursor >= len(state.matches) {
+		cursor = len(state.matches) - 1
+	}
+	state.cursor = cursor
+}
+
+func (state *selectionState) selected() string {
+	if state.cursor < 0 || state.cursor >= len(state.matches) {
+		return ""
+	}
+	return state.matches[state.cursor]
+}
+
+// page returns the index of the first and one past the last match displayed.
+func (state *selectionState) page() (int, int) {
+	first := 0
+	if state.cursor > 0 {
+		first = state.cursor / state.pageSize * state.pageSize
+	}
+	last := first + state.pageSize
+	if last > len(state.matches) {
+		last = len(state.matches)
+	}
+	return first, last
+}
+
+// render redraws the prompt in place of the previously rendered lines and
+// returns the number of lines it rendered.
+func (prompt SelectionPrompt) render(state *selectionState, renderedLines int) int {
+	var lines []string
+	lines = append(lines, prompt.Prompt, "> "+string(state.filter))
+
+	first, last := state.page()
+	if len(state.matches) == 0 {
+		lines = append(lines, "  "+prompt.NoMatches)
+	} else {
+		for i := first; i < last; i++ {
+			marker := "  "
+			if i == state.cursor {
+				marker = "> "
+			}
+			lines = append(lines, marker+state.matches[i])
+		}
+		lines = append(lines, fmt.Sprintf("(%d-%d/%d) %s", first+1, last, len(state.matches), prompt.Hint))
+	}
+
+	prompt.clear(renderedLines)
+	for _, line := range lines {
+		fmt.Fprintf(prompt.Out, "%s\r\n", line)
+	}
+	return len(lines)
+}
+
+func (prompt SelectionPrompt) finish(renderedLines int, selected string) {
+	prompt.clear(renderedLines)
+	fmt.Fprintf(prompt.Out, "%s %s\r\n", prompt.Prompt, selected)
+}
+
+func (prompt SelectionPrompt) clear(renderedLines int) {
+	if renderedLines > 0 {
+		fmt.Fprintf(prompt.Out, "\x1b[%dA\r\x1b[J", renderedLines)
+	}
+}
+
+func readSelectionKey(reader *bufio.Reader) (selectionKey, rune, error) {
+	r, _, err := reader.ReadRune()
+	if err != nil {
+		return selectionKeyNone, 0, err
+	}
+
+	switch r {
+	case '\r', '\n':
+		return selectionKeyEnter, r, nil
+	case 3, 4: // ctrl-c, ctrl-d
+		return selectionKeyCancel, r, nil
+	case 8, 127: // ctrl-h, backspace
+		return selectionKeyBackspace, r, nil
+	case 21: // ctrl-u
+		return selectionKeyClear, r, nil
+	case 16: // ctrl-p
+		return selectionKeyUp, r, nil
+	case 14: // ctrl-n
+		return selectionKeyDown, r, nil
+	case 27: // escape
+		return readSelectionEscapeSequence(reader)
+	}
+
+	if unicode.IsPrint(r) {
+		return selectionKeyRune, r, nil
+	}
+	return selectionKeyNone, r, nil
+}
+
+// readSelectionEscapeSequence reads the rest of an escape sequence. A lone
+// escape cannot be told apart from the start of a sequence without a
+// timeout, so pressing escape twice cancels the prompt.
+func readSelectionEscapeSequence(reader *bufio.Reader) (selectionKey, rune, error) {
+	r, _, err := reader.ReadRune()
+	if err != nil {
+		return selectionKeyNone, 0, err
+	}
+	if r != '[' && r != 'O' {
+		return selectionKeyCancel, r, nil
+	}
+
+	var parameter []rune
+	for {
+		r, _, err = reader.ReadRune()
+		if err != nil {
+			return selectionKeyNone, 0, err
+		}
+		if r < '0' || r > '9' {
+			break
+		}
+		parameter = append(parameter, r)
+	}
+
+	switch {
+	case r == 'A':
+		return selectionKeyUp, r, nil
+	case r == 'B':
+		return selectionKeyDown, r, nil
+	case r == 'C':
+		return selectionKeyPageDown, r, nil
+	case r == 'D':
+		return selectionKeyPageUp, r, nil
+	case r == '~' && string(parameter) == "5":
+		return selectionKeyPageUp, r, nil
+	case r == '~' && string(parameter) == "6":
+		return selectionKeyPageDown, r, nil
+	}
+	return selectionKeyNone, r, nil
+}
@@ -0,0 +1,149 @@
+package ui_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("SelectionPrompt", func() {
+	const (
+		up       = "\x1b[A"
+		down     = "\x1b[B"
+		pageUp   = "\x1b[5~"
+		pageDown = "\x1b[6~"
+	)
+
+	var (
+		prompt  SelectionPrompt
+		options []string
+		input   string
+		out     *Buffer
+		answer  string
+		err     error
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		options = nil
+		for i := 1; i <= 25; i++ {
+			options = append(options, fmt.Sprintf("org-%02d", i))
+		}
+		options = append(options, "Other-Team")
+	})
+
+	JustBeforeEach(func() {
+		prompt = SelectionPrompt{
+			In:        strings.NewReader(input),
+			Out:       out,
+			Prompt:    "Select an org:",
+			Hint:      "some-hint",
+			NoMatches: "No matches",
+			PageSize:  10,
+		}
+		answer, err = prompt.Select(options)
+	})
+
+	Context("when the user presses enter straight away", func() {
+		BeforeEach(func() {
+			input = "\r"
+		})
+
+		It("displays the first page and skips the prompt", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(answer).To(BeEmpty())
+			Expect(out).To(Say("Select an org:\r\n> \r\n  org-01\r\n"))
+			Expect(out).To(Say("  org-10\r\n\\(1-10/26\\) some-hint\r\n"))
+			Expect(out).To(Say("Select an org: \r\n"))
+		})
+	})
+
+	Context("when the user moves down", func() {
+		BeforeEach(func() {
+			input = down + down + up + "\r"
+		})
+
+		It("returns the highlighted option", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(answer).To(Equal("org-01"))
+			Expect(out).To(Say("> org-01\r\n  org-02"))
+			Expect(out).To(Say("Select an org: org-01\r\n"))
+		})
+	})
+
+	Context("when the user pages through the options", func() {
+		BeforeEach(func() {
+			input = pageDown + pageDown + pageDown + pageUp + "\r"
+		})
+
+		It("displays each page and returns the highlighted option", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(answer).To(Equal("org-16"))
+			Expect(out).To(Say("> org-11\r\n"))
+			Expect(out).To(Say("\\(11-20/26\\)"))
+			Expect(out).To(Say("> org-21\r\n"))
+			Expect(out).To(Say("> Other-Team\r\n\\(21-26/26\\)"))
+		})
+	})
+
+	Context("when the user types a filter", func() {
+		BeforeEach(func() {
+			input = "OT" + "\r"
+		})
+
+		It("displays the options containing it, ignoring case, and returns the first", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(answer).To(Equal("Other-Team"))
+			Expect(out).To(Say("> OT\r\n> Other-Team\r\n\\(1-1/1\\)"))
+		})
+
+		Context("when the filter matches nothing", func() {
+			BeforeEach(func() {
+				input = "xyz\r"
+			})
+
+			It("displays that nothing matches and skips the prompt on enter", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(answer).To(BeEmpty())
+				Expect(out).To(Say("> xyz\r\n  No matches\r\n"))
+			})
+		})
+
+		Context("when the user deletes part of the filter", func() {
+			BeforeEach(func() {
+				input = "org-2x\x7f" + down + "\r"
+			})
+
+			It("filters on what is left", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(answer).To(Equal("org-21"))
+			})
+		})
+	})
+
+	Context("when the user cancels the prompt", func() {
+		BeforeEach(func() {
+			input = down + "\x03"
+		})
+
+		It("returns an empty answer", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(answer).To(BeEmpty())
+		})
+	})
+
+	Context("when the input ends", func() {
+		BeforeEach(func() {
+			input = down
+		})
+
+		It("returns an empty answer", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(answer).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,298 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/sorting"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+var ansiEscapeRegexp = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// TableView selects the columns of a table and sorts and filters its rows
+// before it is displayed. Columns are named by their untranslated header,
+// ignoring case, with spaces written as dashes, for example "last-operation".
+type TableView struct {
+	// Columns are the columns to display, in order. All columns are displayed
+	// when it is empty.
+	Columns []string
+	// SortBy is the column the rows are sorted by. The rows keep their order
+	// when it is empty.
+	SortBy string
+	// SortDescending sorts the rows in descending order.
+	SortDescending bool
+	// Filters keep only the rows that match all of them.
+	Filters []TableFilter
+}
+
+// TableViewFlags are the --columns, --sort-by and --filter flags of the list
+// commands. Commands embed it and display their table with the view it
+// returns.
+type TableViewFlags struct {
+	Columns string   `long:"columns" description:"Display only these comma separated columns, in this order"`
+	SortBy  string   `long:"sort-by" description:"Sort the rows by COLUMN, in descending order with COLUMN:desc"`
+	Filter  []string `long:"filter" description:"Display only the rows where COLUMN matches GLOB, given as COLUMN=GLOB (may be repeated)"`
+}
+
+// TableView returns the view described by the flags for a table with the
+// given untranslated column names. Commands call it before displaying
+// anything, so that invalid flags fail the command without partial output.
+func (flags TableViewFlags) TableView(columns []string) (TableView, error) {
+	view, err := NewTableView(flags.Columns, flags.SortBy, flags.Filter)
+	if err != nil {
+		return TableView{}, err
+	}
+
+	err = view.Validate(columns)
+	if err != nil {
+		return TableView{}, err
+	}
+	return view, nil
+}
+
+// TableFilter keeps the rows whose value in Column matches the glob Pattern,
+// ignoring case. In the pattern '*' matches any number of characters and '?'
+// matches a single character.
+type TableFilter struct {
+	Column  string
+	Pattern string
+}
+
+// InvalidTableSortError is returned when a sort order is not of the form
+// COLUMN[:asc|:desc].
+type InvalidTableSortError struct {
+	SortBy string
+}
+
+func (e InvalidTableSortError) Error() string {
+	return fmt.Sprintf("'%s' is not a valid sort order, expected COLUMN[:asc|:desc]", e.SortBy)
+}
+
+// InvalidTableFilterError is returned when a filter is not of the form
+// COLUMN=GLOB.
+type InvalidTableFilterError struct {
+	Filter string
+}
+
+func (e InvalidTableFilterError) Error() string {
+	return fmt.Sprintf("'%s' is not a valid filter, expected COLUMN=GLOB", e.Filter)
+}
+
+// TableColumnNotFoundError is returned when a view names a column the table
+// does not have.
+type TableColumnNotFoundError struct {
+	Column  string
+	Columns []string
+}
+
+func (e TableColumnNotFoundError) Error() string {
+	return fmt.Sprintf("column '%s' not found, available columns: %s", e.Column, strings.Join(e.Columns, ", "))
+}
+
+// NewTableView returns the view described by the --columns, --sort-by and
+// --filter flags. columns is a comma separated list of column names, sortBy
+// is COLUMN[:asc|:desc] and each filter is COLUMN=GLOB.
+func NewTableView(columns string, sortBy string, filters []string) (TableView, error) {
+	var view TableView
+
+	for _, column := range strings.Split(columns, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			view.Columns = append(view.Columns, column)
+		}
+	}
+
+	if sortBy != "" {
+		view.SortBy = sortBy
+		if i := strings.LastIndex(sortBy, ":"); i >= 0 {
+			view.SortBy = sortBy[:i]
+			switch strings.ToLower(sortBy[i+1:]) {
+			case "asc":
+			case "desc":
+				view.SortDescending = true
+			default:
+				return TableView{}, InvalidTableSortError{SortBy: sortBy}
+			}
+		}
+		if view.SortBy == "" {
+			return TableView{}, InvalidTableSortError{SortBy: sortBy}
+		}
+	}
+
+	for _, filter := range filters {
+		parts := strings.SplitN(filter, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return TableView{}, InvalidTableFilterError{Filter: filter}
+		}
+		view.Filters = append(view.Filters, TableFilter{Column: parts[0], Pattern: parts[1]})
+	}
+
+	return view, nil
+}
+
+// Validate returns a TableColumnNotFoundError when the view names a column
+// that is not one of the given column names.
+func (view TableView) Validate(columns []string) error {
+	names := append([]string{view.SortBy}, view.Columns...)
+	for _, filter := range view.Filters {
+		names = append(names, filter.Column)
+	}
+
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		if _, err := tableColumnIndex(columns, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply returns the table, whose first row is the untranslated header, with
+// the view applied. It returns a TableColumnNotFoundError when the view names
+// a column the table does not have.
+func (view TableView) Apply(table [][]string) ([][]string, error) {
+	if len(table) == 0 {
+		return table, nil
+	}
+	return view.ApplyWithColumns(table[0], table)
+}
+
+// ApplyWithColumns is Apply for tables whose header has already been
+// translated. The view's columns are looked up in the untranslated column
+// names given in columns, in the same order as the header.
+func (view TableView) ApplyWithColumns(columns []string, table [][]string) ([][]string, error) {
+	if len(table) == 0 {
+		return table, nil
+	}
+	header := table[0]
+
+	rows := table[1:]
+	for _, filter := range view.Filters {
+		column, err := tableColumnIndex(columns, filter.Column)
+		if err != nil {
+			return nil, err
+		}
+
+		matcher := tableGlobRegexp(filter.Pattern)
+		var matchingRows [][]string
+		for _, row := range rows {
+			if matcher.MatchString(decolorize(tableCell(row, column))) {
+				matchingRows = append(matchingRows, row)
+			}
+		}
+		rows = matchingRows
+	}
+
+	if view.SortBy != "" {
+		column, err := tableColumnIndex(columns, view.SortBy)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append([][]string{}, rows...)
+		sort.SliceStable(rows, func(i int, j int) bool {
+			if view.SortDescending {
+				return lessTableCell(decolorize(tableCell(rows[j], column)), decolorize(tableCell(rows[i], column)))
+			}
+			return lessTableCell(decolorize(tableCell(rows[i], column)), decolorize(tableCell(rows[j], column)))
+		})
+	}
+
+	result := append([][]string{header}, rows...)
+	if len(view.Columns) == 0 {
+		return result, nil
+	}
+
+	var selectedColumns []int
+	for _, name := range view.Columns {
+		column, err := tableColumnIndex(columns, name)
+		if err != nil {
+			return nil, err
+		}
+		selectedColumns = append(selectedColumns, column)
+	}
+
+	for i, row := range result {
+		selected := make([]string, len(selectedColumns))
+		for j, column := range selectedColumns {
+			selected[j] = tableCell(row, column)
+		}
+		result[i] = selected
+	}
+	return result, nil
+}
+
+// tableColumnName returns the name a column is selected by.
+func tableColumnName(header string) string {
+	return strings.Replace(strings.ToLower(strings.TrimSpace(decolorize(header))), " ", "-", -1)
+}
+
+func tableColumnIndex(header []string, name string) (int, error) {
+	var names []string
+	for i, column := range header {
+		columnName := tableColumnName(column)
+		if columnName == "" {
+			continue
+		}
+		if columnName == tableColumnName(name) {
+			return i, nil
+		}
+		names = append(names, columnName)
+	}
+
+	return -1, TableColumnNotFoundError{Column: name, Columns: names}
+}
+
+func tableCell(row []string, column int) string {
+	if column >= len(row) {
+		return ""
+	}
+	return row[column]
+}
+
+func tableGlobRegexp(pattern string) *regexp.Regexp {
+	var expression []string
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expression = append(expression, ".*")
+		case '?':
+			expression = append(expression, ".")
+		default:
+			expression = append(expression, regexp.QuoteMeta(string(r)))
+		}
+	}
+	return regexp.MustCompile("(?is)^" + strings.Join(expression, "") + "$")
+}
+
+// lessTableCell compares numbers, percentages and byte sizes such as 512M by
+// value and everything else alphabetically.
+func lessTableCell(a string, b string) bool {
+	aValue, aIsNumber := tableCellValue(a)
+	bValue, bIsNumber := tableCellValue(b)
+	if aIsNumber && bIsNumber {
+		return aValue < bValue
+	}
+
+	return sorting.SortAlphabeticFunc([]string{a, b})(0, 1)
+}
+
+func tableCellValue(cell string) (float64, bool) {
+	cell = strings.TrimSpace(cell)
+	if value, err := strconv.ParseFloat(strings.TrimSuffix(cell, "%"), 64); err == nil {
+		return value, true
+	}
+	if bytes, err := bytefmt.ToBytes(cell); err == nil {
+		return float64(bytes), true
+	}
+	return 0, false
+}
+
+func decolorize(text string) string {
+	return ansiEscapeRegexp.ReplaceAllString(text, "")
+}
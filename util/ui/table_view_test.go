@@ -0,0 +1,157 @@
+package ui_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TableView", func() {
+	Describe("NewTableView", func() {
+		It("parses the columns, sort order and filters", func() {
+			view, err := NewTableView("name, state,urls", "memory:desc", []string{"name=web-*", "state=running=yes"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(view).To(Equal(TableView{
+				Columns:        []string{"name", "state", "urls"},
+				SortBy:         "memory",
+				SortDescending: true,
+				Filters: []TableFilter{
+					{Column: "name", Pattern: "web-*"},
+					{Column: "state", Pattern: "running=yes"},
+				},
+			}))
+		})
+
+		It("sorts in ascending order by default", func() {
+			view, err := NewTableView("", "name", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(view).To(Equal(TableView{SortBy: "name"}))
+
+			view, err = NewTableView("", "name:ASC", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(view).To(Equal(TableView{SortBy: "name"}))
+		})
+
+		DescribeTable("returns an InvalidTableSortError for an invalid sort order",
+			func(sortBy string) {
+				_, err := NewTableView("", sortBy, nil)
+				Expect(err).To(MatchError(InvalidTableSortError{SortBy: sortBy}))
+			},
+			Entry("unknown direction", "name:sideways"),
+			Entry("missing column", ":desc"),
+		)
+
+		DescribeTable("returns an InvalidTableFilterError for an invalid filter",
+			func(filter string) {
+				_, err := NewTableView("", "", []string{filter})
+				Expect(err).To(MatchError(InvalidTableFilterError{Filter: filter}))
+			},
+			Entry("missing equals sign", "name"),
+			Entry("missing column", "=web-*"),
+		)
+	})
+
+	Describe("TableViewFlags", func() {
+		It("returns the view described by the flags", func() {
+			view, err := TableViewFlags{Columns: "name", SortBy: "memory:desc"}.TableView([]string{"name", "memory"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(view).To(Equal(TableView{
+				Columns:        []string{"name"},
+				SortBy:         "memory",
+				SortDescending: true,
+			}))
+		})
+
+		It("returns a TableColumnNotFoundError when a flag names an unknown column", func() {
+			_, err := TableViewFlags{Filter: []string{"disk=1G"}}.TableView([]string{"name", "requested state"})
+			Expect(err).To(MatchError(TableColumnNotFoundError{
+				Column:  "disk",
+				Columns: []string{"name", "requested-state"},
+			}))
+		})
+	})
+
+	Describe("Apply", func() {
+		var table [][]string
+
+		BeforeEach(func() {
+			table = [][]string{
+				{"name", "requested state", "memory"},
+				{"web-b", "started", "1G"},
+				{"worker", "\x1b[31;1mstopped\x1b[0m", "512M"},
+				{"Web-a", "started", "64M"},
+			}
+		})
+
+		It("leaves the table unchanged when the view is empty", func() {
+			Expect(TableView{}.Apply(table)).To(Equal(table))
+		})
+
+		It("displays the selected columns in order", func() {
+			Expect(TableView{Columns: []string{"memory", "Name"}}.Apply(table)).To(Equal([][]string{
+				{"memory", "name"},
+				{"1G", "web-b"},
+				{"512M", "worker"},
+				{"64M", "Web-a"},
+			}))
+		})
+
+		It("names columns with spaces using dashes", func() {
+			Expect(TableView{Columns: []string{"requested-state"}}.Apply(table)).To(Equal([][]string{
+				{"requested state"},
+				{"started"},
+				{"\x1b[31;1mstopped\x1b[0m"},
+				{"started"},
+			}))
+		})
+
+		It("sorts the rows alphabetically", func() {
+			Expect(TableView{SortBy: "name"}.Apply(table)).To(Equal([][]string{
+				table[0], table[3], table[1], table[2],
+			}))
+		})
+
+		It("sorts byte sizes by value in descending order", func() {
+			Expect(TableView{SortBy: "memory", SortDescending: true}.Apply(table)).To(Equal([][]string{
+				table[0], table[1], table[2], table[3],
+			}))
+		})
+
+		It("keeps the rows matching every filter, ignoring case and color", func() {
+			Expect(TableView{Filters: []TableFilter{{Column: "name", Pattern: "web-?"}}}.Apply(table)).To(Equal([][]string{
+				table[0], table[1], table[3],
+			}))
+			Expect(TableView{Filters: []TableFilter{
+				{Column: "name", Pattern: "w*"},
+				{Column: "requested-state", Pattern: "STOPPED"},
+			}}.Apply(table)).To(Equal([][]string{
+				table[0], table[2],
+			}))
+		})
+
+		It("returns a TableColumnNotFoundError for an unknown column", func() {
+			_, err := TableView{Filters: []TableFilter{{Column: "disk", Pattern: "*"}}}.Apply(table)
+			Expect(err).To(MatchError(TableColumnNotFoundError{
+				Column:  "disk",
+				Columns: []string{"name", "requested-state", "memory"},
+			}))
+		})
+	})
+
+	Describe("ApplyWithColumns", func() {
+		It("looks up the columns by the untranslated column names", func() {
+			table := [][]string{
+				{"nom", "mémoire"},
+				{"web", "1G"},
+				{"worker", "512M"},
+			}
+			Expect(TableView{Columns: []string{"name"}, SortBy: "memory"}.ApplyWithColumns([]string{"name", "memory"}, table)).To(Equal([][]string{
+				{"nom"},
+				{"worker"},
+				{"web"},
+			}))
+		})
+	})
+})
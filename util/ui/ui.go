@@ -6,6 +6,7 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -19,6 +20,7 @@ import (
 	"github.com/lunixbochs/vtclean"
 	runewidth "github.com/mattn/go-runewidth"
 	"github.com/vito/go-interact/interact"
+	yaml "gopkg.in/yaml.v2"
 )
 
 // LogTimestampFormat is the timestamp formatting for log lines.
@@ -32,6 +34,8 @@ type Config interface {
 	ColorEnabled() configv3.ColorSetting
 	// Locale is the language to translate the output to
 	Locale() string
+	// OutputFormat is the format errors are displayed in
+	OutputFormat() configv3.OutputFormat
 	// IsTTY returns true when the ui has a TTY
 	IsTTY() bool
 	// TerminalWidth returns the width of the terminal
@@ -60,6 +64,7 @@ type UI struct {
 	Err io.Writer
 
 	colorEnabled configv3.ColorSetting
+	outputFormat configv3.OutputFormat
 	translate    TranslateFunc
 
 	terminalLock *sync.Mutex
@@ -86,6 +91,7 @@ func NewUI(config Config) (*UI, error) {
 		Out:              color.Output,
 		Err:              os.Stderr,
 		colorEnabled:     config.ColorEnabled(),
+		outputFormat:     config.OutputFormat(),
 		translate:        translateFunc,
 		terminalLock:     &sync.Mutex{},
 		fileLock:         &sync.Mutex{},
@@ -115,6 +121,20 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 	}
 }
 
+// ClearScreen clears the terminal and moves the cursor to its top left corner
+// so that the next output is redrawn in place. It does nothing when the UI
+// does not have a TTY.
+func (ui *UI) ClearScreen() {
+	if !ui.IsTTY {
+		return
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprint(ui.Out, "\x1b[H\x1b[2J")
+}
+
 // DisplayBoolPrompt outputs the prompt and waits for user input. It only
 // allows for a boolean response. A default boolean response can be set with
 // defaultResponse.
@@ -132,7 +152,9 @@ func (ui *UI) DisplayBoolPrompt(defaultResponse bool, template string, templateV
 
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
-// to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
+// to ui.Err, followed by the error's code when it has one. It also outputs
+// "FAILED" in bold red to ui.Out, or the error as a JSON or YAML document when
+// an output format is requested.
 func (ui *UI) DisplayError(err error) {
 	var errMsg string
 	if translatableError, ok := err.(translatableerror.TranslatableError); ok {
@@ -142,12 +164,41 @@ func (ui *UI) DisplayError(err error) {
 	}
 	fmt.Fprintf(ui.Err, "%s\n", errMsg)
 
+	code := translatableerror.ErrorCode(err)
+	if ui.outputFormat != configv3.OutputFormatText {
+		ui.displayStructuredError(code, errMsg, translatableerror.ExitStatusFor(err))
+		return
+	}
+	if code != "" {
+		fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText("Error code: {{.ErrorCode}}", map[string]interface{}{
+			"ErrorCode": code,
+		}))
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
 	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
 }
 
+// displayStructuredError outputs the error to UI.Out in the requested output
+// format instead of FAILED, so that the output can still be parsed when the
+// command fails.
+func (ui *UI) displayStructuredError(code string, message string, exitStatus translatableerror.ExitStatus) {
+	var output struct {
+		Error struct {
+			Code       string `json:"code,omitempty" yaml:"code,omitempty"`
+			Message    string `json:"message" yaml:"message"`
+			ExitStatus int    `json:"exit_status" yaml:"exit_status"`
+		} `json:"error" yaml:"error"`
+	}
+	output.Error.Code = code
+	output.Error.Message = message
+	output.Error.ExitStatus = int(exitStatus)
+
+	_ = ui.DisplayStructuredOutput(ui.outputFormat, output)
+}
+
 // DisplayHeader translates the header, bolds and adds the default color to the
 // header, and outputs the result to ui.Out.
 func (ui *UI) DisplayHeader(text string) {
@@ -180,12 +231,19 @@ func (ui *UI) DisplayKeyValueTable(prefix string, table [][]string, padding int)
 
 // DisplayLogMessage formats and outputs a given log message.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
+	ui.displayLogMessage(message, displayHeader, ui.TimezoneLocation, "")
+}
+
+// displayLogMessage outputs the log message with its timestamp in location.
+// When appName is not empty each line starts with it, colored so that the
+// lines of different apps are told apart easily.
+func (ui *UI) displayLogMessage(message LogMessage, displayHeader bool, location *time.Location, appName string) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
 	var header string
 	if displayHeader {
-		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
+		time := message.Timestamp().In(location).Format(LogTimestampFormat)
 
 		header = fmt.Sprintf("%s [%s/%s] %s ",
 			time,
@@ -195,12 +253,17 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		)
 	}
 
+	var prefix string
+	if appName != "" {
+		prefix = ui.modifyColor(appName, color.New(logAppNameColor(appName), color.Bold)) + " "
+	}
+
 	for _, line := range strings.Split(message.Message(), "\n") {
 		logLine := fmt.Sprintf("%s%s", header, strings.TrimRight(line, "\r\n"))
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "   %s\n", logLine)
+		fmt.Fprintf(ui.Out, "   %s%s\n", prefix, logLine)
 	}
 }
 
@@ -259,6 +322,32 @@ func (ui *UI) DisplayOK() {
 	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("OK"), color.New(color.FgGreen, color.Bold)))
 }
 
+// DisplayStructuredOutput outputs data to UI.Out as an indented JSON document
+// or a YAML document, depending on format.
+func (ui *UI) DisplayStructuredOutput(format configv3.OutputFormat, data interface{}) error {
+	var (
+		output []byte
+		err    error
+	)
+
+	switch format {
+	case configv3.OutputFormatYAML:
+		output, err = yaml.Marshal(data)
+	default:
+		output, err = json.MarshalIndent(data, "", "  ")
+		output = append(output, '\n')
+	}
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = ui.Out.Write(output)
+	return err
+}
+
 func (ui *UI) DisplayTableWithHeader(prefix string, table [][]string, padding int) {
 	if len(table) == 0 {
 		return
@@ -270,6 +359,27 @@ func (ui *UI) DisplayTableWithHeader(prefix string, table [][]string, padding in
 	ui.DisplayNonWrappingTable(prefix, table, padding)
 }
 
+// DisplayTableWithView applies the view to the table, whose first row is the
+// untranslated header, translates the header and displays the result with
+// DisplayTableWithHeader.
+func (ui *UI) DisplayTableWithView(prefix string, table [][]string, padding int, view TableView) error {
+	table, err := view.Apply(table)
+	if err != nil {
+		return err
+	}
+
+	if len(table) > 0 {
+		header := make([]string, len(table[0]))
+		for i, column := range table[0] {
+			header[i] = ui.TranslateText(column)
+		}
+		table = append([][]string{header}, table[1:]...)
+	}
+
+	ui.DisplayTableWithHeader(prefix, table, padding)
+	return nil
+}
+
 // DisplayText translates the template, substitutes in templateValues, and
 // outputs the result to ui.Out. Only the first map in templateValues is used.
 func (ui *UI) DisplayText(template string, templateValues ...map[string]interface{}) {
@@ -326,6 +436,18 @@ func (ui *UI) RequestLoggerFileWriter(filePaths []string) *RequestLoggerFileWrit
 	return newRequestLoggerFileWriter(ui, ui.fileLock, filePaths)
 }
 
+// RequestLoggerJSONFileWriter returns a RequestLoggerJSONWriter that appends
+// to the provided files and cannot overwrite another RequestLoggerFileWriter.
+func (ui *UI) RequestLoggerJSONFileWriter(filePaths []string) *RequestLoggerJSONWriter {
+	return newRequestLoggerJSONWriter(ui, ui.fileLock, filePaths)
+}
+
+// RequestLoggerJSONTerminalDisplay returns a RequestLoggerJSONWriter that
+// writes to the terminal and cannot overwrite the current display.
+func (ui *UI) RequestLoggerJSONTerminalDisplay() *RequestLoggerJSONWriter {
+	return newRequestLoggerJSONWriter(ui, ui.terminalLock, nil)
+}
+
 // RequestLoggerTerminalDisplay returns a RequestLoggerTerminalDisplay that
 // cannot overwrite another RequestLoggerTerminalDisplay or the current
 // display.
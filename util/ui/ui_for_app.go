@@ -6,13 +6,28 @@ import (
 	"github.com/fatih/color"
 )
 
+// InstanceStateTransition separates the previous and current state of an
+// instance in the state column of DisplayInstancesTableForApp, for example
+// "starting → running". Instances whose state changed are highlighted.
+const InstanceStateTransition = " → "
+
 func (ui *UI) DisplayInstancesTableForApp(table [][]string) {
 	redColor := color.New(color.FgRed, color.Bold)
+	yellowColor := color.New(color.FgYellow, color.Bold)
 	trDown, trCrashed := ui.TranslateText("down"), ui.TranslateText("crashed")
 
 	for i, row := range table {
-		if row[1] == trDown || row[1] == trCrashed {
+		state := row[1]
+		transitionIndex := strings.LastIndex(state, InstanceStateTransition)
+		if transitionIndex >= 0 {
+			state = state[transitionIndex+len(InstanceStateTransition):]
+		}
+
+		switch {
+		case state == trDown || state == trCrashed:
 			table[i][1] = ui.modifyColor(row[1], redColor)
+		case transitionIndex >= 0:
+			table[i][1] = ui.modifyColor(row[1], yellowColor)
 		}
 	}
 	ui.DisplayTableWithHeader("", table, 3)
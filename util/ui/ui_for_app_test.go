@@ -95,6 +95,19 @@ var _ = Describe("UI", func() {
 				Expect(ui.Out).To(Say("#1\\s+\x1b\\[31;1mdown\x1b\\[0m\\s+val1\\s+val2"))
 				Expect(ui.Out).To(Say("#2\\s+\x1b\\[31;1mcrashed\x1b\\[0m\\s+val1\\s+val2"))
 			})
+
+			It("displays a table with yellow coloring for state transitions and red for transitions to down and crashed", func() {
+				ui.DisplayInstancesTableForApp([][]string{
+					{"", "header1", "header2", "header3"},
+					{"#0", "starting" + InstanceStateTransition + "running", "val1", "val2"},
+					{"#1", "running" + InstanceStateTransition + "crashed", "val1", "val2"},
+					{"#2", "running", "val1", "val2"},
+				})
+
+				Expect(ui.Out).To(Say("#0\\s+\x1b\\[33;1mstarting → running\x1b\\[0m\\s+val1\\s+val2"))
+				Expect(ui.Out).To(Say("#1\\s+\x1b\\[31;1mrunning → crashed\x1b\\[0m\\s+val1\\s+val2"))
+				Expect(ui.Out).To(Say("#2\\s+running\\s+val1\\s+val2"))
+			})
 		})
 
 		Context("in a non-english language", func() {
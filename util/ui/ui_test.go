@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/translatableerror/translatableerrorfakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	. "code.cloudfoundry.org/cli/util/ui"
@@ -39,6 +40,26 @@ var _ = Describe("UI", func() {
 		Expect(ui.TimezoneLocation).To(Equal(location))
 	})
 
+	Describe("ClearScreen", func() {
+		Context("when the UI has a TTY", func() {
+			BeforeEach(func() {
+				ui.IsTTY = true
+			})
+
+			It("clears the screen and moves the cursor to the top", func() {
+				ui.ClearScreen()
+				Expect(out.Contents()).To(Equal([]byte("\x1b[H\x1b[2J")))
+			})
+		})
+
+		Context("when the UI does not have a TTY", func() {
+			It("displays nothing", func() {
+				ui.ClearScreen()
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("DisplayBoolPrompt", func() {
 		var inBuffer *Buffer
 
@@ -142,6 +163,41 @@ var _ = Describe("UI", func() {
 				Expect(ui.Out).To(Say("\x1b\\[31;1mFAILED\x1b\\[0m\n"))
 			})
 		})
+
+		Context("when the error has an error code", func() {
+			It("displays the error code after the error to ui.Err", func() {
+				ui.DisplayError(translatableerror.NotLoggedInError{BinaryName: "faceman"})
+				Expect(ui.Err).To(Say("Not logged in. Use 'faceman login' to log in.\nError code: NotLoggedIn\n"))
+				Expect(ui.Out).To(Say("\x1b\\[31;1mFAILED\x1b\\[0m\n"))
+			})
+		})
+
+		Context("when the output format is JSON", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				ui.Out = NewBuffer()
+				ui.Err = NewBuffer()
+			})
+
+			It("displays the error to ui.Err and the error as JSON to ui.Out", func() {
+				ui.DisplayError(translatableerror.NotLoggedInError{BinaryName: "faceman"})
+				Expect(ui.Err).To(Say("Not logged in. Use 'faceman login' to log in.\n"))
+				Expect(ui.Out).To(Say(`{
+  "error": {
+    "code": "NotLoggedIn",
+    "message": "Not logged in. Use 'faceman login' to log in.",
+    "exit_status": 2
+  }
+}
+`))
+				Expect(ui.Out).NotTo(Say("FAILED"))
+			})
+		})
 	})
 
 	Describe("DisplayHeader", func() {
@@ -290,6 +346,29 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayStructuredOutput", func() {
+		type data struct {
+			Name  string   `json:"name" yaml:"name"`
+			Items []string `json:"items" yaml:"items"`
+		}
+
+		Context("when the format is json", func() {
+			It("displays the data as indented JSON", func() {
+				err := ui.DisplayStructuredOutput(configv3.OutputFormatJSON, data{Name: "some-name", Items: []string{"a", "b"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ui.Out).To(Say(`{\n  "name": "some-name",\n  "items": \[\n    "a",\n    "b"\n  \]\n}\n`))
+			})
+		})
+
+		Context("when the format is yaml", func() {
+			It("displays the data as YAML", func() {
+				err := ui.DisplayStructuredOutput(configv3.OutputFormatYAML, data{Name: "some-name", Items: []string{"a", "b"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ui.Out).To(Say("name: some-name\nitems:\n- a\n- b\n"))
+			})
+		})
+	})
+
 	Describe("DisplayTableWithHeader", func() {
 		It("makes the first row bold", func() {
 			ui.DisplayTableWithHeader(" ",
@@ -305,6 +384,62 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayTableWithView", func() {
+		It("displays the table with the view applied", func() {
+			err := ui.DisplayTableWithView(" ",
+				[][]string{
+					{"name", "memory"},
+					{"app-a", "1G"},
+					{"app-b", "512M"},
+				},
+				2,
+				TableView{Columns: []string{"name"}, SortBy: "memory"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ui.Out).To(Say(" \x1b\\[1mname\x1b\\[0m\n"))
+			Expect(ui.Out).To(Say(" app-b\n"))
+			Expect(ui.Out).To(Say(" app-a\n"))
+		})
+
+		Context("when the view names a column the table does not have", func() {
+			It("returns the error and displays nothing", func() {
+				err := ui.DisplayTableWithView(" ",
+					[][]string{
+						{"name", "memory"},
+						{"app-a", "1G"},
+					},
+					2,
+					TableView{SortBy: "disk"})
+				Expect(err).To(MatchError(TableColumnNotFoundError{Column: "disk", Columns: []string{"name", "memory"}}))
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+
+		Context("when the locale is not set to english", func() {
+			BeforeEach(func() {
+				fakeConfig.LocaleReturns("fr-FR")
+
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				ui.Out = NewBuffer()
+			})
+
+			It("selects the columns by their untranslated names and translates the header", func() {
+				err := ui.DisplayTableWithView(" ",
+					[][]string{
+						{"name", "memory"},
+						{"app-a", "1G"},
+					},
+					2,
+					TableView{Columns: []string{"memory", "name"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ui.Out).To(Say(" \x1b\\[1mmémoire\x1b\\[0m  \x1b\\[1mnom\x1b\\[0m\n"))
+				Expect(ui.Out).To(Say(" 1G       app-a\n"))
+			})
+		})
+	})
+
 	// Covers the happy paths, additional cases are tested in TranslateText
 	Describe("DisplayText", func() {
 		It("displays the template with map values substituted in to ui.Out with a newline", func() {